@@ -0,0 +1,99 @@
+// Command rdap-conformance checks an RDAP response for conformance with the RDAP
+// specification, and reports any problems found.
+//
+// The response is read from a file, or requested from a URL (including a server running
+// locally):
+//
+//	rdap-conformance response.json
+//	rdap-conformance https://rdap.example.com/ip/192.0.2.1
+//	rdap-conformance -json http://localhost:8080/domain/example.com
+//
+// The command exits with status 1 if any errors are found, or with status 2 if the response
+// could not be read.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ryanmab/rdap-go/pkg/conformance"
+)
+
+func main() {
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	extensions := flag.String("extensions", "", "comma separated extension identifiers to treat as registered")
+	timeout := flag.Duration("timeout", 30*time.Second, "timeout when requesting a URL")
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <file or URL>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	checker := conformance.New()
+
+	if *extensions != "" {
+		checker.WithExtensions(strings.Split(*extensions, ",")...)
+	}
+
+	report, err := check(checker, flag.Arg(0), *timeout)
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(report); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+	} else {
+		for _, finding := range report.Findings {
+			fmt.Println(finding)
+		}
+
+		fmt.Printf("%d errors, %d warnings, %d info\n", report.Count(conformance.Error), report.Count(conformance.Warning), report.Count(conformance.Info))
+	}
+
+	if !report.Passed() {
+		os.Exit(1)
+	}
+}
+
+// check reads the response from the source, which is either a URL or a file path, and
+// checks it.
+func check(checker *conformance.Checker, source string, timeout time.Duration) (*conformance.Report, error) {
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		return checker.CheckURL(ctx, http.DefaultClient, source)
+	}
+
+	data, err := os.ReadFile(source)
+
+	if err != nil {
+		return nil, err
+	}
+
+	report := checker.CheckJSON(data)
+	report.Source = source
+
+	return report, nil
+}
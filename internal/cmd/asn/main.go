@@ -1,9 +1,13 @@
 package main
 
 import (
+	"cmp"
+	"errors"
 	"go/format"
 	"log"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -42,17 +46,33 @@ func main() {
 }
 
 func generate(bootstrapResponse bootstrap.Response) ([]byte, error) {
+	var ranges [][2]uint64
+
 	var sb strings.Builder
 	for _, service := range bootstrapResponse.Services {
 		for _, asnRange := range service.Keys {
 			asnStart, asnEnd, found := strings.Cut(asnRange, "-")
 
-			if found {
-				sb.WriteString("\t\t{" + asnStart + ", " + asnEnd + "}: {\n")
-			} else {
-				sb.WriteString("\t\t{" + asnStart + ", " + asnStart + "}: {\n")
+			if !found {
+				asnEnd = asnStart
 			}
 
+			start, err := strconv.ParseUint(asnStart, 10, 32)
+
+			if err != nil {
+				return nil, errors.New("Invalid ASN range start: " + asnRange)
+			}
+
+			end, err := strconv.ParseUint(asnEnd, 10, 32)
+
+			if err != nil || end < start {
+				return nil, errors.New("Invalid ASN range end: " + asnRange)
+			}
+
+			ranges = append(ranges, [2]uint64{start, end})
+
+			sb.WriteString("\t\t{" + asnStart + ", " + asnEnd + "}: {\n")
+
 			for _, server := range service.Servers {
 				sb.WriteString("\t\t\t\"" + server + "\",\n")
 			}
@@ -60,6 +80,8 @@ func generate(bootstrapResponse bootstrap.Response) ([]byte, error) {
 		}
 	}
 
+	warnOverlaps(ranges)
+
 	template := []byte(`
 		package asn
 
@@ -77,3 +99,22 @@ func generate(bootstrapResponse bootstrap.Response) ([]byte, error) {
 	return format.Source(template)
 
 }
+
+// warnOverlaps logs any ASN ranges which overlap. Overlapping ranges are tolerated (with
+// the narrowest range taking precedence during lookups), but indicate an unexpected change
+// to the IANA bootstrap data which is worth reviewing.
+func warnOverlaps(ranges [][2]uint64) {
+	slices.SortFunc(ranges, func(a, b [2]uint64) int {
+		if c := cmp.Compare(a[0], b[0]); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a[1], b[1])
+	})
+
+	for i := range ranges {
+		for j := i + 1; j < len(ranges) && ranges[j][0] <= ranges[i][1]; j++ {
+			log.Printf("WARNING: ASN range %d-%d overlaps with %d-%d", ranges[i][0], ranges[i][1], ranges[j][0], ranges[j][1])
+		}
+	}
+}
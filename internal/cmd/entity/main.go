@@ -0,0 +1,74 @@
+package main
+
+import (
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ryanmab/rdap-go/internal/cmd/internal/bootstrap"
+	"github.com/ryanmab/rdap-go/internal/query"
+)
+
+func main() {
+	outputPath := "internal/registry/internal/entity/bootstrap_generated.go"
+
+	bootstrapResponse := bootstrap.FetchBootstrap(query.EntityQuery)
+
+	log.Printf("Fetched object tag bootstrap data version %s published at %s. There are %d services", bootstrapResponse.Version, bootstrapResponse.Publication, len(bootstrapResponse.Services))
+
+	f, err := os.Create(outputPath)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	template, err := generate(bootstrapResponse)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if _, err := f.Write(template); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Wrote object tag bootstrap data to %s", outputPath)
+}
+
+// Generate the Go source code for the object tag bootstrap map based on the fetched data
+// from IANA.
+func generate(bootstrapResponse bootstrap.Response) ([]byte, error) {
+	var sb strings.Builder
+	for _, service := range bootstrapResponse.Services {
+		for _, tag := range service.Keys {
+			sb.WriteString("\t\t\"" + strings.ToUpper(tag) + "\": {\n")
+			for _, server := range service.Servers {
+				sb.WriteString("\t\t\t\"" + server + "\",\n")
+			}
+			sb.WriteString("\t\t},\n")
+		}
+	}
+
+	template := []byte(`
+		package entity
+
+        // DO NOT EDIT!
+		//
+		// This file is generated by internal/cmd/entity/main.go
+
+		// Bootstrap is the RDAP object tag bootstrap data sourced from IANA, mapping each
+		// service provider tag to its RDAP servers.
+		//
+		// Source (version: ` + bootstrapResponse.Version + `, publication date: ` + bootstrapResponse.Publication.Format(time.RFC3339) + `): https://data.iana.org/rdap/object-tags.json
+		var Bootstrap = map[string][]string{
+			` + sb.String() + `
+		}
+	`)
+	return format.Source(template)
+}
@@ -2,6 +2,7 @@ package bootstrap
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"time"
@@ -27,6 +28,19 @@ func (dns *Service) UnmarshalJSON(data []byte) error {
 	// The service data is an array of two arrays: the first array contains
 	// the keys (i.e. tlds, IPv6's, IPv4's, etc.), the second array contains
 	// server URIs (with a trailing slash).
+	//
+	// Object tag services are prefixed with an additional array containing the
+	// contacts of the service provider, which is ignored.
+	//
+	// See Section 3: https://datatracker.ietf.org/doc/rfc8521/
+	if len(raw) == 3 {
+		raw = raw[1:]
+	}
+
+	if len(raw) != 2 {
+		return errors.New("expected bootstrap service to be an array of two arrays")
+	}
+
 	dns.Keys = raw[0]
 	dns.Servers = raw[1]
 
@@ -58,6 +72,8 @@ func FetchBootstrap(bootstrap query.RdapQuery) Response {
 		url = "https://data.iana.org/rdap/ipv6.json"
 	case query.AsnQuery:
 		url = "https://data.iana.org/rdap/asn.json"
+	case query.EntityQuery:
+		url = "https://data.iana.org/rdap/object-tags.json"
 	default:
 		panic("unknown bootstrap type")
 	}
@@ -4,8 +4,8 @@ import (
 	"errors"
 	"go/format"
 	"log"
+	"net/netip"
 	"os"
-	"regexp"
 	"strings"
 	"time"
 
@@ -46,29 +46,20 @@ func main() {
 // Generate the Go source code for the IPv4 bootstrap map based on the fetched data
 // from IANA.
 func generate(bootstrapResponse bootstrap.Response) ([]byte, error) {
-	r := regexp.MustCompile(`([0-9]{1,3})\.([0-9]{1,3})\.([0-9]{1,3})\.([0-9]{1,3})/([0-9]+)`)
-
 	var sb strings.Builder
 	for _, service := range bootstrapResponse.Services {
 		for _, ip := range service.Keys {
-			matches := r.FindStringSubmatch(ip)
+			prefix, err := netip.ParsePrefix(ip)
 
-			if matches == nil {
+			if err != nil || !prefix.Addr().Is4() {
 				return nil, errors.New("Invalid IP range format: " + ip)
 			}
 
-			ipParts := matches[1 : len(matches)-1]
-			subnetMask := matches[len(matches)-1]
-
-			if subnetMask != "8" {
-				return nil, errors.New("Expected to only encounter /8 as the subnet mask, found: " + subnetMask + " in IP range: " + ip)
-			}
-
-			if ipParts[1] != "0" || ipParts[2] != "0" || ipParts[3] != "0" {
-				return nil, errors.New("Expected to only encounter 0.0.0 in the last three octets, found: " + ipParts[1] + "." + ipParts[2] + "." + ipParts[3] + " in IP range: " + ip)
+			if prefix != prefix.Masked() {
+				return nil, errors.New("Expected IP range to be a network address, found host bits set in IP range: " + ip)
 			}
 
-			sb.WriteString("\t\t" + ipParts[0] + ": {\n")
+			sb.WriteString("\t\t\"" + prefix.String() + "\": {\n")
 			for _, server := range service.Servers {
 				sb.WriteString("\t\t\t\"" + server + "\",\n")
 			}
@@ -83,10 +74,10 @@ func generate(bootstrapResponse bootstrap.Response) ([]byte, error) {
 		//
 		// This file is generated by internal/cmd/ipv4/main.go
 
-		// Bootstrap is the IPv4 RDAP bootstrap data sourced from IANA.
+		// Bootstrap is the IPv4 RDAP bootstrap data sourced from IANA, keyed by IPv4 prefix.
 		//
 		// Source (version: ` + bootstrapResponse.Version + `, publication date: ` + bootstrapResponse.Publication.Format(time.RFC3339) + `): https://data.iana.org/rdap/ipv4.json
-		var Bootstrap = map[uint8][]string{
+		var Bootstrap = map[string][]string{
 			` + sb.String() + `
 		}
 	`)
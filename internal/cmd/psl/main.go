@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+func main() {
+	outputPath := "internal/publicsuffix/list_generated.go"
+
+	response, err := http.Get("https://publicsuffix.org/list/public_suffix_list.dat")
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer response.Body.Close()
+
+	list, err := parse(response.Body)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Fetched Public Suffix List version %s. There are %d rules", list.version, len(list.rules))
+
+	f, err := os.Create(outputPath)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	template, err := generate(list)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if _, err := f.Write(template); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := f.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Wrote Public Suffix List to %s", outputPath)
+}
+
+// rule is a single rule of the Public Suffix List, in A-label form.
+type rule struct {
+	value   string
+	private bool
+}
+
+// list is the parsed Public Suffix List.
+type list struct {
+	version string
+	rules   []rule
+}
+
+// parse reads the rules from the Public Suffix List, recording which section of the list
+// (ICANN or private) each rule belongs to.
+//
+// See: https://github.com/publicsuffix/list/wiki/Format
+func parse(reader io.Reader) (list, error) {
+	var parsed list
+
+	seen := make(map[string]bool)
+	private := false
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case strings.HasPrefix(line, "// VERSION:"):
+			parsed.version = strings.TrimSpace(strings.TrimPrefix(line, "// VERSION:"))
+			continue
+		case strings.HasPrefix(line, "// ===BEGIN PRIVATE DOMAINS==="):
+			private = true
+			continue
+		case line == "" || strings.HasPrefix(line, "//"):
+			continue
+		}
+
+		// Rules are terminated by the first whitespace on the line.
+		line, _, _ = strings.Cut(line, " ")
+
+		prefix := ""
+		for _, marker := range []string{"!", "*."} {
+			if strings.HasPrefix(line, marker) {
+				prefix, line = marker, strings.TrimPrefix(line, marker)
+			}
+		}
+
+		ascii, err := idna.Lookup.ToASCII(line)
+
+		if err != nil {
+			return list{}, errors.New("Invalid Public Suffix List rule: " + prefix + line)
+		}
+
+		value := prefix + strings.ToLower(ascii)
+
+		if seen[value] {
+			continue
+		}
+
+		seen[value] = true
+		parsed.rules = append(parsed.rules, rule{value: value, private: private})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return list{}, err
+	}
+
+	if parsed.version == "" || len(parsed.rules) == 0 {
+		return list{}, errors.New("Public Suffix List is empty, or missing its version")
+	}
+
+	return parsed, nil
+}
+
+// Generate the Go source code for the Public Suffix List map based on the fetched list.
+func generate(list list) ([]byte, error) {
+	var sb strings.Builder
+	for _, rule := range list.rules {
+		section := "ICANN"
+		if rule.private {
+			section = "Private"
+		}
+
+		sb.WriteString("\t\t\"" + rule.value + "\": " + section + ",\n")
+	}
+
+	template := []byte(`
+		package publicsuffix
+
+		// DO NOT EDIT!
+		//
+		// This file is generated by internal/cmd/psl/main.go
+
+		// List is the Public Suffix List, mapping each rule (in A-label form) to the section
+		// of the list it belongs to.
+		//
+		// Source (version: ` + list.version + `): https://publicsuffix.org/list/public_suffix_list.dat
+		var List = map[string]Section{
+			` + sb.String() + `
+		}
+	`)
+	return format.Source(template)
+}
@@ -0,0 +1,10166 @@
+package publicsuffix
+
+// DO NOT EDIT!
+//
+// This file is generated by internal/cmd/psl/main.go
+
+// List is the Public Suffix List, mapping each rule (in A-label form) to the section
+// of the list it belongs to.
+//
+// Source (version: 2026-03-02_12-22-01_UTC): https://publicsuffix.org/list/public_suffix_list.dat
+var List = map[string]Section{
+	"ac":                                     ICANN,
+	"com.ac":                                 ICANN,
+	"edu.ac":                                 ICANN,
+	"gov.ac":                                 ICANN,
+	"mil.ac":                                 ICANN,
+	"net.ac":                                 ICANN,
+	"org.ac":                                 ICANN,
+	"ad":                                     ICANN,
+	"ae":                                     ICANN,
+	"ac.ae":                                  ICANN,
+	"co.ae":                                  ICANN,
+	"gov.ae":                                 ICANN,
+	"mil.ae":                                 ICANN,
+	"net.ae":                                 ICANN,
+	"org.ae":                                 ICANN,
+	"sch.ae":                                 ICANN,
+	"aero":                                   ICANN,
+	"airline.aero":                           ICANN,
+	"airport.aero":                           ICANN,
+	"accident-investigation.aero":            ICANN,
+	"accident-prevention.aero":               ICANN,
+	"aerobatic.aero":                         ICANN,
+	"aeroclub.aero":                          ICANN,
+	"aerodrome.aero":                         ICANN,
+	"agents.aero":                            ICANN,
+	"air-surveillance.aero":                  ICANN,
+	"air-traffic-control.aero":               ICANN,
+	"aircraft.aero":                          ICANN,
+	"airtraffic.aero":                        ICANN,
+	"ambulance.aero":                         ICANN,
+	"association.aero":                       ICANN,
+	"author.aero":                            ICANN,
+	"ballooning.aero":                        ICANN,
+	"broker.aero":                            ICANN,
+	"caa.aero":                               ICANN,
+	"cargo.aero":                             ICANN,
+	"catering.aero":                          ICANN,
+	"certification.aero":                     ICANN,
+	"championship.aero":                      ICANN,
+	"charter.aero":                           ICANN,
+	"civilaviation.aero":                     ICANN,
+	"club.aero":                              ICANN,
+	"conference.aero":                        ICANN,
+	"consultant.aero":                        ICANN,
+	"consulting.aero":                        ICANN,
+	"control.aero":                           ICANN,
+	"council.aero":                           ICANN,
+	"crew.aero":                              ICANN,
+	"design.aero":                            ICANN,
+	"dgca.aero":                              ICANN,
+	"educator.aero":                          ICANN,
+	"emergency.aero":                         ICANN,
+	"engine.aero":                            ICANN,
+	"engineer.aero":                          ICANN,
+	"entertainment.aero":                     ICANN,
+	"equipment.aero":                         ICANN,
+	"exchange.aero":                          ICANN,
+	"express.aero":                           ICANN,
+	"federation.aero":                        ICANN,
+	"flight.aero":                            ICANN,
+	"freight.aero":                           ICANN,
+	"fuel.aero":                              ICANN,
+	"gliding.aero":                           ICANN,
+	"government.aero":                        ICANN,
+	"groundhandling.aero":                    ICANN,
+	"group.aero":                             ICANN,
+	"hanggliding.aero":                       ICANN,
+	"homebuilt.aero":                         ICANN,
+	"insurance.aero":                         ICANN,
+	"journal.aero":                           ICANN,
+	"journalist.aero":                        ICANN,
+	"leasing.aero":                           ICANN,
+	"logistics.aero":                         ICANN,
+	"magazine.aero":                          ICANN,
+	"maintenance.aero":                       ICANN,
+	"marketplace.aero":                       ICANN,
+	"media.aero":                             ICANN,
+	"microlight.aero":                        ICANN,
+	"modelling.aero":                         ICANN,
+	"navigation.aero":                        ICANN,
+	"parachuting.aero":                       ICANN,
+	"paragliding.aero":                       ICANN,
+	"passenger-association.aero":             ICANN,
+	"pilot.aero":                             ICANN,
+	"press.aero":                             ICANN,
+	"production.aero":                        ICANN,
+	"recreation.aero":                        ICANN,
+	"repbody.aero":                           ICANN,
+	"res.aero":                               ICANN,
+	"research.aero":                          ICANN,
+	"rotorcraft.aero":                        ICANN,
+	"safety.aero":                            ICANN,
+	"scientist.aero":                         ICANN,
+	"services.aero":                          ICANN,
+	"show.aero":                              ICANN,
+	"skydiving.aero":                         ICANN,
+	"software.aero":                          ICANN,
+	"student.aero":                           ICANN,
+	"taxi.aero":                              ICANN,
+	"trader.aero":                            ICANN,
+	"trading.aero":                           ICANN,
+	"trainer.aero":                           ICANN,
+	"union.aero":                             ICANN,
+	"workinggroup.aero":                      ICANN,
+	"works.aero":                             ICANN,
+	"af":                                     ICANN,
+	"com.af":                                 ICANN,
+	"edu.af":                                 ICANN,
+	"gov.af":                                 ICANN,
+	"net.af":                                 ICANN,
+	"org.af":                                 ICANN,
+	"ag":                                     ICANN,
+	"co.ag":                                  ICANN,
+	"com.ag":                                 ICANN,
+	"net.ag":                                 ICANN,
+	"nom.ag":                                 ICANN,
+	"org.ag":                                 ICANN,
+	"ai":                                     ICANN,
+	"com.ai":                                 ICANN,
+	"net.ai":                                 ICANN,
+	"off.ai":                                 ICANN,
+	"org.ai":                                 ICANN,
+	"al":                                     ICANN,
+	"com.al":                                 ICANN,
+	"edu.al":                                 ICANN,
+	"gov.al":                                 ICANN,
+	"mil.al":                                 ICANN,
+	"net.al":                                 ICANN,
+	"org.al":                                 ICANN,
+	"am":                                     ICANN,
+	"co.am":                                  ICANN,
+	"com.am":                                 ICANN,
+	"commune.am":                             ICANN,
+	"net.am":                                 ICANN,
+	"org.am":                                 ICANN,
+	"ao":                                     ICANN,
+	"co.ao":                                  ICANN,
+	"ed.ao":                                  ICANN,
+	"edu.ao":                                 ICANN,
+	"gov.ao":                                 ICANN,
+	"gv.ao":                                  ICANN,
+	"it.ao":                                  ICANN,
+	"og.ao":                                  ICANN,
+	"org.ao":                                 ICANN,
+	"pb.ao":                                  ICANN,
+	"aq":                                     ICANN,
+	"ar":                                     ICANN,
+	"bet.ar":                                 ICANN,
+	"com.ar":                                 ICANN,
+	"coop.ar":                                ICANN,
+	"edu.ar":                                 ICANN,
+	"gob.ar":                                 ICANN,
+	"gov.ar":                                 ICANN,
+	"int.ar":                                 ICANN,
+	"mil.ar":                                 ICANN,
+	"musica.ar":                              ICANN,
+	"mutual.ar":                              ICANN,
+	"net.ar":                                 ICANN,
+	"org.ar":                                 ICANN,
+	"seg.ar":                                 ICANN,
+	"senasa.ar":                              ICANN,
+	"tur.ar":                                 ICANN,
+	"arpa":                                   ICANN,
+	"e164.arpa":                              ICANN,
+	"home.arpa":                              ICANN,
+	"in-addr.arpa":                           ICANN,
+	"ip6.arpa":                               ICANN,
+	"iris.arpa":                              ICANN,
+	"uri.arpa":                               ICANN,
+	"urn.arpa":                               ICANN,
+	"as":                                     ICANN,
+	"gov.as":                                 ICANN,
+	"asia":                                   ICANN,
+	"at":                                     ICANN,
+	"ac.at":                                  ICANN,
+	"sth.ac.at":                              ICANN,
+	"co.at":                                  ICANN,
+	"gv.at":                                  ICANN,
+	"or.at":                                  ICANN,
+	"au":                                     ICANN,
+	"asn.au":                                 ICANN,
+	"com.au":                                 ICANN,
+	"edu.au":                                 ICANN,
+	"gov.au":                                 ICANN,
+	"id.au":                                  ICANN,
+	"net.au":                                 ICANN,
+	"org.au":                                 ICANN,
+	"conf.au":                                ICANN,
+	"oz.au":                                  ICANN,
+	"act.au":                                 ICANN,
+	"nsw.au":                                 ICANN,
+	"nt.au":                                  ICANN,
+	"qld.au":                                 ICANN,
+	"sa.au":                                  ICANN,
+	"tas.au":                                 ICANN,
+	"vic.au":                                 ICANN,
+	"wa.au":                                  ICANN,
+	"act.edu.au":                             ICANN,
+	"catholic.edu.au":                        ICANN,
+	"nsw.edu.au":                             ICANN,
+	"nt.edu.au":                              ICANN,
+	"qld.edu.au":                             ICANN,
+	"sa.edu.au":                              ICANN,
+	"tas.edu.au":                             ICANN,
+	"vic.edu.au":                             ICANN,
+	"wa.edu.au":                              ICANN,
+	"qld.gov.au":                             ICANN,
+	"sa.gov.au":                              ICANN,
+	"tas.gov.au":                             ICANN,
+	"vic.gov.au":                             ICANN,
+	"wa.gov.au":                              ICANN,
+	"aw":                                     ICANN,
+	"com.aw":                                 ICANN,
+	"ax":                                     ICANN,
+	"az":                                     ICANN,
+	"biz.az":                                 ICANN,
+	"co.az":                                  ICANN,
+	"com.az":                                 ICANN,
+	"edu.az":                                 ICANN,
+	"gov.az":                                 ICANN,
+	"info.az":                                ICANN,
+	"int.az":                                 ICANN,
+	"mil.az":                                 ICANN,
+	"name.az":                                ICANN,
+	"net.az":                                 ICANN,
+	"org.az":                                 ICANN,
+	"pp.az":                                  ICANN,
+	"pro.az":                                 ICANN,
+	"ba":                                     ICANN,
+	"com.ba":                                 ICANN,
+	"edu.ba":                                 ICANN,
+	"gov.ba":                                 ICANN,
+	"mil.ba":                                 ICANN,
+	"net.ba":                                 ICANN,
+	"org.ba":                                 ICANN,
+	"bb":                                     ICANN,
+	"biz.bb":                                 ICANN,
+	"co.bb":                                  ICANN,
+	"com.bb":                                 ICANN,
+	"edu.bb":                                 ICANN,
+	"gov.bb":                                 ICANN,
+	"info.bb":                                ICANN,
+	"net.bb":                                 ICANN,
+	"org.bb":                                 ICANN,
+	"store.bb":                               ICANN,
+	"tv.bb":                                  ICANN,
+	"bd":                                     ICANN,
+	"ac.bd":                                  ICANN,
+	"ai.bd":                                  ICANN,
+	"co.bd":                                  ICANN,
+	"com.bd":                                 ICANN,
+	"edu.bd":                                 ICANN,
+	"gov.bd":                                 ICANN,
+	"id.bd":                                  ICANN,
+	"info.bd":                                ICANN,
+	"it.bd":                                  ICANN,
+	"mil.bd":                                 ICANN,
+	"net.bd":                                 ICANN,
+	"org.bd":                                 ICANN,
+	"sch.bd":                                 ICANN,
+	"tv.bd":                                  ICANN,
+	"be":                                     ICANN,
+	"ac.be":                                  ICANN,
+	"bf":                                     ICANN,
+	"gov.bf":                                 ICANN,
+	"bg":                                     ICANN,
+	"0.bg":                                   ICANN,
+	"1.bg":                                   ICANN,
+	"2.bg":                                   ICANN,
+	"3.bg":                                   ICANN,
+	"4.bg":                                   ICANN,
+	"5.bg":                                   ICANN,
+	"6.bg":                                   ICANN,
+	"7.bg":                                   ICANN,
+	"8.bg":                                   ICANN,
+	"9.bg":                                   ICANN,
+	"a.bg":                                   ICANN,
+	"b.bg":                                   ICANN,
+	"c.bg":                                   ICANN,
+	"d.bg":                                   ICANN,
+	"e.bg":                                   ICANN,
+	"f.bg":                                   ICANN,
+	"g.bg":                                   ICANN,
+	"h.bg":                                   ICANN,
+	"i.bg":                                   ICANN,
+	"j.bg":                                   ICANN,
+	"k.bg":                                   ICANN,
+	"l.bg":                                   ICANN,
+	"m.bg":                                   ICANN,
+	"n.bg":                                   ICANN,
+	"o.bg":                                   ICANN,
+	"p.bg":                                   ICANN,
+	"q.bg":                                   ICANN,
+	"r.bg":                                   ICANN,
+	"s.bg":                                   ICANN,
+	"t.bg":                                   ICANN,
+	"u.bg":                                   ICANN,
+	"v.bg":                                   ICANN,
+	"w.bg":                                   ICANN,
+	"x.bg":                                   ICANN,
+	"y.bg":                                   ICANN,
+	"z.bg":                                   ICANN,
+	"bh":                                     ICANN,
+	"com.bh":                                 ICANN,
+	"edu.bh":                                 ICANN,
+	"gov.bh":                                 ICANN,
+	"net.bh":                                 ICANN,
+	"org.bh":                                 ICANN,
+	"bi":                                     ICANN,
+	"co.bi":                                  ICANN,
+	"com.bi":                                 ICANN,
+	"edu.bi":                                 ICANN,
+	"or.bi":                                  ICANN,
+	"org.bi":                                 ICANN,
+	"biz":                                    ICANN,
+	"bj":                                     ICANN,
+	"africa.bj":                              ICANN,
+	"agro.bj":                                ICANN,
+	"architectes.bj":                         ICANN,
+	"assur.bj":                               ICANN,
+	"avocats.bj":                             ICANN,
+	"co.bj":                                  ICANN,
+	"com.bj":                                 ICANN,
+	"eco.bj":                                 ICANN,
+	"econo.bj":                               ICANN,
+	"edu.bj":                                 ICANN,
+	"info.bj":                                ICANN,
+	"loisirs.bj":                             ICANN,
+	"money.bj":                               ICANN,
+	"net.bj":                                 ICANN,
+	"org.bj":                                 ICANN,
+	"ote.bj":                                 ICANN,
+	"restaurant.bj":                          ICANN,
+	"resto.bj":                               ICANN,
+	"tourism.bj":                             ICANN,
+	"univ.bj":                                ICANN,
+	"bm":                                     ICANN,
+	"com.bm":                                 ICANN,
+	"edu.bm":                                 ICANN,
+	"gov.bm":                                 ICANN,
+	"net.bm":                                 ICANN,
+	"org.bm":                                 ICANN,
+	"bn":                                     ICANN,
+	"com.bn":                                 ICANN,
+	"edu.bn":                                 ICANN,
+	"gov.bn":                                 ICANN,
+	"net.bn":                                 ICANN,
+	"org.bn":                                 ICANN,
+	"bo":                                     ICANN,
+	"com.bo":                                 ICANN,
+	"edu.bo":                                 ICANN,
+	"gob.bo":                                 ICANN,
+	"int.bo":                                 ICANN,
+	"mil.bo":                                 ICANN,
+	"net.bo":                                 ICANN,
+	"org.bo":                                 ICANN,
+	"tv.bo":                                  ICANN,
+	"web.bo":                                 ICANN,
+	"academia.bo":                            ICANN,
+	"agro.bo":                                ICANN,
+	"arte.bo":                                ICANN,
+	"blog.bo":                                ICANN,
+	"bolivia.bo":                             ICANN,
+	"ciencia.bo":                             ICANN,
+	"cooperativa.bo":                         ICANN,
+	"democracia.bo":                          ICANN,
+	"deporte.bo":                             ICANN,
+	"ecologia.bo":                            ICANN,
+	"economia.bo":                            ICANN,
+	"empresa.bo":                             ICANN,
+	"indigena.bo":                            ICANN,
+	"industria.bo":                           ICANN,
+	"info.bo":                                ICANN,
+	"medicina.bo":                            ICANN,
+	"movimiento.bo":                          ICANN,
+	"musica.bo":                              ICANN,
+	"natural.bo":                             ICANN,
+	"nombre.bo":                              ICANN,
+	"noticias.bo":                            ICANN,
+	"patria.bo":                              ICANN,
+	"plurinacional.bo":                       ICANN,
+	"politica.bo":                            ICANN,
+	"profesional.bo":                         ICANN,
+	"pueblo.bo":                              ICANN,
+	"revista.bo":                             ICANN,
+	"salud.bo":                               ICANN,
+	"tecnologia.bo":                          ICANN,
+	"tksat.bo":                               ICANN,
+	"transporte.bo":                          ICANN,
+	"wiki.bo":                                ICANN,
+	"br":                                     ICANN,
+	"9guacu.br":                              ICANN,
+	"abc.br":                                 ICANN,
+	"adm.br":                                 ICANN,
+	"adv.br":                                 ICANN,
+	"agr.br":                                 ICANN,
+	"aju.br":                                 ICANN,
+	"am.br":                                  ICANN,
+	"anani.br":                               ICANN,
+	"aparecida.br":                           ICANN,
+	"api.br":                                 ICANN,
+	"app.br":                                 ICANN,
+	"arq.br":                                 ICANN,
+	"art.br":                                 ICANN,
+	"ato.br":                                 ICANN,
+	"b.br":                                   ICANN,
+	"barueri.br":                             ICANN,
+	"belem.br":                               ICANN,
+	"bet.br":                                 ICANN,
+	"bhz.br":                                 ICANN,
+	"bib.br":                                 ICANN,
+	"bio.br":                                 ICANN,
+	"blog.br":                                ICANN,
+	"bmd.br":                                 ICANN,
+	"boavista.br":                            ICANN,
+	"bsb.br":                                 ICANN,
+	"campinagrande.br":                       ICANN,
+	"campinas.br":                            ICANN,
+	"caxias.br":                              ICANN,
+	"cim.br":                                 ICANN,
+	"cng.br":                                 ICANN,
+	"cnt.br":                                 ICANN,
+	"com.br":                                 ICANN,
+	"contagem.br":                            ICANN,
+	"coop.br":                                ICANN,
+	"coz.br":                                 ICANN,
+	"cri.br":                                 ICANN,
+	"cuiaba.br":                              ICANN,
+	"curitiba.br":                            ICANN,
+	"def.br":                                 ICANN,
+	"des.br":                                 ICANN,
+	"det.br":                                 ICANN,
+	"dev.br":                                 ICANN,
+	"ecn.br":                                 ICANN,
+	"eco.br":                                 ICANN,
+	"edu.br":                                 ICANN,
+	"emp.br":                                 ICANN,
+	"enf.br":                                 ICANN,
+	"eng.br":                                 ICANN,
+	"esp.br":                                 ICANN,
+	"etc.br":                                 ICANN,
+	"eti.br":                                 ICANN,
+	"far.br":                                 ICANN,
+	"feira.br":                               ICANN,
+	"flog.br":                                ICANN,
+	"floripa.br":                             ICANN,
+	"fm.br":                                  ICANN,
+	"fnd.br":                                 ICANN,
+	"fortal.br":                              ICANN,
+	"fot.br":                                 ICANN,
+	"foz.br":                                 ICANN,
+	"fst.br":                                 ICANN,
+	"g12.br":                                 ICANN,
+	"geo.br":                                 ICANN,
+	"ggf.br":                                 ICANN,
+	"goiania.br":                             ICANN,
+	"gov.br":                                 ICANN,
+	"ac.gov.br":                              ICANN,
+	"al.gov.br":                              ICANN,
+	"am.gov.br":                              ICANN,
+	"ap.gov.br":                              ICANN,
+	"ba.gov.br":                              ICANN,
+	"ce.gov.br":                              ICANN,
+	"df.gov.br":                              ICANN,
+	"es.gov.br":                              ICANN,
+	"go.gov.br":                              ICANN,
+	"ma.gov.br":                              ICANN,
+	"mg.gov.br":                              ICANN,
+	"ms.gov.br":                              ICANN,
+	"mt.gov.br":                              ICANN,
+	"pa.gov.br":                              ICANN,
+	"pb.gov.br":                              ICANN,
+	"pe.gov.br":                              ICANN,
+	"pi.gov.br":                              ICANN,
+	"pr.gov.br":                              ICANN,
+	"rj.gov.br":                              ICANN,
+	"rn.gov.br":                              ICANN,
+	"ro.gov.br":                              ICANN,
+	"rr.gov.br":                              ICANN,
+	"rs.gov.br":                              ICANN,
+	"sc.gov.br":                              ICANN,
+	"se.gov.br":                              ICANN,
+	"sp.gov.br":                              ICANN,
+	"to.gov.br":                              ICANN,
+	"gru.br":                                 ICANN,
+	"ia.br":                                  ICANN,
+	"imb.br":                                 ICANN,
+	"ind.br":                                 ICANN,
+	"inf.br":                                 ICANN,
+	"jab.br":                                 ICANN,
+	"jampa.br":                               ICANN,
+	"jdf.br":                                 ICANN,
+	"joinville.br":                           ICANN,
+	"jor.br":                                 ICANN,
+	"jus.br":                                 ICANN,
+	"leg.br":                                 ICANN,
+	"leilao.br":                              ICANN,
+	"lel.br":                                 ICANN,
+	"log.br":                                 ICANN,
+	"londrina.br":                            ICANN,
+	"macapa.br":                              ICANN,
+	"maceio.br":                              ICANN,
+	"manaus.br":                              ICANN,
+	"maringa.br":                             ICANN,
+	"mat.br":                                 ICANN,
+	"med.br":                                 ICANN,
+	"mil.br":                                 ICANN,
+	"morena.br":                              ICANN,
+	"mp.br":                                  ICANN,
+	"mus.br":                                 ICANN,
+	"natal.br":                               ICANN,
+	"net.br":                                 ICANN,
+	"niteroi.br":                             ICANN,
+	"*.nom.br":                               ICANN,
+	"not.br":                                 ICANN,
+	"ntr.br":                                 ICANN,
+	"odo.br":                                 ICANN,
+	"ong.br":                                 ICANN,
+	"org.br":                                 ICANN,
+	"osasco.br":                              ICANN,
+	"palmas.br":                              ICANN,
+	"poa.br":                                 ICANN,
+	"ppg.br":                                 ICANN,
+	"pro.br":                                 ICANN,
+	"psc.br":                                 ICANN,
+	"psi.br":                                 ICANN,
+	"pvh.br":                                 ICANN,
+	"qsl.br":                                 ICANN,
+	"radio.br":                               ICANN,
+	"rec.br":                                 ICANN,
+	"recife.br":                              ICANN,
+	"rep.br":                                 ICANN,
+	"ribeirao.br":                            ICANN,
+	"rio.br":                                 ICANN,
+	"riobranco.br":                           ICANN,
+	"riopreto.br":                            ICANN,
+	"salvador.br":                            ICANN,
+	"sampa.br":                               ICANN,
+	"santamaria.br":                          ICANN,
+	"santoandre.br":                          ICANN,
+	"saobernardo.br":                         ICANN,
+	"saogonca.br":                            ICANN,
+	"seg.br":                                 ICANN,
+	"sjc.br":                                 ICANN,
+	"slg.br":                                 ICANN,
+	"slz.br":                                 ICANN,
+	"social.br":                              ICANN,
+	"sorocaba.br":                            ICANN,
+	"srv.br":                                 ICANN,
+	"taxi.br":                                ICANN,
+	"tc.br":                                  ICANN,
+	"tec.br":                                 ICANN,
+	"teo.br":                                 ICANN,
+	"the.br":                                 ICANN,
+	"tmp.br":                                 ICANN,
+	"trd.br":                                 ICANN,
+	"tur.br":                                 ICANN,
+	"tv.br":                                  ICANN,
+	"udi.br":                                 ICANN,
+	"vet.br":                                 ICANN,
+	"vix.br":                                 ICANN,
+	"vlog.br":                                ICANN,
+	"wiki.br":                                ICANN,
+	"xyz.br":                                 ICANN,
+	"zlg.br":                                 ICANN,
+	"bs":                                     ICANN,
+	"com.bs":                                 ICANN,
+	"edu.bs":                                 ICANN,
+	"gov.bs":                                 ICANN,
+	"net.bs":                                 ICANN,
+	"org.bs":                                 ICANN,
+	"bt":                                     ICANN,
+	"com.bt":                                 ICANN,
+	"edu.bt":                                 ICANN,
+	"gov.bt":                                 ICANN,
+	"net.bt":                                 ICANN,
+	"org.bt":                                 ICANN,
+	"bv":                                     ICANN,
+	"bw":                                     ICANN,
+	"ac.bw":                                  ICANN,
+	"co.bw":                                  ICANN,
+	"gov.bw":                                 ICANN,
+	"net.bw":                                 ICANN,
+	"org.bw":                                 ICANN,
+	"by":                                     ICANN,
+	"gov.by":                                 ICANN,
+	"mil.by":                                 ICANN,
+	"com.by":                                 ICANN,
+	"of.by":                                  ICANN,
+	"bz":                                     ICANN,
+	"co.bz":                                  ICANN,
+	"com.bz":                                 ICANN,
+	"edu.bz":                                 ICANN,
+	"gov.bz":                                 ICANN,
+	"net.bz":                                 ICANN,
+	"org.bz":                                 ICANN,
+	"ca":                                     ICANN,
+	"ab.ca":                                  ICANN,
+	"bc.ca":                                  ICANN,
+	"mb.ca":                                  ICANN,
+	"nb.ca":                                  ICANN,
+	"nf.ca":                                  ICANN,
+	"nl.ca":                                  ICANN,
+	"ns.ca":                                  ICANN,
+	"nt.ca":                                  ICANN,
+	"nu.ca":                                  ICANN,
+	"on.ca":                                  ICANN,
+	"pe.ca":                                  ICANN,
+	"qc.ca":                                  ICANN,
+	"sk.ca":                                  ICANN,
+	"yk.ca":                                  ICANN,
+	"gc.ca":                                  ICANN,
+	"cat":                                    ICANN,
+	"cc":                                     ICANN,
+	"cd":                                     ICANN,
+	"gov.cd":                                 ICANN,
+	"cf":                                     ICANN,
+	"cg":                                     ICANN,
+	"ch":                                     ICANN,
+	"ci":                                     ICANN,
+	"ac.ci":                                  ICANN,
+	"xn--aroport-bya.ci":                     ICANN,
+	"asso.ci":                                ICANN,
+	"co.ci":                                  ICANN,
+	"com.ci":                                 ICANN,
+	"ed.ci":                                  ICANN,
+	"edu.ci":                                 ICANN,
+	"go.ci":                                  ICANN,
+	"gouv.ci":                                ICANN,
+	"int.ci":                                 ICANN,
+	"net.ci":                                 ICANN,
+	"or.ci":                                  ICANN,
+	"org.ci":                                 ICANN,
+	"*.ck":                                   ICANN,
+	"!www.ck":                                ICANN,
+	"cl":                                     ICANN,
+	"co.cl":                                  ICANN,
+	"gob.cl":                                 ICANN,
+	"gov.cl":                                 ICANN,
+	"mil.cl":                                 ICANN,
+	"cm":                                     ICANN,
+	"co.cm":                                  ICANN,
+	"com.cm":                                 ICANN,
+	"gov.cm":                                 ICANN,
+	"net.cm":                                 ICANN,
+	"cn":                                     ICANN,
+	"ac.cn":                                  ICANN,
+	"com.cn":                                 ICANN,
+	"edu.cn":                                 ICANN,
+	"gov.cn":                                 ICANN,
+	"mil.cn":                                 ICANN,
+	"net.cn":                                 ICANN,
+	"org.cn":                                 ICANN,
+	"xn--55qx5d.cn":                          ICANN,
+	"xn--od0alg.cn":                          ICANN,
+	"xn--io0a7i.cn":                          ICANN,
+	"ah.cn":                                  ICANN,
+	"bj.cn":                                  ICANN,
+	"cq.cn":                                  ICANN,
+	"fj.cn":                                  ICANN,
+	"gd.cn":                                  ICANN,
+	"gs.cn":                                  ICANN,
+	"gx.cn":                                  ICANN,
+	"gz.cn":                                  ICANN,
+	"ha.cn":                                  ICANN,
+	"hb.cn":                                  ICANN,
+	"he.cn":                                  ICANN,
+	"hi.cn":                                  ICANN,
+	"hk.cn":                                  ICANN,
+	"hl.cn":                                  ICANN,
+	"hn.cn":                                  ICANN,
+	"jl.cn":                                  ICANN,
+	"js.cn":                                  ICANN,
+	"jx.cn":                                  ICANN,
+	"ln.cn":                                  ICANN,
+	"mo.cn":                                  ICANN,
+	"nm.cn":                                  ICANN,
+	"nx.cn":                                  ICANN,
+	"qh.cn":                                  ICANN,
+	"sc.cn":                                  ICANN,
+	"sd.cn":                                  ICANN,
+	"sh.cn":                                  ICANN,
+	"sn.cn":                                  ICANN,
+	"sx.cn":                                  ICANN,
+	"tj.cn":                                  ICANN,
+	"tw.cn":                                  ICANN,
+	"xj.cn":                                  ICANN,
+	"xz.cn":                                  ICANN,
+	"yn.cn":                                  ICANN,
+	"zj.cn":                                  ICANN,
+	"co":                                     ICANN,
+	"com.co":                                 ICANN,
+	"edu.co":                                 ICANN,
+	"gov.co":                                 ICANN,
+	"mil.co":                                 ICANN,
+	"net.co":                                 ICANN,
+	"nom.co":                                 ICANN,
+	"org.co":                                 ICANN,
+	"com":                                    ICANN,
+	"coop":                                   ICANN,
+	"cr":                                     ICANN,
+	"ac.cr":                                  ICANN,
+	"co.cr":                                  ICANN,
+	"ed.cr":                                  ICANN,
+	"fi.cr":                                  ICANN,
+	"go.cr":                                  ICANN,
+	"or.cr":                                  ICANN,
+	"sa.cr":                                  ICANN,
+	"cu":                                     ICANN,
+	"com.cu":                                 ICANN,
+	"edu.cu":                                 ICANN,
+	"gob.cu":                                 ICANN,
+	"inf.cu":                                 ICANN,
+	"nat.cu":                                 ICANN,
+	"net.cu":                                 ICANN,
+	"org.cu":                                 ICANN,
+	"cv":                                     ICANN,
+	"com.cv":                                 ICANN,
+	"edu.cv":                                 ICANN,
+	"id.cv":                                  ICANN,
+	"int.cv":                                 ICANN,
+	"net.cv":                                 ICANN,
+	"nome.cv":                                ICANN,
+	"org.cv":                                 ICANN,
+	"publ.cv":                                ICANN,
+	"cw":                                     ICANN,
+	"com.cw":                                 ICANN,
+	"edu.cw":                                 ICANN,
+	"net.cw":                                 ICANN,
+	"org.cw":                                 ICANN,
+	"cx":                                     ICANN,
+	"gov.cx":                                 ICANN,
+	"cy":                                     ICANN,
+	"ac.cy":                                  ICANN,
+	"biz.cy":                                 ICANN,
+	"com.cy":                                 ICANN,
+	"ekloges.cy":                             ICANN,
+	"gov.cy":                                 ICANN,
+	"ltd.cy":                                 ICANN,
+	"mil.cy":                                 ICANN,
+	"net.cy":                                 ICANN,
+	"org.cy":                                 ICANN,
+	"press.cy":                               ICANN,
+	"pro.cy":                                 ICANN,
+	"tm.cy":                                  ICANN,
+	"cz":                                     ICANN,
+	"gov.cz":                                 ICANN,
+	"de":                                     ICANN,
+	"dj":                                     ICANN,
+	"dk":                                     ICANN,
+	"dm":                                     ICANN,
+	"co.dm":                                  ICANN,
+	"com.dm":                                 ICANN,
+	"edu.dm":                                 ICANN,
+	"gov.dm":                                 ICANN,
+	"net.dm":                                 ICANN,
+	"org.dm":                                 ICANN,
+	"do":                                     ICANN,
+	"art.do":                                 ICANN,
+	"com.do":                                 ICANN,
+	"edu.do":                                 ICANN,
+	"gob.do":                                 ICANN,
+	"gov.do":                                 ICANN,
+	"mil.do":                                 ICANN,
+	"net.do":                                 ICANN,
+	"org.do":                                 ICANN,
+	"sld.do":                                 ICANN,
+	"web.do":                                 ICANN,
+	"dz":                                     ICANN,
+	"art.dz":                                 ICANN,
+	"asso.dz":                                ICANN,
+	"com.dz":                                 ICANN,
+	"edu.dz":                                 ICANN,
+	"gov.dz":                                 ICANN,
+	"net.dz":                                 ICANN,
+	"org.dz":                                 ICANN,
+	"pol.dz":                                 ICANN,
+	"soc.dz":                                 ICANN,
+	"tm.dz":                                  ICANN,
+	"ec":                                     ICANN,
+	"abg.ec":                                 ICANN,
+	"adm.ec":                                 ICANN,
+	"agron.ec":                               ICANN,
+	"arqt.ec":                                ICANN,
+	"art.ec":                                 ICANN,
+	"bar.ec":                                 ICANN,
+	"chef.ec":                                ICANN,
+	"com.ec":                                 ICANN,
+	"cont.ec":                                ICANN,
+	"cpa.ec":                                 ICANN,
+	"cue.ec":                                 ICANN,
+	"dent.ec":                                ICANN,
+	"dgn.ec":                                 ICANN,
+	"disco.ec":                               ICANN,
+	"doc.ec":                                 ICANN,
+	"edu.ec":                                 ICANN,
+	"eng.ec":                                 ICANN,
+	"esm.ec":                                 ICANN,
+	"fin.ec":                                 ICANN,
+	"fot.ec":                                 ICANN,
+	"gal.ec":                                 ICANN,
+	"gob.ec":                                 ICANN,
+	"gov.ec":                                 ICANN,
+	"gye.ec":                                 ICANN,
+	"ibr.ec":                                 ICANN,
+	"info.ec":                                ICANN,
+	"k12.ec":                                 ICANN,
+	"lat.ec":                                 ICANN,
+	"loj.ec":                                 ICANN,
+	"med.ec":                                 ICANN,
+	"mil.ec":                                 ICANN,
+	"mktg.ec":                                ICANN,
+	"mon.ec":                                 ICANN,
+	"net.ec":                                 ICANN,
+	"ntr.ec":                                 ICANN,
+	"odont.ec":                               ICANN,
+	"org.ec":                                 ICANN,
+	"pro.ec":                                 ICANN,
+	"prof.ec":                                ICANN,
+	"psic.ec":                                ICANN,
+	"psiq.ec":                                ICANN,
+	"pub.ec":                                 ICANN,
+	"rio.ec":                                 ICANN,
+	"rrpp.ec":                                ICANN,
+	"sal.ec":                                 ICANN,
+	"tech.ec":                                ICANN,
+	"tul.ec":                                 ICANN,
+	"tur.ec":                                 ICANN,
+	"uio.ec":                                 ICANN,
+	"vet.ec":                                 ICANN,
+	"xxx.ec":                                 ICANN,
+	"edu":                                    ICANN,
+	"ee":                                     ICANN,
+	"aip.ee":                                 ICANN,
+	"com.ee":                                 ICANN,
+	"edu.ee":                                 ICANN,
+	"fie.ee":                                 ICANN,
+	"gov.ee":                                 ICANN,
+	"lib.ee":                                 ICANN,
+	"med.ee":                                 ICANN,
+	"org.ee":                                 ICANN,
+	"pri.ee":                                 ICANN,
+	"riik.ee":                                ICANN,
+	"eg":                                     ICANN,
+	"ac.eg":                                  ICANN,
+	"com.eg":                                 ICANN,
+	"edu.eg":                                 ICANN,
+	"eun.eg":                                 ICANN,
+	"gov.eg":                                 ICANN,
+	"info.eg":                                ICANN,
+	"me.eg":                                  ICANN,
+	"mil.eg":                                 ICANN,
+	"name.eg":                                ICANN,
+	"net.eg":                                 ICANN,
+	"org.eg":                                 ICANN,
+	"sci.eg":                                 ICANN,
+	"sport.eg":                               ICANN,
+	"tv.eg":                                  ICANN,
+	"*.er":                                   ICANN,
+	"es":                                     ICANN,
+	"com.es":                                 ICANN,
+	"edu.es":                                 ICANN,
+	"gob.es":                                 ICANN,
+	"nom.es":                                 ICANN,
+	"org.es":                                 ICANN,
+	"et":                                     ICANN,
+	"biz.et":                                 ICANN,
+	"com.et":                                 ICANN,
+	"edu.et":                                 ICANN,
+	"gov.et":                                 ICANN,
+	"info.et":                                ICANN,
+	"name.et":                                ICANN,
+	"net.et":                                 ICANN,
+	"org.et":                                 ICANN,
+	"eu":                                     ICANN,
+	"fi":                                     ICANN,
+	"aland.fi":                               ICANN,
+	"fj":                                     ICANN,
+	"ac.fj":                                  ICANN,
+	"biz.fj":                                 ICANN,
+	"com.fj":                                 ICANN,
+	"edu.fj":                                 ICANN,
+	"gov.fj":                                 ICANN,
+	"id.fj":                                  ICANN,
+	"info.fj":                                ICANN,
+	"mil.fj":                                 ICANN,
+	"name.fj":                                ICANN,
+	"net.fj":                                 ICANN,
+	"org.fj":                                 ICANN,
+	"pro.fj":                                 ICANN,
+	"*.fk":                                   ICANN,
+	"fm":                                     ICANN,
+	"com.fm":                                 ICANN,
+	"edu.fm":                                 ICANN,
+	"net.fm":                                 ICANN,
+	"org.fm":                                 ICANN,
+	"fo":                                     ICANN,
+	"fr":                                     ICANN,
+	"asso.fr":                                ICANN,
+	"com.fr":                                 ICANN,
+	"gouv.fr":                                ICANN,
+	"nom.fr":                                 ICANN,
+	"prd.fr":                                 ICANN,
+	"tm.fr":                                  ICANN,
+	"avoues.fr":                              ICANN,
+	"cci.fr":                                 ICANN,
+	"greta.fr":                               ICANN,
+	"huissier-justice.fr":                    ICANN,
+	"ga":                                     ICANN,
+	"gb":                                     ICANN,
+	"gd":                                     ICANN,
+	"edu.gd":                                 ICANN,
+	"gov.gd":                                 ICANN,
+	"ge":                                     ICANN,
+	"com.ge":                                 ICANN,
+	"edu.ge":                                 ICANN,
+	"gov.ge":                                 ICANN,
+	"net.ge":                                 ICANN,
+	"org.ge":                                 ICANN,
+	"pvt.ge":                                 ICANN,
+	"school.ge":                              ICANN,
+	"gf":                                     ICANN,
+	"gg":                                     ICANN,
+	"co.gg":                                  ICANN,
+	"net.gg":                                 ICANN,
+	"org.gg":                                 ICANN,
+	"gh":                                     ICANN,
+	"biz.gh":                                 ICANN,
+	"com.gh":                                 ICANN,
+	"edu.gh":                                 ICANN,
+	"gov.gh":                                 ICANN,
+	"mil.gh":                                 ICANN,
+	"net.gh":                                 ICANN,
+	"org.gh":                                 ICANN,
+	"gi":                                     ICANN,
+	"com.gi":                                 ICANN,
+	"edu.gi":                                 ICANN,
+	"gov.gi":                                 ICANN,
+	"ltd.gi":                                 ICANN,
+	"mod.gi":                                 ICANN,
+	"org.gi":                                 ICANN,
+	"gl":                                     ICANN,
+	"co.gl":                                  ICANN,
+	"com.gl":                                 ICANN,
+	"edu.gl":                                 ICANN,
+	"net.gl":                                 ICANN,
+	"org.gl":                                 ICANN,
+	"gm":                                     ICANN,
+	"gn":                                     ICANN,
+	"ac.gn":                                  ICANN,
+	"com.gn":                                 ICANN,
+	"edu.gn":                                 ICANN,
+	"gov.gn":                                 ICANN,
+	"net.gn":                                 ICANN,
+	"org.gn":                                 ICANN,
+	"gov":                                    ICANN,
+	"gp":                                     ICANN,
+	"asso.gp":                                ICANN,
+	"com.gp":                                 ICANN,
+	"edu.gp":                                 ICANN,
+	"mobi.gp":                                ICANN,
+	"net.gp":                                 ICANN,
+	"org.gp":                                 ICANN,
+	"gq":                                     ICANN,
+	"gr":                                     ICANN,
+	"com.gr":                                 ICANN,
+	"edu.gr":                                 ICANN,
+	"gov.gr":                                 ICANN,
+	"net.gr":                                 ICANN,
+	"org.gr":                                 ICANN,
+	"gs":                                     ICANN,
+	"gt":                                     ICANN,
+	"com.gt":                                 ICANN,
+	"edu.gt":                                 ICANN,
+	"gob.gt":                                 ICANN,
+	"ind.gt":                                 ICANN,
+	"mil.gt":                                 ICANN,
+	"net.gt":                                 ICANN,
+	"org.gt":                                 ICANN,
+	"gu":                                     ICANN,
+	"com.gu":                                 ICANN,
+	"edu.gu":                                 ICANN,
+	"gov.gu":                                 ICANN,
+	"guam.gu":                                ICANN,
+	"info.gu":                                ICANN,
+	"net.gu":                                 ICANN,
+	"org.gu":                                 ICANN,
+	"web.gu":                                 ICANN,
+	"gw":                                     ICANN,
+	"gy":                                     ICANN,
+	"co.gy":                                  ICANN,
+	"com.gy":                                 ICANN,
+	"edu.gy":                                 ICANN,
+	"gov.gy":                                 ICANN,
+	"net.gy":                                 ICANN,
+	"org.gy":                                 ICANN,
+	"hk":                                     ICANN,
+	"com.hk":                                 ICANN,
+	"edu.hk":                                 ICANN,
+	"gov.hk":                                 ICANN,
+	"idv.hk":                                 ICANN,
+	"net.hk":                                 ICANN,
+	"org.hk":                                 ICANN,
+	"xn--ciqpn.hk":                           ICANN,
+	"xn--gmqw5a.hk":                          ICANN,
+	"xn--55qx5d.hk":                          ICANN,
+	"xn--mxtq1m.hk":                          ICANN,
+	"xn--lcvr32d.hk":                         ICANN,
+	"xn--wcvs22d.hk":                         ICANN,
+	"xn--gmq050i.hk":                         ICANN,
+	"xn--uc0atv.hk":                          ICANN,
+	"xn--uc0ay4a.hk":                         ICANN,
+	"xn--od0alg.hk":                          ICANN,
+	"xn--zf0avx.hk":                          ICANN,
+	"xn--mk0axi.hk":                          ICANN,
+	"xn--tn0ag.hk":                           ICANN,
+	"xn--od0aq3b.hk":                         ICANN,
+	"xn--io0a7i.hk":                          ICANN,
+	"hm":                                     ICANN,
+	"hn":                                     ICANN,
+	"com.hn":                                 ICANN,
+	"edu.hn":                                 ICANN,
+	"gob.hn":                                 ICANN,
+	"mil.hn":                                 ICANN,
+	"net.hn":                                 ICANN,
+	"org.hn":                                 ICANN,
+	"hr":                                     ICANN,
+	"com.hr":                                 ICANN,
+	"from.hr":                                ICANN,
+	"iz.hr":                                  ICANN,
+	"name.hr":                                ICANN,
+	"ht":                                     ICANN,
+	"adult.ht":                               ICANN,
+	"art.ht":                                 ICANN,
+	"asso.ht":                                ICANN,
+	"com.ht":                                 ICANN,
+	"coop.ht":                                ICANN,
+	"edu.ht":                                 ICANN,
+	"firm.ht":                                ICANN,
+	"gouv.ht":                                ICANN,
+	"info.ht":                                ICANN,
+	"med.ht":                                 ICANN,
+	"net.ht":                                 ICANN,
+	"org.ht":                                 ICANN,
+	"perso.ht":                               ICANN,
+	"pol.ht":                                 ICANN,
+	"pro.ht":                                 ICANN,
+	"rel.ht":                                 ICANN,
+	"shop.ht":                                ICANN,
+	"hu":                                     ICANN,
+	"2000.hu":                                ICANN,
+	"agrar.hu":                               ICANN,
+	"bolt.hu":                                ICANN,
+	"casino.hu":                              ICANN,
+	"city.hu":                                ICANN,
+	"co.hu":                                  ICANN,
+	"erotica.hu":                             ICANN,
+	"erotika.hu":                             ICANN,
+	"film.hu":                                ICANN,
+	"forum.hu":                               ICANN,
+	"games.hu":                               ICANN,
+	"hotel.hu":                               ICANN,
+	"info.hu":                                ICANN,
+	"ingatlan.hu":                            ICANN,
+	"jogasz.hu":                              ICANN,
+	"konyvelo.hu":                            ICANN,
+	"lakas.hu":                               ICANN,
+	"media.hu":                               ICANN,
+	"news.hu":                                ICANN,
+	"org.hu":                                 ICANN,
+	"priv.hu":                                ICANN,
+	"reklam.hu":                              ICANN,
+	"sex.hu":                                 ICANN,
+	"shop.hu":                                ICANN,
+	"sport.hu":                               ICANN,
+	"suli.hu":                                ICANN,
+	"szex.hu":                                ICANN,
+	"tm.hu":                                  ICANN,
+	"tozsde.hu":                              ICANN,
+	"utazas.hu":                              ICANN,
+	"video.hu":                               ICANN,
+	"id":                                     ICANN,
+	"ac.id":                                  ICANN,
+	"biz.id":                                 ICANN,
+	"co.id":                                  ICANN,
+	"desa.id":                                ICANN,
+	"go.id":                                  ICANN,
+	"kop.id":                                 ICANN,
+	"mil.id":                                 ICANN,
+	"my.id":                                  ICANN,
+	"net.id":                                 ICANN,
+	"or.id":                                  ICANN,
+	"ponpes.id":                              ICANN,
+	"sch.id":                                 ICANN,
+	"web.id":                                 ICANN,
+	"xn--9tfky.id":                           ICANN,
+	"ie":                                     ICANN,
+	"gov.ie":                                 ICANN,
+	"il":                                     ICANN,
+	"ac.il":                                  ICANN,
+	"co.il":                                  ICANN,
+	"gov.il":                                 ICANN,
+	"idf.il":                                 ICANN,
+	"k12.il":                                 ICANN,
+	"muni.il":                                ICANN,
+	"net.il":                                 ICANN,
+	"org.il":                                 ICANN,
+	"xn--4dbrk0ce":                           ICANN,
+	"xn--4dbgdty6c.xn--4dbrk0ce":             ICANN,
+	"xn--5dbhl8d.xn--4dbrk0ce":               ICANN,
+	"xn--8dbq2a.xn--4dbrk0ce":                ICANN,
+	"xn--hebda8b.xn--4dbrk0ce":               ICANN,
+	"im":                                     ICANN,
+	"ac.im":                                  ICANN,
+	"co.im":                                  ICANN,
+	"ltd.co.im":                              ICANN,
+	"plc.co.im":                              ICANN,
+	"com.im":                                 ICANN,
+	"net.im":                                 ICANN,
+	"org.im":                                 ICANN,
+	"tt.im":                                  ICANN,
+	"tv.im":                                  ICANN,
+	"in":                                     ICANN,
+	"5g.in":                                  ICANN,
+	"6g.in":                                  ICANN,
+	"ac.in":                                  ICANN,
+	"ai.in":                                  ICANN,
+	"am.in":                                  ICANN,
+	"bank.in":                                ICANN,
+	"bihar.in":                               ICANN,
+	"biz.in":                                 ICANN,
+	"business.in":                            ICANN,
+	"ca.in":                                  ICANN,
+	"cn.in":                                  ICANN,
+	"co.in":                                  ICANN,
+	"com.in":                                 ICANN,
+	"coop.in":                                ICANN,
+	"cs.in":                                  ICANN,
+	"delhi.in":                               ICANN,
+	"dr.in":                                  ICANN,
+	"edu.in":                                 ICANN,
+	"er.in":                                  ICANN,
+	"fin.in":                                 ICANN,
+	"firm.in":                                ICANN,
+	"gen.in":                                 ICANN,
+	"gov.in":                                 ICANN,
+	"gujarat.in":                             ICANN,
+	"ind.in":                                 ICANN,
+	"info.in":                                ICANN,
+	"int.in":                                 ICANN,
+	"internet.in":                            ICANN,
+	"io.in":                                  ICANN,
+	"me.in":                                  ICANN,
+	"mil.in":                                 ICANN,
+	"net.in":                                 ICANN,
+	"nic.in":                                 ICANN,
+	"org.in":                                 ICANN,
+	"pg.in":                                  ICANN,
+	"post.in":                                ICANN,
+	"pro.in":                                 ICANN,
+	"res.in":                                 ICANN,
+	"travel.in":                              ICANN,
+	"tv.in":                                  ICANN,
+	"uk.in":                                  ICANN,
+	"up.in":                                  ICANN,
+	"us.in":                                  ICANN,
+	"info":                                   ICANN,
+	"int":                                    ICANN,
+	"eu.int":                                 ICANN,
+	"io":                                     ICANN,
+	"co.io":                                  ICANN,
+	"com.io":                                 ICANN,
+	"edu.io":                                 ICANN,
+	"gov.io":                                 ICANN,
+	"mil.io":                                 ICANN,
+	"net.io":                                 ICANN,
+	"nom.io":                                 ICANN,
+	"org.io":                                 ICANN,
+	"iq":                                     ICANN,
+	"com.iq":                                 ICANN,
+	"edu.iq":                                 ICANN,
+	"gov.iq":                                 ICANN,
+	"mil.iq":                                 ICANN,
+	"net.iq":                                 ICANN,
+	"org.iq":                                 ICANN,
+	"ir":                                     ICANN,
+	"ac.ir":                                  ICANN,
+	"co.ir":                                  ICANN,
+	"gov.ir":                                 ICANN,
+	"id.ir":                                  ICANN,
+	"net.ir":                                 ICANN,
+	"org.ir":                                 ICANN,
+	"sch.ir":                                 ICANN,
+	"xn--mgba3a4f16a.ir":                     ICANN,
+	"xn--mgba3a4fra.ir":                      ICANN,
+	"is":                                     ICANN,
+	"it":                                     ICANN,
+	"edu.it":                                 ICANN,
+	"gov.it":                                 ICANN,
+	"abr.it":                                 ICANN,
+	"abruzzo.it":                             ICANN,
+	"aosta-valley.it":                        ICANN,
+	"aostavalley.it":                         ICANN,
+	"bas.it":                                 ICANN,
+	"basilicata.it":                          ICANN,
+	"cal.it":                                 ICANN,
+	"calabria.it":                            ICANN,
+	"cam.it":                                 ICANN,
+	"campania.it":                            ICANN,
+	"emilia-romagna.it":                      ICANN,
+	"emiliaromagna.it":                       ICANN,
+	"emr.it":                                 ICANN,
+	"friuli-v-giulia.it":                     ICANN,
+	"friuli-ve-giulia.it":                    ICANN,
+	"friuli-vegiulia.it":                     ICANN,
+	"friuli-venezia-giulia.it":               ICANN,
+	"friuli-veneziagiulia.it":                ICANN,
+	"friuli-vgiulia.it":                      ICANN,
+	"friuliv-giulia.it":                      ICANN,
+	"friulive-giulia.it":                     ICANN,
+	"friulivegiulia.it":                      ICANN,
+	"friulivenezia-giulia.it":                ICANN,
+	"friuliveneziagiulia.it":                 ICANN,
+	"friulivgiulia.it":                       ICANN,
+	"fvg.it":                                 ICANN,
+	"laz.it":                                 ICANN,
+	"lazio.it":                               ICANN,
+	"lig.it":                                 ICANN,
+	"liguria.it":                             ICANN,
+	"lom.it":                                 ICANN,
+	"lombardia.it":                           ICANN,
+	"lombardy.it":                            ICANN,
+	"lucania.it":                             ICANN,
+	"mar.it":                                 ICANN,
+	"marche.it":                              ICANN,
+	"mol.it":                                 ICANN,
+	"molise.it":                              ICANN,
+	"piedmont.it":                            ICANN,
+	"piemonte.it":                            ICANN,
+	"pmn.it":                                 ICANN,
+	"pug.it":                                 ICANN,
+	"puglia.it":                              ICANN,
+	"sar.it":                                 ICANN,
+	"sardegna.it":                            ICANN,
+	"sardinia.it":                            ICANN,
+	"sic.it":                                 ICANN,
+	"sicilia.it":                             ICANN,
+	"sicily.it":                              ICANN,
+	"taa.it":                                 ICANN,
+	"tos.it":                                 ICANN,
+	"toscana.it":                             ICANN,
+	"trentin-sud-tirol.it":                   ICANN,
+	"xn--trentin-sd-tirol-rzb.it":            ICANN,
+	"trentin-sudtirol.it":                    ICANN,
+	"xn--trentin-sdtirol-7vb.it":             ICANN,
+	"trentin-sued-tirol.it":                  ICANN,
+	"trentin-suedtirol.it":                   ICANN,
+	"trentino.it":                            ICANN,
+	"trentino-a-adige.it":                    ICANN,
+	"trentino-aadige.it":                     ICANN,
+	"trentino-alto-adige.it":                 ICANN,
+	"trentino-altoadige.it":                  ICANN,
+	"trentino-s-tirol.it":                    ICANN,
+	"trentino-stirol.it":                     ICANN,
+	"trentino-sud-tirol.it":                  ICANN,
+	"xn--trentino-sd-tirol-c3b.it":           ICANN,
+	"trentino-sudtirol.it":                   ICANN,
+	"xn--trentino-sdtirol-szb.it":            ICANN,
+	"trentino-sued-tirol.it":                 ICANN,
+	"trentino-suedtirol.it":                  ICANN,
+	"trentinoa-adige.it":                     ICANN,
+	"trentinoaadige.it":                      ICANN,
+	"trentinoalto-adige.it":                  ICANN,
+	"trentinoaltoadige.it":                   ICANN,
+	"trentinos-tirol.it":                     ICANN,
+	"trentinostirol.it":                      ICANN,
+	"trentinosud-tirol.it":                   ICANN,
+	"xn--trentinosd-tirol-rzb.it":            ICANN,
+	"trentinosudtirol.it":                    ICANN,
+	"xn--trentinosdtirol-7vb.it":             ICANN,
+	"trentinosued-tirol.it":                  ICANN,
+	"trentinosuedtirol.it":                   ICANN,
+	"trentinsud-tirol.it":                    ICANN,
+	"xn--trentinsd-tirol-6vb.it":             ICANN,
+	"trentinsudtirol.it":                     ICANN,
+	"xn--trentinsdtirol-nsb.it":              ICANN,
+	"trentinsued-tirol.it":                   ICANN,
+	"trentinsuedtirol.it":                    ICANN,
+	"tuscany.it":                             ICANN,
+	"umb.it":                                 ICANN,
+	"umbria.it":                              ICANN,
+	"val-d-aosta.it":                         ICANN,
+	"val-daosta.it":                          ICANN,
+	"vald-aosta.it":                          ICANN,
+	"valdaosta.it":                           ICANN,
+	"valle-aosta.it":                         ICANN,
+	"valle-d-aosta.it":                       ICANN,
+	"valle-daosta.it":                        ICANN,
+	"valleaosta.it":                          ICANN,
+	"valled-aosta.it":                        ICANN,
+	"valledaosta.it":                         ICANN,
+	"vallee-aoste.it":                        ICANN,
+	"xn--valle-aoste-ebb.it":                 ICANN,
+	"vallee-d-aoste.it":                      ICANN,
+	"xn--valle-d-aoste-ehb.it":               ICANN,
+	"valleeaoste.it":                         ICANN,
+	"xn--valleaoste-e7a.it":                  ICANN,
+	"valleedaoste.it":                        ICANN,
+	"xn--valledaoste-ebb.it":                 ICANN,
+	"vao.it":                                 ICANN,
+	"vda.it":                                 ICANN,
+	"ven.it":                                 ICANN,
+	"veneto.it":                              ICANN,
+	"ag.it":                                  ICANN,
+	"agrigento.it":                           ICANN,
+	"al.it":                                  ICANN,
+	"alessandria.it":                         ICANN,
+	"alto-adige.it":                          ICANN,
+	"altoadige.it":                           ICANN,
+	"an.it":                                  ICANN,
+	"ancona.it":                              ICANN,
+	"andria-barletta-trani.it":               ICANN,
+	"andria-trani-barletta.it":               ICANN,
+	"andriabarlettatrani.it":                 ICANN,
+	"andriatranibarletta.it":                 ICANN,
+	"ao.it":                                  ICANN,
+	"aosta.it":                               ICANN,
+	"aoste.it":                               ICANN,
+	"ap.it":                                  ICANN,
+	"aq.it":                                  ICANN,
+	"aquila.it":                              ICANN,
+	"ar.it":                                  ICANN,
+	"arezzo.it":                              ICANN,
+	"ascoli-piceno.it":                       ICANN,
+	"ascolipiceno.it":                        ICANN,
+	"asti.it":                                ICANN,
+	"at.it":                                  ICANN,
+	"av.it":                                  ICANN,
+	"avellino.it":                            ICANN,
+	"ba.it":                                  ICANN,
+	"balsan.it":                              ICANN,
+	"balsan-sudtirol.it":                     ICANN,
+	"xn--balsan-sdtirol-nsb.it":              ICANN,
+	"balsan-suedtirol.it":                    ICANN,
+	"bari.it":                                ICANN,
+	"barletta-trani-andria.it":               ICANN,
+	"barlettatraniandria.it":                 ICANN,
+	"belluno.it":                             ICANN,
+	"benevento.it":                           ICANN,
+	"bergamo.it":                             ICANN,
+	"bg.it":                                  ICANN,
+	"bi.it":                                  ICANN,
+	"biella.it":                              ICANN,
+	"bl.it":                                  ICANN,
+	"bn.it":                                  ICANN,
+	"bo.it":                                  ICANN,
+	"bologna.it":                             ICANN,
+	"bolzano.it":                             ICANN,
+	"bolzano-altoadige.it":                   ICANN,
+	"bozen.it":                               ICANN,
+	"bozen-sudtirol.it":                      ICANN,
+	"xn--bozen-sdtirol-2ob.it":               ICANN,
+	"bozen-suedtirol.it":                     ICANN,
+	"br.it":                                  ICANN,
+	"brescia.it":                             ICANN,
+	"brindisi.it":                            ICANN,
+	"bs.it":                                  ICANN,
+	"bt.it":                                  ICANN,
+	"bulsan.it":                              ICANN,
+	"bulsan-sudtirol.it":                     ICANN,
+	"xn--bulsan-sdtirol-nsb.it":              ICANN,
+	"bulsan-suedtirol.it":                    ICANN,
+	"bz.it":                                  ICANN,
+	"ca.it":                                  ICANN,
+	"cagliari.it":                            ICANN,
+	"caltanissetta.it":                       ICANN,
+	"campidano-medio.it":                     ICANN,
+	"campidanomedio.it":                      ICANN,
+	"campobasso.it":                          ICANN,
+	"carbonia-iglesias.it":                   ICANN,
+	"carboniaiglesias.it":                    ICANN,
+	"carrara-massa.it":                       ICANN,
+	"carraramassa.it":                        ICANN,
+	"caserta.it":                             ICANN,
+	"catania.it":                             ICANN,
+	"catanzaro.it":                           ICANN,
+	"cb.it":                                  ICANN,
+	"ce.it":                                  ICANN,
+	"cesena-forli.it":                        ICANN,
+	"xn--cesena-forl-mcb.it":                 ICANN,
+	"cesenaforli.it":                         ICANN,
+	"xn--cesenaforl-i8a.it":                  ICANN,
+	"ch.it":                                  ICANN,
+	"chieti.it":                              ICANN,
+	"ci.it":                                  ICANN,
+	"cl.it":                                  ICANN,
+	"cn.it":                                  ICANN,
+	"co.it":                                  ICANN,
+	"como.it":                                ICANN,
+	"cosenza.it":                             ICANN,
+	"cr.it":                                  ICANN,
+	"cremona.it":                             ICANN,
+	"crotone.it":                             ICANN,
+	"cs.it":                                  ICANN,
+	"ct.it":                                  ICANN,
+	"cuneo.it":                               ICANN,
+	"cz.it":                                  ICANN,
+	"dell-ogliastra.it":                      ICANN,
+	"dellogliastra.it":                       ICANN,
+	"en.it":                                  ICANN,
+	"enna.it":                                ICANN,
+	"fc.it":                                  ICANN,
+	"fe.it":                                  ICANN,
+	"fermo.it":                               ICANN,
+	"ferrara.it":                             ICANN,
+	"fg.it":                                  ICANN,
+	"fi.it":                                  ICANN,
+	"firenze.it":                             ICANN,
+	"florence.it":                            ICANN,
+	"fm.it":                                  ICANN,
+	"foggia.it":                              ICANN,
+	"forli-cesena.it":                        ICANN,
+	"xn--forl-cesena-fcb.it":                 ICANN,
+	"forlicesena.it":                         ICANN,
+	"xn--forlcesena-c8a.it":                  ICANN,
+	"fr.it":                                  ICANN,
+	"frosinone.it":                           ICANN,
+	"ge.it":                                  ICANN,
+	"genoa.it":                               ICANN,
+	"genova.it":                              ICANN,
+	"go.it":                                  ICANN,
+	"gorizia.it":                             ICANN,
+	"gr.it":                                  ICANN,
+	"grosseto.it":                            ICANN,
+	"iglesias-carbonia.it":                   ICANN,
+	"iglesiascarbonia.it":                    ICANN,
+	"im.it":                                  ICANN,
+	"imperia.it":                             ICANN,
+	"is.it":                                  ICANN,
+	"isernia.it":                             ICANN,
+	"kr.it":                                  ICANN,
+	"la-spezia.it":                           ICANN,
+	"laquila.it":                             ICANN,
+	"laspezia.it":                            ICANN,
+	"latina.it":                              ICANN,
+	"lc.it":                                  ICANN,
+	"le.it":                                  ICANN,
+	"lecce.it":                               ICANN,
+	"lecco.it":                               ICANN,
+	"li.it":                                  ICANN,
+	"livorno.it":                             ICANN,
+	"lo.it":                                  ICANN,
+	"lodi.it":                                ICANN,
+	"lt.it":                                  ICANN,
+	"lu.it":                                  ICANN,
+	"lucca.it":                               ICANN,
+	"macerata.it":                            ICANN,
+	"mantova.it":                             ICANN,
+	"massa-carrara.it":                       ICANN,
+	"massacarrara.it":                        ICANN,
+	"matera.it":                              ICANN,
+	"mb.it":                                  ICANN,
+	"mc.it":                                  ICANN,
+	"me.it":                                  ICANN,
+	"medio-campidano.it":                     ICANN,
+	"mediocampidano.it":                      ICANN,
+	"messina.it":                             ICANN,
+	"mi.it":                                  ICANN,
+	"milan.it":                               ICANN,
+	"milano.it":                              ICANN,
+	"mn.it":                                  ICANN,
+	"mo.it":                                  ICANN,
+	"modena.it":                              ICANN,
+	"monza.it":                               ICANN,
+	"monza-brianza.it":                       ICANN,
+	"monza-e-della-brianza.it":               ICANN,
+	"monzabrianza.it":                        ICANN,
+	"monzaebrianza.it":                       ICANN,
+	"monzaedellabrianza.it":                  ICANN,
+	"ms.it":                                  ICANN,
+	"mt.it":                                  ICANN,
+	"na.it":                                  ICANN,
+	"naples.it":                              ICANN,
+	"napoli.it":                              ICANN,
+	"no.it":                                  ICANN,
+	"novara.it":                              ICANN,
+	"nu.it":                                  ICANN,
+	"nuoro.it":                               ICANN,
+	"og.it":                                  ICANN,
+	"ogliastra.it":                           ICANN,
+	"olbia-tempio.it":                        ICANN,
+	"olbiatempio.it":                         ICANN,
+	"or.it":                                  ICANN,
+	"oristano.it":                            ICANN,
+	"ot.it":                                  ICANN,
+	"pa.it":                                  ICANN,
+	"padova.it":                              ICANN,
+	"padua.it":                               ICANN,
+	"palermo.it":                             ICANN,
+	"parma.it":                               ICANN,
+	"pavia.it":                               ICANN,
+	"pc.it":                                  ICANN,
+	"pd.it":                                  ICANN,
+	"pe.it":                                  ICANN,
+	"perugia.it":                             ICANN,
+	"pesaro-urbino.it":                       ICANN,
+	"pesarourbino.it":                        ICANN,
+	"pescara.it":                             ICANN,
+	"pg.it":                                  ICANN,
+	"pi.it":                                  ICANN,
+	"piacenza.it":                            ICANN,
+	"pisa.it":                                ICANN,
+	"pistoia.it":                             ICANN,
+	"pn.it":                                  ICANN,
+	"po.it":                                  ICANN,
+	"pordenone.it":                           ICANN,
+	"potenza.it":                             ICANN,
+	"pr.it":                                  ICANN,
+	"prato.it":                               ICANN,
+	"pt.it":                                  ICANN,
+	"pu.it":                                  ICANN,
+	"pv.it":                                  ICANN,
+	"pz.it":                                  ICANN,
+	"ra.it":                                  ICANN,
+	"ragusa.it":                              ICANN,
+	"ravenna.it":                             ICANN,
+	"rc.it":                                  ICANN,
+	"re.it":                                  ICANN,
+	"reggio-calabria.it":                     ICANN,
+	"reggio-emilia.it":                       ICANN,
+	"reggiocalabria.it":                      ICANN,
+	"reggioemilia.it":                        ICANN,
+	"rg.it":                                  ICANN,
+	"ri.it":                                  ICANN,
+	"rieti.it":                               ICANN,
+	"rimini.it":                              ICANN,
+	"rm.it":                                  ICANN,
+	"rn.it":                                  ICANN,
+	"ro.it":                                  ICANN,
+	"roma.it":                                ICANN,
+	"rome.it":                                ICANN,
+	"rovigo.it":                              ICANN,
+	"sa.it":                                  ICANN,
+	"salerno.it":                             ICANN,
+	"sassari.it":                             ICANN,
+	"savona.it":                              ICANN,
+	"si.it":                                  ICANN,
+	"siena.it":                               ICANN,
+	"siracusa.it":                            ICANN,
+	"so.it":                                  ICANN,
+	"sondrio.it":                             ICANN,
+	"sp.it":                                  ICANN,
+	"sr.it":                                  ICANN,
+	"ss.it":                                  ICANN,
+	"xn--sdtirol-n2a.it":                     ICANN,
+	"suedtirol.it":                           ICANN,
+	"sv.it":                                  ICANN,
+	"ta.it":                                  ICANN,
+	"taranto.it":                             ICANN,
+	"te.it":                                  ICANN,
+	"tempio-olbia.it":                        ICANN,
+	"tempioolbia.it":                         ICANN,
+	"teramo.it":                              ICANN,
+	"terni.it":                               ICANN,
+	"tn.it":                                  ICANN,
+	"to.it":                                  ICANN,
+	"torino.it":                              ICANN,
+	"tp.it":                                  ICANN,
+	"tr.it":                                  ICANN,
+	"trani-andria-barletta.it":               ICANN,
+	"trani-barletta-andria.it":               ICANN,
+	"traniandriabarletta.it":                 ICANN,
+	"tranibarlettaandria.it":                 ICANN,
+	"trapani.it":                             ICANN,
+	"trento.it":                              ICANN,
+	"treviso.it":                             ICANN,
+	"trieste.it":                             ICANN,
+	"ts.it":                                  ICANN,
+	"turin.it":                               ICANN,
+	"tv.it":                                  ICANN,
+	"ud.it":                                  ICANN,
+	"udine.it":                               ICANN,
+	"urbino-pesaro.it":                       ICANN,
+	"urbinopesaro.it":                        ICANN,
+	"va.it":                                  ICANN,
+	"varese.it":                              ICANN,
+	"vb.it":                                  ICANN,
+	"vc.it":                                  ICANN,
+	"ve.it":                                  ICANN,
+	"venezia.it":                             ICANN,
+	"venice.it":                              ICANN,
+	"verbania.it":                            ICANN,
+	"vercelli.it":                            ICANN,
+	"verona.it":                              ICANN,
+	"vi.it":                                  ICANN,
+	"vibo-valentia.it":                       ICANN,
+	"vibovalentia.it":                        ICANN,
+	"vicenza.it":                             ICANN,
+	"viterbo.it":                             ICANN,
+	"vr.it":                                  ICANN,
+	"vs.it":                                  ICANN,
+	"vt.it":                                  ICANN,
+	"vv.it":                                  ICANN,
+	"je":                                     ICANN,
+	"co.je":                                  ICANN,
+	"net.je":                                 ICANN,
+	"org.je":                                 ICANN,
+	"*.jm":                                   ICANN,
+	"jo":                                     ICANN,
+	"agri.jo":                                ICANN,
+	"ai.jo":                                  ICANN,
+	"com.jo":                                 ICANN,
+	"edu.jo":                                 ICANN,
+	"eng.jo":                                 ICANN,
+	"fm.jo":                                  ICANN,
+	"gov.jo":                                 ICANN,
+	"mil.jo":                                 ICANN,
+	"net.jo":                                 ICANN,
+	"org.jo":                                 ICANN,
+	"per.jo":                                 ICANN,
+	"phd.jo":                                 ICANN,
+	"sch.jo":                                 ICANN,
+	"tv.jo":                                  ICANN,
+	"jobs":                                   ICANN,
+	"jp":                                     ICANN,
+	"ac.jp":                                  ICANN,
+	"ad.jp":                                  ICANN,
+	"co.jp":                                  ICANN,
+	"ed.jp":                                  ICANN,
+	"go.jp":                                  ICANN,
+	"gr.jp":                                  ICANN,
+	"lg.jp":                                  ICANN,
+	"ne.jp":                                  ICANN,
+	"or.jp":                                  ICANN,
+	"aichi.jp":                               ICANN,
+	"akita.jp":                               ICANN,
+	"aomori.jp":                              ICANN,
+	"chiba.jp":                               ICANN,
+	"ehime.jp":                               ICANN,
+	"fukui.jp":                               ICANN,
+	"fukuoka.jp":                             ICANN,
+	"fukushima.jp":                           ICANN,
+	"gifu.jp":                                ICANN,
+	"gunma.jp":                               ICANN,
+	"hiroshima.jp":                           ICANN,
+	"hokkaido.jp":                            ICANN,
+	"hyogo.jp":                               ICANN,
+	"ibaraki.jp":                             ICANN,
+	"ishikawa.jp":                            ICANN,
+	"iwate.jp":                               ICANN,
+	"kagawa.jp":                              ICANN,
+	"kagoshima.jp":                           ICANN,
+	"kanagawa.jp":                            ICANN,
+	"kochi.jp":                               ICANN,
+	"kumamoto.jp":                            ICANN,
+	"kyoto.jp":                               ICANN,
+	"mie.jp":                                 ICANN,
+	"miyagi.jp":                              ICANN,
+	"miyazaki.jp":                            ICANN,
+	"nagano.jp":                              ICANN,
+	"nagasaki.jp":                            ICANN,
+	"nara.jp":                                ICANN,
+	"niigata.jp":                             ICANN,
+	"oita.jp":                                ICANN,
+	"okayama.jp":                             ICANN,
+	"okinawa.jp":                             ICANN,
+	"osaka.jp":                               ICANN,
+	"saga.jp":                                ICANN,
+	"saitama.jp":                             ICANN,
+	"shiga.jp":                               ICANN,
+	"shimane.jp":                             ICANN,
+	"shizuoka.jp":                            ICANN,
+	"tochigi.jp":                             ICANN,
+	"tokushima.jp":                           ICANN,
+	"tokyo.jp":                               ICANN,
+	"tottori.jp":                             ICANN,
+	"toyama.jp":                              ICANN,
+	"wakayama.jp":                            ICANN,
+	"yamagata.jp":                            ICANN,
+	"yamaguchi.jp":                           ICANN,
+	"yamanashi.jp":                           ICANN,
+	"xn--ehqz56n.jp":                         ICANN,
+	"xn--1lqs03n.jp":                         ICANN,
+	"xn--qqqt11m.jp":                         ICANN,
+	"xn--f6qx53a.jp":                         ICANN,
+	"xn--djrs72d6uy.jp":                      ICANN,
+	"xn--mkru45i.jp":                         ICANN,
+	"xn--0trq7p7nn.jp":                       ICANN,
+	"xn--5js045d.jp":                         ICANN,
+	"xn--kbrq7o.jp":                          ICANN,
+	"xn--pssu33l.jp":                         ICANN,
+	"xn--ntsq17g.jp":                         ICANN,
+	"xn--uisz3g.jp":                          ICANN,
+	"xn--6btw5a.jp":                          ICANN,
+	"xn--1ctwo.jp":                           ICANN,
+	"xn--6orx2r.jp":                          ICANN,
+	"xn--rht61e.jp":                          ICANN,
+	"xn--rht27z.jp":                          ICANN,
+	"xn--nit225k.jp":                         ICANN,
+	"xn--rht3d.jp":                           ICANN,
+	"xn--djty4k.jp":                          ICANN,
+	"xn--klty5x.jp":                          ICANN,
+	"xn--kltx9a.jp":                          ICANN,
+	"xn--kltp7d.jp":                          ICANN,
+	"xn--c3s14m.jp":                          ICANN,
+	"xn--vgu402c.jp":                         ICANN,
+	"xn--efvn9s.jp":                          ICANN,
+	"xn--1lqs71d.jp":                         ICANN,
+	"xn--4pvxs.jp":                           ICANN,
+	"xn--uuwu58a.jp":                         ICANN,
+	"xn--zbx025d.jp":                         ICANN,
+	"xn--8pvr4u.jp":                          ICANN,
+	"xn--5rtp49c.jp":                         ICANN,
+	"xn--ntso0iqx3a.jp":                      ICANN,
+	"xn--elqq16h.jp":                         ICANN,
+	"xn--4it168d.jp":                         ICANN,
+	"xn--klt787d.jp":                         ICANN,
+	"xn--rny31h.jp":                          ICANN,
+	"xn--7t0a264c.jp":                        ICANN,
+	"xn--uist22h.jp":                         ICANN,
+	"xn--8ltr62k.jp":                         ICANN,
+	"xn--2m4a15e.jp":                         ICANN,
+	"xn--32vp30h.jp":                         ICANN,
+	"xn--4it797k.jp":                         ICANN,
+	"xn--5rtq34k.jp":                         ICANN,
+	"xn--k7yn95e.jp":                         ICANN,
+	"xn--tor131o.jp":                         ICANN,
+	"xn--d5qv7z876c.jp":                      ICANN,
+	"*.kawasaki.jp":                          ICANN,
+	"!city.kawasaki.jp":                      ICANN,
+	"*.kitakyushu.jp":                        ICANN,
+	"!city.kitakyushu.jp":                    ICANN,
+	"*.kobe.jp":                              ICANN,
+	"!city.kobe.jp":                          ICANN,
+	"*.nagoya.jp":                            ICANN,
+	"!city.nagoya.jp":                        ICANN,
+	"*.sapporo.jp":                           ICANN,
+	"!city.sapporo.jp":                       ICANN,
+	"*.sendai.jp":                            ICANN,
+	"!city.sendai.jp":                        ICANN,
+	"*.yokohama.jp":                          ICANN,
+	"!city.yokohama.jp":                      ICANN,
+	"aisai.aichi.jp":                         ICANN,
+	"ama.aichi.jp":                           ICANN,
+	"anjo.aichi.jp":                          ICANN,
+	"asuke.aichi.jp":                         ICANN,
+	"chiryu.aichi.jp":                        ICANN,
+	"chita.aichi.jp":                         ICANN,
+	"fuso.aichi.jp":                          ICANN,
+	"gamagori.aichi.jp":                      ICANN,
+	"handa.aichi.jp":                         ICANN,
+	"hazu.aichi.jp":                          ICANN,
+	"hekinan.aichi.jp":                       ICANN,
+	"higashiura.aichi.jp":                    ICANN,
+	"ichinomiya.aichi.jp":                    ICANN,
+	"inazawa.aichi.jp":                       ICANN,
+	"inuyama.aichi.jp":                       ICANN,
+	"isshiki.aichi.jp":                       ICANN,
+	"iwakura.aichi.jp":                       ICANN,
+	"kanie.aichi.jp":                         ICANN,
+	"kariya.aichi.jp":                        ICANN,
+	"kasugai.aichi.jp":                       ICANN,
+	"kira.aichi.jp":                          ICANN,
+	"kiyosu.aichi.jp":                        ICANN,
+	"komaki.aichi.jp":                        ICANN,
+	"konan.aichi.jp":                         ICANN,
+	"kota.aichi.jp":                          ICANN,
+	"mihama.aichi.jp":                        ICANN,
+	"miyoshi.aichi.jp":                       ICANN,
+	"nishio.aichi.jp":                        ICANN,
+	"nisshin.aichi.jp":                       ICANN,
+	"obu.aichi.jp":                           ICANN,
+	"oguchi.aichi.jp":                        ICANN,
+	"oharu.aichi.jp":                         ICANN,
+	"okazaki.aichi.jp":                       ICANN,
+	"owariasahi.aichi.jp":                    ICANN,
+	"seto.aichi.jp":                          ICANN,
+	"shikatsu.aichi.jp":                      ICANN,
+	"shinshiro.aichi.jp":                     ICANN,
+	"shitara.aichi.jp":                       ICANN,
+	"tahara.aichi.jp":                        ICANN,
+	"takahama.aichi.jp":                      ICANN,
+	"tobishima.aichi.jp":                     ICANN,
+	"toei.aichi.jp":                          ICANN,
+	"togo.aichi.jp":                          ICANN,
+	"tokai.aichi.jp":                         ICANN,
+	"tokoname.aichi.jp":                      ICANN,
+	"toyoake.aichi.jp":                       ICANN,
+	"toyohashi.aichi.jp":                     ICANN,
+	"toyokawa.aichi.jp":                      ICANN,
+	"toyone.aichi.jp":                        ICANN,
+	"toyota.aichi.jp":                        ICANN,
+	"tsushima.aichi.jp":                      ICANN,
+	"yatomi.aichi.jp":                        ICANN,
+	"akita.akita.jp":                         ICANN,
+	"daisen.akita.jp":                        ICANN,
+	"fujisato.akita.jp":                      ICANN,
+	"gojome.akita.jp":                        ICANN,
+	"hachirogata.akita.jp":                   ICANN,
+	"happou.akita.jp":                        ICANN,
+	"higashinaruse.akita.jp":                 ICANN,
+	"honjo.akita.jp":                         ICANN,
+	"honjyo.akita.jp":                        ICANN,
+	"ikawa.akita.jp":                         ICANN,
+	"kamikoani.akita.jp":                     ICANN,
+	"kamioka.akita.jp":                       ICANN,
+	"katagami.akita.jp":                      ICANN,
+	"kazuno.akita.jp":                        ICANN,
+	"kitaakita.akita.jp":                     ICANN,
+	"kosaka.akita.jp":                        ICANN,
+	"kyowa.akita.jp":                         ICANN,
+	"misato.akita.jp":                        ICANN,
+	"mitane.akita.jp":                        ICANN,
+	"moriyoshi.akita.jp":                     ICANN,
+	"nikaho.akita.jp":                        ICANN,
+	"noshiro.akita.jp":                       ICANN,
+	"odate.akita.jp":                         ICANN,
+	"oga.akita.jp":                           ICANN,
+	"ogata.akita.jp":                         ICANN,
+	"semboku.akita.jp":                       ICANN,
+	"yokote.akita.jp":                        ICANN,
+	"yurihonjo.akita.jp":                     ICANN,
+	"aomori.aomori.jp":                       ICANN,
+	"gonohe.aomori.jp":                       ICANN,
+	"hachinohe.aomori.jp":                    ICANN,
+	"hashikami.aomori.jp":                    ICANN,
+	"hiranai.aomori.jp":                      ICANN,
+	"hirosaki.aomori.jp":                     ICANN,
+	"itayanagi.aomori.jp":                    ICANN,
+	"kuroishi.aomori.jp":                     ICANN,
+	"misawa.aomori.jp":                       ICANN,
+	"mutsu.aomori.jp":                        ICANN,
+	"nakadomari.aomori.jp":                   ICANN,
+	"noheji.aomori.jp":                       ICANN,
+	"oirase.aomori.jp":                       ICANN,
+	"owani.aomori.jp":                        ICANN,
+	"rokunohe.aomori.jp":                     ICANN,
+	"sannohe.aomori.jp":                      ICANN,
+	"shichinohe.aomori.jp":                   ICANN,
+	"shingo.aomori.jp":                       ICANN,
+	"takko.aomori.jp":                        ICANN,
+	"towada.aomori.jp":                       ICANN,
+	"tsugaru.aomori.jp":                      ICANN,
+	"tsuruta.aomori.jp":                      ICANN,
+	"abiko.chiba.jp":                         ICANN,
+	"asahi.chiba.jp":                         ICANN,
+	"chonan.chiba.jp":                        ICANN,
+	"chosei.chiba.jp":                        ICANN,
+	"choshi.chiba.jp":                        ICANN,
+	"chuo.chiba.jp":                          ICANN,
+	"funabashi.chiba.jp":                     ICANN,
+	"futtsu.chiba.jp":                        ICANN,
+	"hanamigawa.chiba.jp":                    ICANN,
+	"ichihara.chiba.jp":                      ICANN,
+	"ichikawa.chiba.jp":                      ICANN,
+	"ichinomiya.chiba.jp":                    ICANN,
+	"inzai.chiba.jp":                         ICANN,
+	"isumi.chiba.jp":                         ICANN,
+	"kamagaya.chiba.jp":                      ICANN,
+	"kamogawa.chiba.jp":                      ICANN,
+	"kashiwa.chiba.jp":                       ICANN,
+	"katori.chiba.jp":                        ICANN,
+	"katsuura.chiba.jp":                      ICANN,
+	"kimitsu.chiba.jp":                       ICANN,
+	"kisarazu.chiba.jp":                      ICANN,
+	"kozaki.chiba.jp":                        ICANN,
+	"kujukuri.chiba.jp":                      ICANN,
+	"kyonan.chiba.jp":                        ICANN,
+	"matsudo.chiba.jp":                       ICANN,
+	"midori.chiba.jp":                        ICANN,
+	"mihama.chiba.jp":                        ICANN,
+	"minamiboso.chiba.jp":                    ICANN,
+	"mobara.chiba.jp":                        ICANN,
+	"mutsuzawa.chiba.jp":                     ICANN,
+	"nagara.chiba.jp":                        ICANN,
+	"nagareyama.chiba.jp":                    ICANN,
+	"narashino.chiba.jp":                     ICANN,
+	"narita.chiba.jp":                        ICANN,
+	"noda.chiba.jp":                          ICANN,
+	"oamishirasato.chiba.jp":                 ICANN,
+	"omigawa.chiba.jp":                       ICANN,
+	"onjuku.chiba.jp":                        ICANN,
+	"otaki.chiba.jp":                         ICANN,
+	"sakae.chiba.jp":                         ICANN,
+	"sakura.chiba.jp":                        ICANN,
+	"shimofusa.chiba.jp":                     ICANN,
+	"shirako.chiba.jp":                       ICANN,
+	"shiroi.chiba.jp":                        ICANN,
+	"shisui.chiba.jp":                        ICANN,
+	"sodegaura.chiba.jp":                     ICANN,
+	"sosa.chiba.jp":                          ICANN,
+	"tako.chiba.jp":                          ICANN,
+	"tateyama.chiba.jp":                      ICANN,
+	"togane.chiba.jp":                        ICANN,
+	"tohnosho.chiba.jp":                      ICANN,
+	"tomisato.chiba.jp":                      ICANN,
+	"urayasu.chiba.jp":                       ICANN,
+	"yachimata.chiba.jp":                     ICANN,
+	"yachiyo.chiba.jp":                       ICANN,
+	"yokaichiba.chiba.jp":                    ICANN,
+	"yokoshibahikari.chiba.jp":               ICANN,
+	"yotsukaido.chiba.jp":                    ICANN,
+	"ainan.ehime.jp":                         ICANN,
+	"honai.ehime.jp":                         ICANN,
+	"ikata.ehime.jp":                         ICANN,
+	"imabari.ehime.jp":                       ICANN,
+	"iyo.ehime.jp":                           ICANN,
+	"kamijima.ehime.jp":                      ICANN,
+	"kihoku.ehime.jp":                        ICANN,
+	"kumakogen.ehime.jp":                     ICANN,
+	"masaki.ehime.jp":                        ICANN,
+	"matsuno.ehime.jp":                       ICANN,
+	"matsuyama.ehime.jp":                     ICANN,
+	"namikata.ehime.jp":                      ICANN,
+	"niihama.ehime.jp":                       ICANN,
+	"ozu.ehime.jp":                           ICANN,
+	"saijo.ehime.jp":                         ICANN,
+	"seiyo.ehime.jp":                         ICANN,
+	"shikokuchuo.ehime.jp":                   ICANN,
+	"tobe.ehime.jp":                          ICANN,
+	"toon.ehime.jp":                          ICANN,
+	"uchiko.ehime.jp":                        ICANN,
+	"uwajima.ehime.jp":                       ICANN,
+	"yawatahama.ehime.jp":                    ICANN,
+	"echizen.fukui.jp":                       ICANN,
+	"eiheiji.fukui.jp":                       ICANN,
+	"fukui.fukui.jp":                         ICANN,
+	"ikeda.fukui.jp":                         ICANN,
+	"katsuyama.fukui.jp":                     ICANN,
+	"mihama.fukui.jp":                        ICANN,
+	"minamiechizen.fukui.jp":                 ICANN,
+	"obama.fukui.jp":                         ICANN,
+	"ohi.fukui.jp":                           ICANN,
+	"ono.fukui.jp":                           ICANN,
+	"sabae.fukui.jp":                         ICANN,
+	"sakai.fukui.jp":                         ICANN,
+	"takahama.fukui.jp":                      ICANN,
+	"tsuruga.fukui.jp":                       ICANN,
+	"wakasa.fukui.jp":                        ICANN,
+	"ashiya.fukuoka.jp":                      ICANN,
+	"buzen.fukuoka.jp":                       ICANN,
+	"chikugo.fukuoka.jp":                     ICANN,
+	"chikuho.fukuoka.jp":                     ICANN,
+	"chikujo.fukuoka.jp":                     ICANN,
+	"chikushino.fukuoka.jp":                  ICANN,
+	"chikuzen.fukuoka.jp":                    ICANN,
+	"chuo.fukuoka.jp":                        ICANN,
+	"dazaifu.fukuoka.jp":                     ICANN,
+	"fukuchi.fukuoka.jp":                     ICANN,
+	"hakata.fukuoka.jp":                      ICANN,
+	"higashi.fukuoka.jp":                     ICANN,
+	"hirokawa.fukuoka.jp":                    ICANN,
+	"hisayama.fukuoka.jp":                    ICANN,
+	"iizuka.fukuoka.jp":                      ICANN,
+	"inatsuki.fukuoka.jp":                    ICANN,
+	"kaho.fukuoka.jp":                        ICANN,
+	"kasuga.fukuoka.jp":                      ICANN,
+	"kasuya.fukuoka.jp":                      ICANN,
+	"kawara.fukuoka.jp":                      ICANN,
+	"keisen.fukuoka.jp":                      ICANN,
+	"koga.fukuoka.jp":                        ICANN,
+	"kurate.fukuoka.jp":                      ICANN,
+	"kurogi.fukuoka.jp":                      ICANN,
+	"kurume.fukuoka.jp":                      ICANN,
+	"minami.fukuoka.jp":                      ICANN,
+	"miyako.fukuoka.jp":                      ICANN,
+	"miyama.fukuoka.jp":                      ICANN,
+	"miyawaka.fukuoka.jp":                    ICANN,
+	"mizumaki.fukuoka.jp":                    ICANN,
+	"munakata.fukuoka.jp":                    ICANN,
+	"nakagawa.fukuoka.jp":                    ICANN,
+	"nakama.fukuoka.jp":                      ICANN,
+	"nishi.fukuoka.jp":                       ICANN,
+	"nogata.fukuoka.jp":                      ICANN,
+	"ogori.fukuoka.jp":                       ICANN,
+	"okagaki.fukuoka.jp":                     ICANN,
+	"okawa.fukuoka.jp":                       ICANN,
+	"oki.fukuoka.jp":                         ICANN,
+	"omuta.fukuoka.jp":                       ICANN,
+	"onga.fukuoka.jp":                        ICANN,
+	"onojo.fukuoka.jp":                       ICANN,
+	"oto.fukuoka.jp":                         ICANN,
+	"saigawa.fukuoka.jp":                     ICANN,
+	"sasaguri.fukuoka.jp":                    ICANN,
+	"shingu.fukuoka.jp":                      ICANN,
+	"shinyoshitomi.fukuoka.jp":               ICANN,
+	"shonai.fukuoka.jp":                      ICANN,
+	"soeda.fukuoka.jp":                       ICANN,
+	"sue.fukuoka.jp":                         ICANN,
+	"tachiarai.fukuoka.jp":                   ICANN,
+	"tagawa.fukuoka.jp":                      ICANN,
+	"takata.fukuoka.jp":                      ICANN,
+	"toho.fukuoka.jp":                        ICANN,
+	"toyotsu.fukuoka.jp":                     ICANN,
+	"tsuiki.fukuoka.jp":                      ICANN,
+	"ukiha.fukuoka.jp":                       ICANN,
+	"umi.fukuoka.jp":                         ICANN,
+	"usui.fukuoka.jp":                        ICANN,
+	"yamada.fukuoka.jp":                      ICANN,
+	"yame.fukuoka.jp":                        ICANN,
+	"yanagawa.fukuoka.jp":                    ICANN,
+	"yukuhashi.fukuoka.jp":                   ICANN,
+	"aizubange.fukushima.jp":                 ICANN,
+	"aizumisato.fukushima.jp":                ICANN,
+	"aizuwakamatsu.fukushima.jp":             ICANN,
+	"asakawa.fukushima.jp":                   ICANN,
+	"bandai.fukushima.jp":                    ICANN,
+	"date.fukushima.jp":                      ICANN,
+	"fukushima.fukushima.jp":                 ICANN,
+	"furudono.fukushima.jp":                  ICANN,
+	"futaba.fukushima.jp":                    ICANN,
+	"hanawa.fukushima.jp":                    ICANN,
+	"higashi.fukushima.jp":                   ICANN,
+	"hirata.fukushima.jp":                    ICANN,
+	"hirono.fukushima.jp":                    ICANN,
+	"iitate.fukushima.jp":                    ICANN,
+	"inawashiro.fukushima.jp":                ICANN,
+	"ishikawa.fukushima.jp":                  ICANN,
+	"iwaki.fukushima.jp":                     ICANN,
+	"izumizaki.fukushima.jp":                 ICANN,
+	"kagamiishi.fukushima.jp":                ICANN,
+	"kaneyama.fukushima.jp":                  ICANN,
+	"kawamata.fukushima.jp":                  ICANN,
+	"kitakata.fukushima.jp":                  ICANN,
+	"kitashiobara.fukushima.jp":              ICANN,
+	"koori.fukushima.jp":                     ICANN,
+	"koriyama.fukushima.jp":                  ICANN,
+	"kunimi.fukushima.jp":                    ICANN,
+	"miharu.fukushima.jp":                    ICANN,
+	"mishima.fukushima.jp":                   ICANN,
+	"namie.fukushima.jp":                     ICANN,
+	"nango.fukushima.jp":                     ICANN,
+	"nishiaizu.fukushima.jp":                 ICANN,
+	"nishigo.fukushima.jp":                   ICANN,
+	"okuma.fukushima.jp":                     ICANN,
+	"omotego.fukushima.jp":                   ICANN,
+	"ono.fukushima.jp":                       ICANN,
+	"otama.fukushima.jp":                     ICANN,
+	"samegawa.fukushima.jp":                  ICANN,
+	"shimogo.fukushima.jp":                   ICANN,
+	"shirakawa.fukushima.jp":                 ICANN,
+	"showa.fukushima.jp":                     ICANN,
+	"soma.fukushima.jp":                      ICANN,
+	"sukagawa.fukushima.jp":                  ICANN,
+	"taishin.fukushima.jp":                   ICANN,
+	"tamakawa.fukushima.jp":                  ICANN,
+	"tanagura.fukushima.jp":                  ICANN,
+	"tenei.fukushima.jp":                     ICANN,
+	"yabuki.fukushima.jp":                    ICANN,
+	"yamato.fukushima.jp":                    ICANN,
+	"yamatsuri.fukushima.jp":                 ICANN,
+	"yanaizu.fukushima.jp":                   ICANN,
+	"yugawa.fukushima.jp":                    ICANN,
+	"anpachi.gifu.jp":                        ICANN,
+	"ena.gifu.jp":                            ICANN,
+	"gifu.gifu.jp":                           ICANN,
+	"ginan.gifu.jp":                          ICANN,
+	"godo.gifu.jp":                           ICANN,
+	"gujo.gifu.jp":                           ICANN,
+	"hashima.gifu.jp":                        ICANN,
+	"hichiso.gifu.jp":                        ICANN,
+	"hida.gifu.jp":                           ICANN,
+	"higashishirakawa.gifu.jp":               ICANN,
+	"ibigawa.gifu.jp":                        ICANN,
+	"ikeda.gifu.jp":                          ICANN,
+	"kakamigahara.gifu.jp":                   ICANN,
+	"kani.gifu.jp":                           ICANN,
+	"kasahara.gifu.jp":                       ICANN,
+	"kasamatsu.gifu.jp":                      ICANN,
+	"kawaue.gifu.jp":                         ICANN,
+	"kitagata.gifu.jp":                       ICANN,
+	"mino.gifu.jp":                           ICANN,
+	"minokamo.gifu.jp":                       ICANN,
+	"mitake.gifu.jp":                         ICANN,
+	"mizunami.gifu.jp":                       ICANN,
+	"motosu.gifu.jp":                         ICANN,
+	"nakatsugawa.gifu.jp":                    ICANN,
+	"ogaki.gifu.jp":                          ICANN,
+	"sakahogi.gifu.jp":                       ICANN,
+	"seki.gifu.jp":                           ICANN,
+	"sekigahara.gifu.jp":                     ICANN,
+	"shirakawa.gifu.jp":                      ICANN,
+	"tajimi.gifu.jp":                         ICANN,
+	"takayama.gifu.jp":                       ICANN,
+	"tarui.gifu.jp":                          ICANN,
+	"toki.gifu.jp":                           ICANN,
+	"tomika.gifu.jp":                         ICANN,
+	"wanouchi.gifu.jp":                       ICANN,
+	"yamagata.gifu.jp":                       ICANN,
+	"yaotsu.gifu.jp":                         ICANN,
+	"yoro.gifu.jp":                           ICANN,
+	"annaka.gunma.jp":                        ICANN,
+	"chiyoda.gunma.jp":                       ICANN,
+	"fujioka.gunma.jp":                       ICANN,
+	"higashiagatsuma.gunma.jp":               ICANN,
+	"isesaki.gunma.jp":                       ICANN,
+	"itakura.gunma.jp":                       ICANN,
+	"kanna.gunma.jp":                         ICANN,
+	"kanra.gunma.jp":                         ICANN,
+	"katashina.gunma.jp":                     ICANN,
+	"kawaba.gunma.jp":                        ICANN,
+	"kiryu.gunma.jp":                         ICANN,
+	"kusatsu.gunma.jp":                       ICANN,
+	"maebashi.gunma.jp":                      ICANN,
+	"meiwa.gunma.jp":                         ICANN,
+	"midori.gunma.jp":                        ICANN,
+	"minakami.gunma.jp":                      ICANN,
+	"naganohara.gunma.jp":                    ICANN,
+	"nakanojo.gunma.jp":                      ICANN,
+	"nanmoku.gunma.jp":                       ICANN,
+	"numata.gunma.jp":                        ICANN,
+	"oizumi.gunma.jp":                        ICANN,
+	"ora.gunma.jp":                           ICANN,
+	"ota.gunma.jp":                           ICANN,
+	"shibukawa.gunma.jp":                     ICANN,
+	"shimonita.gunma.jp":                     ICANN,
+	"shinto.gunma.jp":                        ICANN,
+	"showa.gunma.jp":                         ICANN,
+	"takasaki.gunma.jp":                      ICANN,
+	"takayama.gunma.jp":                      ICANN,
+	"tamamura.gunma.jp":                      ICANN,
+	"tatebayashi.gunma.jp":                   ICANN,
+	"tomioka.gunma.jp":                       ICANN,
+	"tsukiyono.gunma.jp":                     ICANN,
+	"tsumagoi.gunma.jp":                      ICANN,
+	"ueno.gunma.jp":                          ICANN,
+	"yoshioka.gunma.jp":                      ICANN,
+	"asaminami.hiroshima.jp":                 ICANN,
+	"daiwa.hiroshima.jp":                     ICANN,
+	"etajima.hiroshima.jp":                   ICANN,
+	"fuchu.hiroshima.jp":                     ICANN,
+	"fukuyama.hiroshima.jp":                  ICANN,
+	"hatsukaichi.hiroshima.jp":               ICANN,
+	"higashihiroshima.hiroshima.jp":          ICANN,
+	"hongo.hiroshima.jp":                     ICANN,
+	"jinsekikogen.hiroshima.jp":              ICANN,
+	"kaita.hiroshima.jp":                     ICANN,
+	"kui.hiroshima.jp":                       ICANN,
+	"kumano.hiroshima.jp":                    ICANN,
+	"kure.hiroshima.jp":                      ICANN,
+	"mihara.hiroshima.jp":                    ICANN,
+	"miyoshi.hiroshima.jp":                   ICANN,
+	"naka.hiroshima.jp":                      ICANN,
+	"onomichi.hiroshima.jp":                  ICANN,
+	"osakikamijima.hiroshima.jp":             ICANN,
+	"otake.hiroshima.jp":                     ICANN,
+	"saka.hiroshima.jp":                      ICANN,
+	"sera.hiroshima.jp":                      ICANN,
+	"seranishi.hiroshima.jp":                 ICANN,
+	"shinichi.hiroshima.jp":                  ICANN,
+	"shobara.hiroshima.jp":                   ICANN,
+	"takehara.hiroshima.jp":                  ICANN,
+	"abashiri.hokkaido.jp":                   ICANN,
+	"abira.hokkaido.jp":                      ICANN,
+	"aibetsu.hokkaido.jp":                    ICANN,
+	"akabira.hokkaido.jp":                    ICANN,
+	"akkeshi.hokkaido.jp":                    ICANN,
+	"asahikawa.hokkaido.jp":                  ICANN,
+	"ashibetsu.hokkaido.jp":                  ICANN,
+	"ashoro.hokkaido.jp":                     ICANN,
+	"assabu.hokkaido.jp":                     ICANN,
+	"atsuma.hokkaido.jp":                     ICANN,
+	"bibai.hokkaido.jp":                      ICANN,
+	"biei.hokkaido.jp":                       ICANN,
+	"bifuka.hokkaido.jp":                     ICANN,
+	"bihoro.hokkaido.jp":                     ICANN,
+	"biratori.hokkaido.jp":                   ICANN,
+	"chippubetsu.hokkaido.jp":                ICANN,
+	"chitose.hokkaido.jp":                    ICANN,
+	"date.hokkaido.jp":                       ICANN,
+	"ebetsu.hokkaido.jp":                     ICANN,
+	"embetsu.hokkaido.jp":                    ICANN,
+	"eniwa.hokkaido.jp":                      ICANN,
+	"erimo.hokkaido.jp":                      ICANN,
+	"esan.hokkaido.jp":                       ICANN,
+	"esashi.hokkaido.jp":                     ICANN,
+	"fukagawa.hokkaido.jp":                   ICANN,
+	"fukushima.hokkaido.jp":                  ICANN,
+	"furano.hokkaido.jp":                     ICANN,
+	"furubira.hokkaido.jp":                   ICANN,
+	"haboro.hokkaido.jp":                     ICANN,
+	"hakodate.hokkaido.jp":                   ICANN,
+	"hamatonbetsu.hokkaido.jp":               ICANN,
+	"hidaka.hokkaido.jp":                     ICANN,
+	"higashikagura.hokkaido.jp":              ICANN,
+	"higashikawa.hokkaido.jp":                ICANN,
+	"hiroo.hokkaido.jp":                      ICANN,
+	"hokuryu.hokkaido.jp":                    ICANN,
+	"hokuto.hokkaido.jp":                     ICANN,
+	"honbetsu.hokkaido.jp":                   ICANN,
+	"horokanai.hokkaido.jp":                  ICANN,
+	"horonobe.hokkaido.jp":                   ICANN,
+	"ikeda.hokkaido.jp":                      ICANN,
+	"imakane.hokkaido.jp":                    ICANN,
+	"ishikari.hokkaido.jp":                   ICANN,
+	"iwamizawa.hokkaido.jp":                  ICANN,
+	"iwanai.hokkaido.jp":                     ICANN,
+	"kamifurano.hokkaido.jp":                 ICANN,
+	"kamikawa.hokkaido.jp":                   ICANN,
+	"kamishihoro.hokkaido.jp":                ICANN,
+	"kamisunagawa.hokkaido.jp":               ICANN,
+	"kamoenai.hokkaido.jp":                   ICANN,
+	"kayabe.hokkaido.jp":                     ICANN,
+	"kembuchi.hokkaido.jp":                   ICANN,
+	"kikonai.hokkaido.jp":                    ICANN,
+	"kimobetsu.hokkaido.jp":                  ICANN,
+	"kitahiroshima.hokkaido.jp":              ICANN,
+	"kitami.hokkaido.jp":                     ICANN,
+	"kiyosato.hokkaido.jp":                   ICANN,
+	"koshimizu.hokkaido.jp":                  ICANN,
+	"kunneppu.hokkaido.jp":                   ICANN,
+	"kuriyama.hokkaido.jp":                   ICANN,
+	"kuromatsunai.hokkaido.jp":               ICANN,
+	"kushiro.hokkaido.jp":                    ICANN,
+	"kutchan.hokkaido.jp":                    ICANN,
+	"kyowa.hokkaido.jp":                      ICANN,
+	"mashike.hokkaido.jp":                    ICANN,
+	"matsumae.hokkaido.jp":                   ICANN,
+	"mikasa.hokkaido.jp":                     ICANN,
+	"minamifurano.hokkaido.jp":               ICANN,
+	"mombetsu.hokkaido.jp":                   ICANN,
+	"moseushi.hokkaido.jp":                   ICANN,
+	"mukawa.hokkaido.jp":                     ICANN,
+	"muroran.hokkaido.jp":                    ICANN,
+	"naie.hokkaido.jp":                       ICANN,
+	"nakagawa.hokkaido.jp":                   ICANN,
+	"nakasatsunai.hokkaido.jp":               ICANN,
+	"nakatombetsu.hokkaido.jp":               ICANN,
+	"nanae.hokkaido.jp":                      ICANN,
+	"nanporo.hokkaido.jp":                    ICANN,
+	"nayoro.hokkaido.jp":                     ICANN,
+	"nemuro.hokkaido.jp":                     ICANN,
+	"niikappu.hokkaido.jp":                   ICANN,
+	"niki.hokkaido.jp":                       ICANN,
+	"nishiokoppe.hokkaido.jp":                ICANN,
+	"noboribetsu.hokkaido.jp":                ICANN,
+	"numata.hokkaido.jp":                     ICANN,
+	"obihiro.hokkaido.jp":                    ICANN,
+	"obira.hokkaido.jp":                      ICANN,
+	"oketo.hokkaido.jp":                      ICANN,
+	"okoppe.hokkaido.jp":                     ICANN,
+	"otaru.hokkaido.jp":                      ICANN,
+	"otobe.hokkaido.jp":                      ICANN,
+	"otofuke.hokkaido.jp":                    ICANN,
+	"otoineppu.hokkaido.jp":                  ICANN,
+	"oumu.hokkaido.jp":                       ICANN,
+	"ozora.hokkaido.jp":                      ICANN,
+	"pippu.hokkaido.jp":                      ICANN,
+	"rankoshi.hokkaido.jp":                   ICANN,
+	"rebun.hokkaido.jp":                      ICANN,
+	"rikubetsu.hokkaido.jp":                  ICANN,
+	"rishiri.hokkaido.jp":                    ICANN,
+	"rishirifuji.hokkaido.jp":                ICANN,
+	"saroma.hokkaido.jp":                     ICANN,
+	"sarufutsu.hokkaido.jp":                  ICANN,
+	"shakotan.hokkaido.jp":                   ICANN,
+	"shari.hokkaido.jp":                      ICANN,
+	"shibecha.hokkaido.jp":                   ICANN,
+	"shibetsu.hokkaido.jp":                   ICANN,
+	"shikabe.hokkaido.jp":                    ICANN,
+	"shikaoi.hokkaido.jp":                    ICANN,
+	"shimamaki.hokkaido.jp":                  ICANN,
+	"shimizu.hokkaido.jp":                    ICANN,
+	"shimokawa.hokkaido.jp":                  ICANN,
+	"shinshinotsu.hokkaido.jp":               ICANN,
+	"shintoku.hokkaido.jp":                   ICANN,
+	"shiranuka.hokkaido.jp":                  ICANN,
+	"shiraoi.hokkaido.jp":                    ICANN,
+	"shiriuchi.hokkaido.jp":                  ICANN,
+	"sobetsu.hokkaido.jp":                    ICANN,
+	"sunagawa.hokkaido.jp":                   ICANN,
+	"taiki.hokkaido.jp":                      ICANN,
+	"takasu.hokkaido.jp":                     ICANN,
+	"takikawa.hokkaido.jp":                   ICANN,
+	"takinoue.hokkaido.jp":                   ICANN,
+	"teshikaga.hokkaido.jp":                  ICANN,
+	"tobetsu.hokkaido.jp":                    ICANN,
+	"tohma.hokkaido.jp":                      ICANN,
+	"tomakomai.hokkaido.jp":                  ICANN,
+	"tomari.hokkaido.jp":                     ICANN,
+	"toya.hokkaido.jp":                       ICANN,
+	"toyako.hokkaido.jp":                     ICANN,
+	"toyotomi.hokkaido.jp":                   ICANN,
+	"toyoura.hokkaido.jp":                    ICANN,
+	"tsubetsu.hokkaido.jp":                   ICANN,
+	"tsukigata.hokkaido.jp":                  ICANN,
+	"urakawa.hokkaido.jp":                    ICANN,
+	"urausu.hokkaido.jp":                     ICANN,
+	"uryu.hokkaido.jp":                       ICANN,
+	"utashinai.hokkaido.jp":                  ICANN,
+	"wakkanai.hokkaido.jp":                   ICANN,
+	"wassamu.hokkaido.jp":                    ICANN,
+	"yakumo.hokkaido.jp":                     ICANN,
+	"yoichi.hokkaido.jp":                     ICANN,
+	"aioi.hyogo.jp":                          ICANN,
+	"akashi.hyogo.jp":                        ICANN,
+	"ako.hyogo.jp":                           ICANN,
+	"amagasaki.hyogo.jp":                     ICANN,
+	"aogaki.hyogo.jp":                        ICANN,
+	"asago.hyogo.jp":                         ICANN,
+	"ashiya.hyogo.jp":                        ICANN,
+	"awaji.hyogo.jp":                         ICANN,
+	"fukusaki.hyogo.jp":                      ICANN,
+	"goshiki.hyogo.jp":                       ICANN,
+	"harima.hyogo.jp":                        ICANN,
+	"himeji.hyogo.jp":                        ICANN,
+	"ichikawa.hyogo.jp":                      ICANN,
+	"inagawa.hyogo.jp":                       ICANN,
+	"itami.hyogo.jp":                         ICANN,
+	"kakogawa.hyogo.jp":                      ICANN,
+	"kamigori.hyogo.jp":                      ICANN,
+	"kamikawa.hyogo.jp":                      ICANN,
+	"kasai.hyogo.jp":                         ICANN,
+	"kasuga.hyogo.jp":                        ICANN,
+	"kawanishi.hyogo.jp":                     ICANN,
+	"miki.hyogo.jp":                          ICANN,
+	"minamiawaji.hyogo.jp":                   ICANN,
+	"nishinomiya.hyogo.jp":                   ICANN,
+	"nishiwaki.hyogo.jp":                     ICANN,
+	"ono.hyogo.jp":                           ICANN,
+	"sanda.hyogo.jp":                         ICANN,
+	"sannan.hyogo.jp":                        ICANN,
+	"sasayama.hyogo.jp":                      ICANN,
+	"sayo.hyogo.jp":                          ICANN,
+	"shingu.hyogo.jp":                        ICANN,
+	"shinonsen.hyogo.jp":                     ICANN,
+	"shiso.hyogo.jp":                         ICANN,
+	"sumoto.hyogo.jp":                        ICANN,
+	"taishi.hyogo.jp":                        ICANN,
+	"taka.hyogo.jp":                          ICANN,
+	"takarazuka.hyogo.jp":                    ICANN,
+	"takasago.hyogo.jp":                      ICANN,
+	"takino.hyogo.jp":                        ICANN,
+	"tamba.hyogo.jp":                         ICANN,
+	"tatsuno.hyogo.jp":                       ICANN,
+	"toyooka.hyogo.jp":                       ICANN,
+	"yabu.hyogo.jp":                          ICANN,
+	"yashiro.hyogo.jp":                       ICANN,
+	"yoka.hyogo.jp":                          ICANN,
+	"yokawa.hyogo.jp":                        ICANN,
+	"ami.ibaraki.jp":                         ICANN,
+	"asahi.ibaraki.jp":                       ICANN,
+	"bando.ibaraki.jp":                       ICANN,
+	"chikusei.ibaraki.jp":                    ICANN,
+	"daigo.ibaraki.jp":                       ICANN,
+	"fujishiro.ibaraki.jp":                   ICANN,
+	"hitachi.ibaraki.jp":                     ICANN,
+	"hitachinaka.ibaraki.jp":                 ICANN,
+	"hitachiomiya.ibaraki.jp":                ICANN,
+	"hitachiota.ibaraki.jp":                  ICANN,
+	"ibaraki.ibaraki.jp":                     ICANN,
+	"ina.ibaraki.jp":                         ICANN,
+	"inashiki.ibaraki.jp":                    ICANN,
+	"itako.ibaraki.jp":                       ICANN,
+	"iwama.ibaraki.jp":                       ICANN,
+	"joso.ibaraki.jp":                        ICANN,
+	"kamisu.ibaraki.jp":                      ICANN,
+	"kasama.ibaraki.jp":                      ICANN,
+	"kashima.ibaraki.jp":                     ICANN,
+	"kasumigaura.ibaraki.jp":                 ICANN,
+	"koga.ibaraki.jp":                        ICANN,
+	"miho.ibaraki.jp":                        ICANN,
+	"mito.ibaraki.jp":                        ICANN,
+	"moriya.ibaraki.jp":                      ICANN,
+	"naka.ibaraki.jp":                        ICANN,
+	"namegata.ibaraki.jp":                    ICANN,
+	"oarai.ibaraki.jp":                       ICANN,
+	"ogawa.ibaraki.jp":                       ICANN,
+	"omitama.ibaraki.jp":                     ICANN,
+	"ryugasaki.ibaraki.jp":                   ICANN,
+	"sakai.ibaraki.jp":                       ICANN,
+	"sakuragawa.ibaraki.jp":                  ICANN,
+	"shimodate.ibaraki.jp":                   ICANN,
+	"shimotsuma.ibaraki.jp":                  ICANN,
+	"shirosato.ibaraki.jp":                   ICANN,
+	"sowa.ibaraki.jp":                        ICANN,
+	"suifu.ibaraki.jp":                       ICANN,
+	"takahagi.ibaraki.jp":                    ICANN,
+	"tamatsukuri.ibaraki.jp":                 ICANN,
+	"tokai.ibaraki.jp":                       ICANN,
+	"tomobe.ibaraki.jp":                      ICANN,
+	"tone.ibaraki.jp":                        ICANN,
+	"toride.ibaraki.jp":                      ICANN,
+	"tsuchiura.ibaraki.jp":                   ICANN,
+	"tsukuba.ibaraki.jp":                     ICANN,
+	"uchihara.ibaraki.jp":                    ICANN,
+	"ushiku.ibaraki.jp":                      ICANN,
+	"yachiyo.ibaraki.jp":                     ICANN,
+	"yamagata.ibaraki.jp":                    ICANN,
+	"yawara.ibaraki.jp":                      ICANN,
+	"yuki.ibaraki.jp":                        ICANN,
+	"anamizu.ishikawa.jp":                    ICANN,
+	"hakui.ishikawa.jp":                      ICANN,
+	"hakusan.ishikawa.jp":                    ICANN,
+	"kaga.ishikawa.jp":                       ICANN,
+	"kahoku.ishikawa.jp":                     ICANN,
+	"kanazawa.ishikawa.jp":                   ICANN,
+	"kawakita.ishikawa.jp":                   ICANN,
+	"komatsu.ishikawa.jp":                    ICANN,
+	"nakanoto.ishikawa.jp":                   ICANN,
+	"nanao.ishikawa.jp":                      ICANN,
+	"nomi.ishikawa.jp":                       ICANN,
+	"nonoichi.ishikawa.jp":                   ICANN,
+	"noto.ishikawa.jp":                       ICANN,
+	"shika.ishikawa.jp":                      ICANN,
+	"suzu.ishikawa.jp":                       ICANN,
+	"tsubata.ishikawa.jp":                    ICANN,
+	"tsurugi.ishikawa.jp":                    ICANN,
+	"uchinada.ishikawa.jp":                   ICANN,
+	"wajima.ishikawa.jp":                     ICANN,
+	"fudai.iwate.jp":                         ICANN,
+	"fujisawa.iwate.jp":                      ICANN,
+	"hanamaki.iwate.jp":                      ICANN,
+	"hiraizumi.iwate.jp":                     ICANN,
+	"hirono.iwate.jp":                        ICANN,
+	"ichinohe.iwate.jp":                      ICANN,
+	"ichinoseki.iwate.jp":                    ICANN,
+	"iwaizumi.iwate.jp":                      ICANN,
+	"iwate.iwate.jp":                         ICANN,
+	"joboji.iwate.jp":                        ICANN,
+	"kamaishi.iwate.jp":                      ICANN,
+	"kanegasaki.iwate.jp":                    ICANN,
+	"karumai.iwate.jp":                       ICANN,
+	"kawai.iwate.jp":                         ICANN,
+	"kitakami.iwate.jp":                      ICANN,
+	"kuji.iwate.jp":                          ICANN,
+	"kunohe.iwate.jp":                        ICANN,
+	"kuzumaki.iwate.jp":                      ICANN,
+	"miyako.iwate.jp":                        ICANN,
+	"mizusawa.iwate.jp":                      ICANN,
+	"morioka.iwate.jp":                       ICANN,
+	"ninohe.iwate.jp":                        ICANN,
+	"noda.iwate.jp":                          ICANN,
+	"ofunato.iwate.jp":                       ICANN,
+	"oshu.iwate.jp":                          ICANN,
+	"otsuchi.iwate.jp":                       ICANN,
+	"rikuzentakata.iwate.jp":                 ICANN,
+	"shiwa.iwate.jp":                         ICANN,
+	"shizukuishi.iwate.jp":                   ICANN,
+	"sumita.iwate.jp":                        ICANN,
+	"tanohata.iwate.jp":                      ICANN,
+	"tono.iwate.jp":                          ICANN,
+	"yahaba.iwate.jp":                        ICANN,
+	"yamada.iwate.jp":                        ICANN,
+	"ayagawa.kagawa.jp":                      ICANN,
+	"higashikagawa.kagawa.jp":                ICANN,
+	"kanonji.kagawa.jp":                      ICANN,
+	"kotohira.kagawa.jp":                     ICANN,
+	"manno.kagawa.jp":                        ICANN,
+	"marugame.kagawa.jp":                     ICANN,
+	"mitoyo.kagawa.jp":                       ICANN,
+	"naoshima.kagawa.jp":                     ICANN,
+	"sanuki.kagawa.jp":                       ICANN,
+	"tadotsu.kagawa.jp":                      ICANN,
+	"takamatsu.kagawa.jp":                    ICANN,
+	"tonosho.kagawa.jp":                      ICANN,
+	"uchinomi.kagawa.jp":                     ICANN,
+	"utazu.kagawa.jp":                        ICANN,
+	"zentsuji.kagawa.jp":                     ICANN,
+	"akune.kagoshima.jp":                     ICANN,
+	"amami.kagoshima.jp":                     ICANN,
+	"hioki.kagoshima.jp":                     ICANN,
+	"isa.kagoshima.jp":                       ICANN,
+	"isen.kagoshima.jp":                      ICANN,
+	"izumi.kagoshima.jp":                     ICANN,
+	"kagoshima.kagoshima.jp":                 ICANN,
+	"kanoya.kagoshima.jp":                    ICANN,
+	"kawanabe.kagoshima.jp":                  ICANN,
+	"kinko.kagoshima.jp":                     ICANN,
+	"kouyama.kagoshima.jp":                   ICANN,
+	"makurazaki.kagoshima.jp":                ICANN,
+	"matsumoto.kagoshima.jp":                 ICANN,
+	"minamitane.kagoshima.jp":                ICANN,
+	"nakatane.kagoshima.jp":                  ICANN,
+	"nishinoomote.kagoshima.jp":              ICANN,
+	"satsumasendai.kagoshima.jp":             ICANN,
+	"soo.kagoshima.jp":                       ICANN,
+	"tarumizu.kagoshima.jp":                  ICANN,
+	"yusui.kagoshima.jp":                     ICANN,
+	"aikawa.kanagawa.jp":                     ICANN,
+	"atsugi.kanagawa.jp":                     ICANN,
+	"ayase.kanagawa.jp":                      ICANN,
+	"chigasaki.kanagawa.jp":                  ICANN,
+	"ebina.kanagawa.jp":                      ICANN,
+	"fujisawa.kanagawa.jp":                   ICANN,
+	"hadano.kanagawa.jp":                     ICANN,
+	"hakone.kanagawa.jp":                     ICANN,
+	"hiratsuka.kanagawa.jp":                  ICANN,
+	"isehara.kanagawa.jp":                    ICANN,
+	"kaisei.kanagawa.jp":                     ICANN,
+	"kamakura.kanagawa.jp":                   ICANN,
+	"kiyokawa.kanagawa.jp":                   ICANN,
+	"matsuda.kanagawa.jp":                    ICANN,
+	"minamiashigara.kanagawa.jp":             ICANN,
+	"miura.kanagawa.jp":                      ICANN,
+	"nakai.kanagawa.jp":                      ICANN,
+	"ninomiya.kanagawa.jp":                   ICANN,
+	"odawara.kanagawa.jp":                    ICANN,
+	"oi.kanagawa.jp":                         ICANN,
+	"oiso.kanagawa.jp":                       ICANN,
+	"sagamihara.kanagawa.jp":                 ICANN,
+	"samukawa.kanagawa.jp":                   ICANN,
+	"tsukui.kanagawa.jp":                     ICANN,
+	"yamakita.kanagawa.jp":                   ICANN,
+	"yamato.kanagawa.jp":                     ICANN,
+	"yokosuka.kanagawa.jp":                   ICANN,
+	"yugawara.kanagawa.jp":                   ICANN,
+	"zama.kanagawa.jp":                       ICANN,
+	"zushi.kanagawa.jp":                      ICANN,
+	"aki.kochi.jp":                           ICANN,
+	"geisei.kochi.jp":                        ICANN,
+	"hidaka.kochi.jp":                        ICANN,
+	"higashitsuno.kochi.jp":                  ICANN,
+	"ino.kochi.jp":                           ICANN,
+	"kagami.kochi.jp":                        ICANN,
+	"kami.kochi.jp":                          ICANN,
+	"kitagawa.kochi.jp":                      ICANN,
+	"kochi.kochi.jp":                         ICANN,
+	"mihara.kochi.jp":                        ICANN,
+	"motoyama.kochi.jp":                      ICANN,
+	"muroto.kochi.jp":                        ICANN,
+	"nahari.kochi.jp":                        ICANN,
+	"nakamura.kochi.jp":                      ICANN,
+	"nankoku.kochi.jp":                       ICANN,
+	"nishitosa.kochi.jp":                     ICANN,
+	"niyodogawa.kochi.jp":                    ICANN,
+	"ochi.kochi.jp":                          ICANN,
+	"okawa.kochi.jp":                         ICANN,
+	"otoyo.kochi.jp":                         ICANN,
+	"otsuki.kochi.jp":                        ICANN,
+	"sakawa.kochi.jp":                        ICANN,
+	"sukumo.kochi.jp":                        ICANN,
+	"susaki.kochi.jp":                        ICANN,
+	"tosa.kochi.jp":                          ICANN,
+	"tosashimizu.kochi.jp":                   ICANN,
+	"toyo.kochi.jp":                          ICANN,
+	"tsuno.kochi.jp":                         ICANN,
+	"umaji.kochi.jp":                         ICANN,
+	"yasuda.kochi.jp":                        ICANN,
+	"yusuhara.kochi.jp":                      ICANN,
+	"amakusa.kumamoto.jp":                    ICANN,
+	"arao.kumamoto.jp":                       ICANN,
+	"aso.kumamoto.jp":                        ICANN,
+	"choyo.kumamoto.jp":                      ICANN,
+	"gyokuto.kumamoto.jp":                    ICANN,
+	"kamiamakusa.kumamoto.jp":                ICANN,
+	"kikuchi.kumamoto.jp":                    ICANN,
+	"kumamoto.kumamoto.jp":                   ICANN,
+	"mashiki.kumamoto.jp":                    ICANN,
+	"mifune.kumamoto.jp":                     ICANN,
+	"minamata.kumamoto.jp":                   ICANN,
+	"minamioguni.kumamoto.jp":                ICANN,
+	"nagasu.kumamoto.jp":                     ICANN,
+	"nishihara.kumamoto.jp":                  ICANN,
+	"oguni.kumamoto.jp":                      ICANN,
+	"ozu.kumamoto.jp":                        ICANN,
+	"sumoto.kumamoto.jp":                     ICANN,
+	"takamori.kumamoto.jp":                   ICANN,
+	"uki.kumamoto.jp":                        ICANN,
+	"uto.kumamoto.jp":                        ICANN,
+	"yamaga.kumamoto.jp":                     ICANN,
+	"yamato.kumamoto.jp":                     ICANN,
+	"yatsushiro.kumamoto.jp":                 ICANN,
+	"ayabe.kyoto.jp":                         ICANN,
+	"fukuchiyama.kyoto.jp":                   ICANN,
+	"higashiyama.kyoto.jp":                   ICANN,
+	"ide.kyoto.jp":                           ICANN,
+	"ine.kyoto.jp":                           ICANN,
+	"joyo.kyoto.jp":                          ICANN,
+	"kameoka.kyoto.jp":                       ICANN,
+	"kamo.kyoto.jp":                          ICANN,
+	"kita.kyoto.jp":                          ICANN,
+	"kizu.kyoto.jp":                          ICANN,
+	"kumiyama.kyoto.jp":                      ICANN,
+	"kyotamba.kyoto.jp":                      ICANN,
+	"kyotanabe.kyoto.jp":                     ICANN,
+	"kyotango.kyoto.jp":                      ICANN,
+	"maizuru.kyoto.jp":                       ICANN,
+	"minami.kyoto.jp":                        ICANN,
+	"minamiyamashiro.kyoto.jp":               ICANN,
+	"miyazu.kyoto.jp":                        ICANN,
+	"muko.kyoto.jp":                          ICANN,
+	"nagaokakyo.kyoto.jp":                    ICANN,
+	"nakagyo.kyoto.jp":                       ICANN,
+	"nantan.kyoto.jp":                        ICANN,
+	"oyamazaki.kyoto.jp":                     ICANN,
+	"sakyo.kyoto.jp":                         ICANN,
+	"seika.kyoto.jp":                         ICANN,
+	"tanabe.kyoto.jp":                        ICANN,
+	"uji.kyoto.jp":                           ICANN,
+	"ujitawara.kyoto.jp":                     ICANN,
+	"wazuka.kyoto.jp":                        ICANN,
+	"yamashina.kyoto.jp":                     ICANN,
+	"yawata.kyoto.jp":                        ICANN,
+	"asahi.mie.jp":                           ICANN,
+	"inabe.mie.jp":                           ICANN,
+	"ise.mie.jp":                             ICANN,
+	"kameyama.mie.jp":                        ICANN,
+	"kawagoe.mie.jp":                         ICANN,
+	"kiho.mie.jp":                            ICANN,
+	"kisosaki.mie.jp":                        ICANN,
+	"kiwa.mie.jp":                            ICANN,
+	"komono.mie.jp":                          ICANN,
+	"kumano.mie.jp":                          ICANN,
+	"kuwana.mie.jp":                          ICANN,
+	"matsusaka.mie.jp":                       ICANN,
+	"meiwa.mie.jp":                           ICANN,
+	"mihama.mie.jp":                          ICANN,
+	"minamiise.mie.jp":                       ICANN,
+	"misugi.mie.jp":                          ICANN,
+	"miyama.mie.jp":                          ICANN,
+	"nabari.mie.jp":                          ICANN,
+	"shima.mie.jp":                           ICANN,
+	"suzuka.mie.jp":                          ICANN,
+	"tado.mie.jp":                            ICANN,
+	"taiki.mie.jp":                           ICANN,
+	"taki.mie.jp":                            ICANN,
+	"tamaki.mie.jp":                          ICANN,
+	"toba.mie.jp":                            ICANN,
+	"tsu.mie.jp":                             ICANN,
+	"udono.mie.jp":                           ICANN,
+	"ureshino.mie.jp":                        ICANN,
+	"watarai.mie.jp":                         ICANN,
+	"yokkaichi.mie.jp":                       ICANN,
+	"furukawa.miyagi.jp":                     ICANN,
+	"higashimatsushima.miyagi.jp":            ICANN,
+	"ishinomaki.miyagi.jp":                   ICANN,
+	"iwanuma.miyagi.jp":                      ICANN,
+	"kakuda.miyagi.jp":                       ICANN,
+	"kami.miyagi.jp":                         ICANN,
+	"kawasaki.miyagi.jp":                     ICANN,
+	"marumori.miyagi.jp":                     ICANN,
+	"matsushima.miyagi.jp":                   ICANN,
+	"minamisanriku.miyagi.jp":                ICANN,
+	"misato.miyagi.jp":                       ICANN,
+	"murata.miyagi.jp":                       ICANN,
+	"natori.miyagi.jp":                       ICANN,
+	"ogawara.miyagi.jp":                      ICANN,
+	"ohira.miyagi.jp":                        ICANN,
+	"onagawa.miyagi.jp":                      ICANN,
+	"osaki.miyagi.jp":                        ICANN,
+	"rifu.miyagi.jp":                         ICANN,
+	"semine.miyagi.jp":                       ICANN,
+	"shibata.miyagi.jp":                      ICANN,
+	"shichikashuku.miyagi.jp":                ICANN,
+	"shikama.miyagi.jp":                      ICANN,
+	"shiogama.miyagi.jp":                     ICANN,
+	"shiroishi.miyagi.jp":                    ICANN,
+	"tagajo.miyagi.jp":                       ICANN,
+	"taiwa.miyagi.jp":                        ICANN,
+	"tome.miyagi.jp":                         ICANN,
+	"tomiya.miyagi.jp":                       ICANN,
+	"wakuya.miyagi.jp":                       ICANN,
+	"watari.miyagi.jp":                       ICANN,
+	"yamamoto.miyagi.jp":                     ICANN,
+	"zao.miyagi.jp":                          ICANN,
+	"aya.miyazaki.jp":                        ICANN,
+	"ebino.miyazaki.jp":                      ICANN,
+	"gokase.miyazaki.jp":                     ICANN,
+	"hyuga.miyazaki.jp":                      ICANN,
+	"kadogawa.miyazaki.jp":                   ICANN,
+	"kawaminami.miyazaki.jp":                 ICANN,
+	"kijo.miyazaki.jp":                       ICANN,
+	"kitagawa.miyazaki.jp":                   ICANN,
+	"kitakata.miyazaki.jp":                   ICANN,
+	"kitaura.miyazaki.jp":                    ICANN,
+	"kobayashi.miyazaki.jp":                  ICANN,
+	"kunitomi.miyazaki.jp":                   ICANN,
+	"kushima.miyazaki.jp":                    ICANN,
+	"mimata.miyazaki.jp":                     ICANN,
+	"miyakonojo.miyazaki.jp":                 ICANN,
+	"miyazaki.miyazaki.jp":                   ICANN,
+	"morotsuka.miyazaki.jp":                  ICANN,
+	"nichinan.miyazaki.jp":                   ICANN,
+	"nishimera.miyazaki.jp":                  ICANN,
+	"nobeoka.miyazaki.jp":                    ICANN,
+	"saito.miyazaki.jp":                      ICANN,
+	"shiiba.miyazaki.jp":                     ICANN,
+	"shintomi.miyazaki.jp":                   ICANN,
+	"takaharu.miyazaki.jp":                   ICANN,
+	"takanabe.miyazaki.jp":                   ICANN,
+	"takazaki.miyazaki.jp":                   ICANN,
+	"tsuno.miyazaki.jp":                      ICANN,
+	"achi.nagano.jp":                         ICANN,
+	"agematsu.nagano.jp":                     ICANN,
+	"anan.nagano.jp":                         ICANN,
+	"aoki.nagano.jp":                         ICANN,
+	"asahi.nagano.jp":                        ICANN,
+	"azumino.nagano.jp":                      ICANN,
+	"chikuhoku.nagano.jp":                    ICANN,
+	"chikuma.nagano.jp":                      ICANN,
+	"chino.nagano.jp":                        ICANN,
+	"fujimi.nagano.jp":                       ICANN,
+	"hakuba.nagano.jp":                       ICANN,
+	"hara.nagano.jp":                         ICANN,
+	"hiraya.nagano.jp":                       ICANN,
+	"iida.nagano.jp":                         ICANN,
+	"iijima.nagano.jp":                       ICANN,
+	"iiyama.nagano.jp":                       ICANN,
+	"iizuna.nagano.jp":                       ICANN,
+	"ikeda.nagano.jp":                        ICANN,
+	"ikusaka.nagano.jp":                      ICANN,
+	"ina.nagano.jp":                          ICANN,
+	"karuizawa.nagano.jp":                    ICANN,
+	"kawakami.nagano.jp":                     ICANN,
+	"kiso.nagano.jp":                         ICANN,
+	"kisofukushima.nagano.jp":                ICANN,
+	"kitaaiki.nagano.jp":                     ICANN,
+	"komagane.nagano.jp":                     ICANN,
+	"komoro.nagano.jp":                       ICANN,
+	"matsukawa.nagano.jp":                    ICANN,
+	"matsumoto.nagano.jp":                    ICANN,
+	"miasa.nagano.jp":                        ICANN,
+	"minamiaiki.nagano.jp":                   ICANN,
+	"minamimaki.nagano.jp":                   ICANN,
+	"minamiminowa.nagano.jp":                 ICANN,
+	"minowa.nagano.jp":                       ICANN,
+	"miyada.nagano.jp":                       ICANN,
+	"miyota.nagano.jp":                       ICANN,
+	"mochizuki.nagano.jp":                    ICANN,
+	"nagano.nagano.jp":                       ICANN,
+	"nagawa.nagano.jp":                       ICANN,
+	"nagiso.nagano.jp":                       ICANN,
+	"nakagawa.nagano.jp":                     ICANN,
+	"nakano.nagano.jp":                       ICANN,
+	"nozawaonsen.nagano.jp":                  ICANN,
+	"obuse.nagano.jp":                        ICANN,
+	"ogawa.nagano.jp":                        ICANN,
+	"okaya.nagano.jp":                        ICANN,
+	"omachi.nagano.jp":                       ICANN,
+	"omi.nagano.jp":                          ICANN,
+	"ookuwa.nagano.jp":                       ICANN,
+	"ooshika.nagano.jp":                      ICANN,
+	"otaki.nagano.jp":                        ICANN,
+	"otari.nagano.jp":                        ICANN,
+	"sakae.nagano.jp":                        ICANN,
+	"sakaki.nagano.jp":                       ICANN,
+	"saku.nagano.jp":                         ICANN,
+	"sakuho.nagano.jp":                       ICANN,
+	"shimosuwa.nagano.jp":                    ICANN,
+	"shinanomachi.nagano.jp":                 ICANN,
+	"shiojiri.nagano.jp":                     ICANN,
+	"suwa.nagano.jp":                         ICANN,
+	"suzaka.nagano.jp":                       ICANN,
+	"takagi.nagano.jp":                       ICANN,
+	"takamori.nagano.jp":                     ICANN,
+	"takayama.nagano.jp":                     ICANN,
+	"tateshina.nagano.jp":                    ICANN,
+	"tatsuno.nagano.jp":                      ICANN,
+	"togakushi.nagano.jp":                    ICANN,
+	"togura.nagano.jp":                       ICANN,
+	"tomi.nagano.jp":                         ICANN,
+	"ueda.nagano.jp":                         ICANN,
+	"wada.nagano.jp":                         ICANN,
+	"yamagata.nagano.jp":                     ICANN,
+	"yamanouchi.nagano.jp":                   ICANN,
+	"yasaka.nagano.jp":                       ICANN,
+	"yasuoka.nagano.jp":                      ICANN,
+	"chijiwa.nagasaki.jp":                    ICANN,
+	"futsu.nagasaki.jp":                      ICANN,
+	"goto.nagasaki.jp":                       ICANN,
+	"hasami.nagasaki.jp":                     ICANN,
+	"hirado.nagasaki.jp":                     ICANN,
+	"iki.nagasaki.jp":                        ICANN,
+	"isahaya.nagasaki.jp":                    ICANN,
+	"kawatana.nagasaki.jp":                   ICANN,
+	"kuchinotsu.nagasaki.jp":                 ICANN,
+	"matsuura.nagasaki.jp":                   ICANN,
+	"nagasaki.nagasaki.jp":                   ICANN,
+	"obama.nagasaki.jp":                      ICANN,
+	"omura.nagasaki.jp":                      ICANN,
+	"oseto.nagasaki.jp":                      ICANN,
+	"saikai.nagasaki.jp":                     ICANN,
+	"sasebo.nagasaki.jp":                     ICANN,
+	"seihi.nagasaki.jp":                      ICANN,
+	"shimabara.nagasaki.jp":                  ICANN,
+	"shinkamigoto.nagasaki.jp":               ICANN,
+	"togitsu.nagasaki.jp":                    ICANN,
+	"tsushima.nagasaki.jp":                   ICANN,
+	"unzen.nagasaki.jp":                      ICANN,
+	"ando.nara.jp":                           ICANN,
+	"gose.nara.jp":                           ICANN,
+	"heguri.nara.jp":                         ICANN,
+	"higashiyoshino.nara.jp":                 ICANN,
+	"ikaruga.nara.jp":                        ICANN,
+	"ikoma.nara.jp":                          ICANN,
+	"kamikitayama.nara.jp":                   ICANN,
+	"kanmaki.nara.jp":                        ICANN,
+	"kashiba.nara.jp":                        ICANN,
+	"kashihara.nara.jp":                      ICANN,
+	"katsuragi.nara.jp":                      ICANN,
+	"kawai.nara.jp":                          ICANN,
+	"kawakami.nara.jp":                       ICANN,
+	"kawanishi.nara.jp":                      ICANN,
+	"koryo.nara.jp":                          ICANN,
+	"kurotaki.nara.jp":                       ICANN,
+	"mitsue.nara.jp":                         ICANN,
+	"miyake.nara.jp":                         ICANN,
+	"nara.nara.jp":                           ICANN,
+	"nosegawa.nara.jp":                       ICANN,
+	"oji.nara.jp":                            ICANN,
+	"ouda.nara.jp":                           ICANN,
+	"oyodo.nara.jp":                          ICANN,
+	"sakurai.nara.jp":                        ICANN,
+	"sango.nara.jp":                          ICANN,
+	"shimoichi.nara.jp":                      ICANN,
+	"shimokitayama.nara.jp":                  ICANN,
+	"shinjo.nara.jp":                         ICANN,
+	"soni.nara.jp":                           ICANN,
+	"takatori.nara.jp":                       ICANN,
+	"tawaramoto.nara.jp":                     ICANN,
+	"tenkawa.nara.jp":                        ICANN,
+	"tenri.nara.jp":                          ICANN,
+	"uda.nara.jp":                            ICANN,
+	"yamatokoriyama.nara.jp":                 ICANN,
+	"yamatotakada.nara.jp":                   ICANN,
+	"yamazoe.nara.jp":                        ICANN,
+	"yoshino.nara.jp":                        ICANN,
+	"aga.niigata.jp":                         ICANN,
+	"agano.niigata.jp":                       ICANN,
+	"gosen.niigata.jp":                       ICANN,
+	"itoigawa.niigata.jp":                    ICANN,
+	"izumozaki.niigata.jp":                   ICANN,
+	"joetsu.niigata.jp":                      ICANN,
+	"kamo.niigata.jp":                        ICANN,
+	"kariwa.niigata.jp":                      ICANN,
+	"kashiwazaki.niigata.jp":                 ICANN,
+	"minamiuonuma.niigata.jp":                ICANN,
+	"mitsuke.niigata.jp":                     ICANN,
+	"muika.niigata.jp":                       ICANN,
+	"murakami.niigata.jp":                    ICANN,
+	"myoko.niigata.jp":                       ICANN,
+	"nagaoka.niigata.jp":                     ICANN,
+	"niigata.niigata.jp":                     ICANN,
+	"ojiya.niigata.jp":                       ICANN,
+	"omi.niigata.jp":                         ICANN,
+	"sado.niigata.jp":                        ICANN,
+	"sanjo.niigata.jp":                       ICANN,
+	"seiro.niigata.jp":                       ICANN,
+	"seirou.niigata.jp":                      ICANN,
+	"sekikawa.niigata.jp":                    ICANN,
+	"shibata.niigata.jp":                     ICANN,
+	"tagami.niigata.jp":                      ICANN,
+	"tainai.niigata.jp":                      ICANN,
+	"tochio.niigata.jp":                      ICANN,
+	"tokamachi.niigata.jp":                   ICANN,
+	"tsubame.niigata.jp":                     ICANN,
+	"tsunan.niigata.jp":                      ICANN,
+	"uonuma.niigata.jp":                      ICANN,
+	"yahiko.niigata.jp":                      ICANN,
+	"yoita.niigata.jp":                       ICANN,
+	"yuzawa.niigata.jp":                      ICANN,
+	"beppu.oita.jp":                          ICANN,
+	"bungoono.oita.jp":                       ICANN,
+	"bungotakada.oita.jp":                    ICANN,
+	"hasama.oita.jp":                         ICANN,
+	"hiji.oita.jp":                           ICANN,
+	"himeshima.oita.jp":                      ICANN,
+	"hita.oita.jp":                           ICANN,
+	"kamitsue.oita.jp":                       ICANN,
+	"kokonoe.oita.jp":                        ICANN,
+	"kuju.oita.jp":                           ICANN,
+	"kunisaki.oita.jp":                       ICANN,
+	"kusu.oita.jp":                           ICANN,
+	"oita.oita.jp":                           ICANN,
+	"saiki.oita.jp":                          ICANN,
+	"taketa.oita.jp":                         ICANN,
+	"tsukumi.oita.jp":                        ICANN,
+	"usa.oita.jp":                            ICANN,
+	"usuki.oita.jp":                          ICANN,
+	"yufu.oita.jp":                           ICANN,
+	"akaiwa.okayama.jp":                      ICANN,
+	"asakuchi.okayama.jp":                    ICANN,
+	"bizen.okayama.jp":                       ICANN,
+	"hayashima.okayama.jp":                   ICANN,
+	"ibara.okayama.jp":                       ICANN,
+	"kagamino.okayama.jp":                    ICANN,
+	"kasaoka.okayama.jp":                     ICANN,
+	"kibichuo.okayama.jp":                    ICANN,
+	"kumenan.okayama.jp":                     ICANN,
+	"kurashiki.okayama.jp":                   ICANN,
+	"maniwa.okayama.jp":                      ICANN,
+	"misaki.okayama.jp":                      ICANN,
+	"nagi.okayama.jp":                        ICANN,
+	"niimi.okayama.jp":                       ICANN,
+	"nishiawakura.okayama.jp":                ICANN,
+	"okayama.okayama.jp":                     ICANN,
+	"satosho.okayama.jp":                     ICANN,
+	"setouchi.okayama.jp":                    ICANN,
+	"shinjo.okayama.jp":                      ICANN,
+	"shoo.okayama.jp":                        ICANN,
+	"soja.okayama.jp":                        ICANN,
+	"takahashi.okayama.jp":                   ICANN,
+	"tamano.okayama.jp":                      ICANN,
+	"tsuyama.okayama.jp":                     ICANN,
+	"wake.okayama.jp":                        ICANN,
+	"yakage.okayama.jp":                      ICANN,
+	"aguni.okinawa.jp":                       ICANN,
+	"ginowan.okinawa.jp":                     ICANN,
+	"ginoza.okinawa.jp":                      ICANN,
+	"gushikami.okinawa.jp":                   ICANN,
+	"haebaru.okinawa.jp":                     ICANN,
+	"higashi.okinawa.jp":                     ICANN,
+	"hirara.okinawa.jp":                      ICANN,
+	"iheya.okinawa.jp":                       ICANN,
+	"ishigaki.okinawa.jp":                    ICANN,
+	"ishikawa.okinawa.jp":                    ICANN,
+	"itoman.okinawa.jp":                      ICANN,
+	"izena.okinawa.jp":                       ICANN,
+	"kadena.okinawa.jp":                      ICANN,
+	"kin.okinawa.jp":                         ICANN,
+	"kitadaito.okinawa.jp":                   ICANN,
+	"kitanakagusuku.okinawa.jp":              ICANN,
+	"kumejima.okinawa.jp":                    ICANN,
+	"kunigami.okinawa.jp":                    ICANN,
+	"minamidaito.okinawa.jp":                 ICANN,
+	"motobu.okinawa.jp":                      ICANN,
+	"nago.okinawa.jp":                        ICANN,
+	"naha.okinawa.jp":                        ICANN,
+	"nakagusuku.okinawa.jp":                  ICANN,
+	"nakijin.okinawa.jp":                     ICANN,
+	"nanjo.okinawa.jp":                       ICANN,
+	"nishihara.okinawa.jp":                   ICANN,
+	"ogimi.okinawa.jp":                       ICANN,
+	"okinawa.okinawa.jp":                     ICANN,
+	"onna.okinawa.jp":                        ICANN,
+	"shimoji.okinawa.jp":                     ICANN,
+	"taketomi.okinawa.jp":                    ICANN,
+	"tarama.okinawa.jp":                      ICANN,
+	"tokashiki.okinawa.jp":                   ICANN,
+	"tomigusuku.okinawa.jp":                  ICANN,
+	"tonaki.okinawa.jp":                      ICANN,
+	"urasoe.okinawa.jp":                      ICANN,
+	"uruma.okinawa.jp":                       ICANN,
+	"yaese.okinawa.jp":                       ICANN,
+	"yomitan.okinawa.jp":                     ICANN,
+	"yonabaru.okinawa.jp":                    ICANN,
+	"yonaguni.okinawa.jp":                    ICANN,
+	"zamami.okinawa.jp":                      ICANN,
+	"abeno.osaka.jp":                         ICANN,
+	"chihayaakasaka.osaka.jp":                ICANN,
+	"chuo.osaka.jp":                          ICANN,
+	"daito.osaka.jp":                         ICANN,
+	"fujiidera.osaka.jp":                     ICANN,
+	"habikino.osaka.jp":                      ICANN,
+	"hannan.osaka.jp":                        ICANN,
+	"higashiosaka.osaka.jp":                  ICANN,
+	"higashisumiyoshi.osaka.jp":              ICANN,
+	"higashiyodogawa.osaka.jp":               ICANN,
+	"hirakata.osaka.jp":                      ICANN,
+	"ibaraki.osaka.jp":                       ICANN,
+	"ikeda.osaka.jp":                         ICANN,
+	"izumi.osaka.jp":                         ICANN,
+	"izumiotsu.osaka.jp":                     ICANN,
+	"izumisano.osaka.jp":                     ICANN,
+	"kadoma.osaka.jp":                        ICANN,
+	"kaizuka.osaka.jp":                       ICANN,
+	"kanan.osaka.jp":                         ICANN,
+	"kashiwara.osaka.jp":                     ICANN,
+	"katano.osaka.jp":                        ICANN,
+	"kawachinagano.osaka.jp":                 ICANN,
+	"kishiwada.osaka.jp":                     ICANN,
+	"kita.osaka.jp":                          ICANN,
+	"kumatori.osaka.jp":                      ICANN,
+	"matsubara.osaka.jp":                     ICANN,
+	"minato.osaka.jp":                        ICANN,
+	"minoh.osaka.jp":                         ICANN,
+	"misaki.osaka.jp":                        ICANN,
+	"moriguchi.osaka.jp":                     ICANN,
+	"neyagawa.osaka.jp":                      ICANN,
+	"nishi.osaka.jp":                         ICANN,
+	"nose.osaka.jp":                          ICANN,
+	"osakasayama.osaka.jp":                   ICANN,
+	"sakai.osaka.jp":                         ICANN,
+	"sayama.osaka.jp":                        ICANN,
+	"sennan.osaka.jp":                        ICANN,
+	"settsu.osaka.jp":                        ICANN,
+	"shijonawate.osaka.jp":                   ICANN,
+	"shimamoto.osaka.jp":                     ICANN,
+	"suita.osaka.jp":                         ICANN,
+	"tadaoka.osaka.jp":                       ICANN,
+	"taishi.osaka.jp":                        ICANN,
+	"tajiri.osaka.jp":                        ICANN,
+	"takaishi.osaka.jp":                      ICANN,
+	"takatsuki.osaka.jp":                     ICANN,
+	"tondabayashi.osaka.jp":                  ICANN,
+	"toyonaka.osaka.jp":                      ICANN,
+	"toyono.osaka.jp":                        ICANN,
+	"yao.osaka.jp":                           ICANN,
+	"ariake.saga.jp":                         ICANN,
+	"arita.saga.jp":                          ICANN,
+	"fukudomi.saga.jp":                       ICANN,
+	"genkai.saga.jp":                         ICANN,
+	"hamatama.saga.jp":                       ICANN,
+	"hizen.saga.jp":                          ICANN,
+	"imari.saga.jp":                          ICANN,
+	"kamimine.saga.jp":                       ICANN,
+	"kanzaki.saga.jp":                        ICANN,
+	"karatsu.saga.jp":                        ICANN,
+	"kashima.saga.jp":                        ICANN,
+	"kitagata.saga.jp":                       ICANN,
+	"kitahata.saga.jp":                       ICANN,
+	"kiyama.saga.jp":                         ICANN,
+	"kouhoku.saga.jp":                        ICANN,
+	"kyuragi.saga.jp":                        ICANN,
+	"nishiarita.saga.jp":                     ICANN,
+	"ogi.saga.jp":                            ICANN,
+	"omachi.saga.jp":                         ICANN,
+	"ouchi.saga.jp":                          ICANN,
+	"saga.saga.jp":                           ICANN,
+	"shiroishi.saga.jp":                      ICANN,
+	"taku.saga.jp":                           ICANN,
+	"tara.saga.jp":                           ICANN,
+	"tosu.saga.jp":                           ICANN,
+	"yoshinogari.saga.jp":                    ICANN,
+	"arakawa.saitama.jp":                     ICANN,
+	"asaka.saitama.jp":                       ICANN,
+	"chichibu.saitama.jp":                    ICANN,
+	"fujimi.saitama.jp":                      ICANN,
+	"fujimino.saitama.jp":                    ICANN,
+	"fukaya.saitama.jp":                      ICANN,
+	"hanno.saitama.jp":                       ICANN,
+	"hanyu.saitama.jp":                       ICANN,
+	"hasuda.saitama.jp":                      ICANN,
+	"hatogaya.saitama.jp":                    ICANN,
+	"hatoyama.saitama.jp":                    ICANN,
+	"hidaka.saitama.jp":                      ICANN,
+	"higashichichibu.saitama.jp":             ICANN,
+	"higashimatsuyama.saitama.jp":            ICANN,
+	"honjo.saitama.jp":                       ICANN,
+	"ina.saitama.jp":                         ICANN,
+	"iruma.saitama.jp":                       ICANN,
+	"iwatsuki.saitama.jp":                    ICANN,
+	"kamiizumi.saitama.jp":                   ICANN,
+	"kamikawa.saitama.jp":                    ICANN,
+	"kamisato.saitama.jp":                    ICANN,
+	"kasukabe.saitama.jp":                    ICANN,
+	"kawagoe.saitama.jp":                     ICANN,
+	"kawaguchi.saitama.jp":                   ICANN,
+	"kawajima.saitama.jp":                    ICANN,
+	"kazo.saitama.jp":                        ICANN,
+	"kitamoto.saitama.jp":                    ICANN,
+	"koshigaya.saitama.jp":                   ICANN,
+	"kounosu.saitama.jp":                     ICANN,
+	"kuki.saitama.jp":                        ICANN,
+	"kumagaya.saitama.jp":                    ICANN,
+	"matsubushi.saitama.jp":                  ICANN,
+	"minano.saitama.jp":                      ICANN,
+	"misato.saitama.jp":                      ICANN,
+	"miyashiro.saitama.jp":                   ICANN,
+	"miyoshi.saitama.jp":                     ICANN,
+	"moroyama.saitama.jp":                    ICANN,
+	"nagatoro.saitama.jp":                    ICANN,
+	"namegawa.saitama.jp":                    ICANN,
+	"niiza.saitama.jp":                       ICANN,
+	"ogano.saitama.jp":                       ICANN,
+	"ogawa.saitama.jp":                       ICANN,
+	"ogose.saitama.jp":                       ICANN,
+	"okegawa.saitama.jp":                     ICANN,
+	"omiya.saitama.jp":                       ICANN,
+	"otaki.saitama.jp":                       ICANN,
+	"ranzan.saitama.jp":                      ICANN,
+	"ryokami.saitama.jp":                     ICANN,
+	"saitama.saitama.jp":                     ICANN,
+	"sakado.saitama.jp":                      ICANN,
+	"satte.saitama.jp":                       ICANN,
+	"sayama.saitama.jp":                      ICANN,
+	"shiki.saitama.jp":                       ICANN,
+	"shiraoka.saitama.jp":                    ICANN,
+	"soka.saitama.jp":                        ICANN,
+	"sugito.saitama.jp":                      ICANN,
+	"toda.saitama.jp":                        ICANN,
+	"tokigawa.saitama.jp":                    ICANN,
+	"tokorozawa.saitama.jp":                  ICANN,
+	"tsurugashima.saitama.jp":                ICANN,
+	"urawa.saitama.jp":                       ICANN,
+	"warabi.saitama.jp":                      ICANN,
+	"yashio.saitama.jp":                      ICANN,
+	"yokoze.saitama.jp":                      ICANN,
+	"yono.saitama.jp":                        ICANN,
+	"yorii.saitama.jp":                       ICANN,
+	"yoshida.saitama.jp":                     ICANN,
+	"yoshikawa.saitama.jp":                   ICANN,
+	"yoshimi.saitama.jp":                     ICANN,
+	"aisho.shiga.jp":                         ICANN,
+	"gamo.shiga.jp":                          ICANN,
+	"higashiomi.shiga.jp":                    ICANN,
+	"hikone.shiga.jp":                        ICANN,
+	"koka.shiga.jp":                          ICANN,
+	"konan.shiga.jp":                         ICANN,
+	"kosei.shiga.jp":                         ICANN,
+	"koto.shiga.jp":                          ICANN,
+	"kusatsu.shiga.jp":                       ICANN,
+	"maibara.shiga.jp":                       ICANN,
+	"moriyama.shiga.jp":                      ICANN,
+	"nagahama.shiga.jp":                      ICANN,
+	"nishiazai.shiga.jp":                     ICANN,
+	"notogawa.shiga.jp":                      ICANN,
+	"omihachiman.shiga.jp":                   ICANN,
+	"otsu.shiga.jp":                          ICANN,
+	"ritto.shiga.jp":                         ICANN,
+	"ryuoh.shiga.jp":                         ICANN,
+	"takashima.shiga.jp":                     ICANN,
+	"takatsuki.shiga.jp":                     ICANN,
+	"torahime.shiga.jp":                      ICANN,
+	"toyosato.shiga.jp":                      ICANN,
+	"yasu.shiga.jp":                          ICANN,
+	"akagi.shimane.jp":                       ICANN,
+	"ama.shimane.jp":                         ICANN,
+	"gotsu.shimane.jp":                       ICANN,
+	"hamada.shimane.jp":                      ICANN,
+	"higashiizumo.shimane.jp":                ICANN,
+	"hikawa.shimane.jp":                      ICANN,
+	"hikimi.shimane.jp":                      ICANN,
+	"izumo.shimane.jp":                       ICANN,
+	"kakinoki.shimane.jp":                    ICANN,
+	"masuda.shimane.jp":                      ICANN,
+	"matsue.shimane.jp":                      ICANN,
+	"misato.shimane.jp":                      ICANN,
+	"nishinoshima.shimane.jp":                ICANN,
+	"ohda.shimane.jp":                        ICANN,
+	"okinoshima.shimane.jp":                  ICANN,
+	"okuizumo.shimane.jp":                    ICANN,
+	"shimane.shimane.jp":                     ICANN,
+	"tamayu.shimane.jp":                      ICANN,
+	"tsuwano.shimane.jp":                     ICANN,
+	"unnan.shimane.jp":                       ICANN,
+	"yakumo.shimane.jp":                      ICANN,
+	"yasugi.shimane.jp":                      ICANN,
+	"yatsuka.shimane.jp":                     ICANN,
+	"arai.shizuoka.jp":                       ICANN,
+	"atami.shizuoka.jp":                      ICANN,
+	"fuji.shizuoka.jp":                       ICANN,
+	"fujieda.shizuoka.jp":                    ICANN,
+	"fujikawa.shizuoka.jp":                   ICANN,
+	"fujinomiya.shizuoka.jp":                 ICANN,
+	"fukuroi.shizuoka.jp":                    ICANN,
+	"gotemba.shizuoka.jp":                    ICANN,
+	"haibara.shizuoka.jp":                    ICANN,
+	"hamamatsu.shizuoka.jp":                  ICANN,
+	"higashiizu.shizuoka.jp":                 ICANN,
+	"ito.shizuoka.jp":                        ICANN,
+	"iwata.shizuoka.jp":                      ICANN,
+	"izu.shizuoka.jp":                        ICANN,
+	"izunokuni.shizuoka.jp":                  ICANN,
+	"kakegawa.shizuoka.jp":                   ICANN,
+	"kannami.shizuoka.jp":                    ICANN,
+	"kawanehon.shizuoka.jp":                  ICANN,
+	"kawazu.shizuoka.jp":                     ICANN,
+	"kikugawa.shizuoka.jp":                   ICANN,
+	"kosai.shizuoka.jp":                      ICANN,
+	"makinohara.shizuoka.jp":                 ICANN,
+	"matsuzaki.shizuoka.jp":                  ICANN,
+	"minamiizu.shizuoka.jp":                  ICANN,
+	"mishima.shizuoka.jp":                    ICANN,
+	"morimachi.shizuoka.jp":                  ICANN,
+	"nishiizu.shizuoka.jp":                   ICANN,
+	"numazu.shizuoka.jp":                     ICANN,
+	"omaezaki.shizuoka.jp":                   ICANN,
+	"shimada.shizuoka.jp":                    ICANN,
+	"shimizu.shizuoka.jp":                    ICANN,
+	"shimoda.shizuoka.jp":                    ICANN,
+	"shizuoka.shizuoka.jp":                   ICANN,
+	"susono.shizuoka.jp":                     ICANN,
+	"yaizu.shizuoka.jp":                      ICANN,
+	"yoshida.shizuoka.jp":                    ICANN,
+	"ashikaga.tochigi.jp":                    ICANN,
+	"bato.tochigi.jp":                        ICANN,
+	"haga.tochigi.jp":                        ICANN,
+	"ichikai.tochigi.jp":                     ICANN,
+	"iwafune.tochigi.jp":                     ICANN,
+	"kaminokawa.tochigi.jp":                  ICANN,
+	"kanuma.tochigi.jp":                      ICANN,
+	"karasuyama.tochigi.jp":                  ICANN,
+	"kuroiso.tochigi.jp":                     ICANN,
+	"mashiko.tochigi.jp":                     ICANN,
+	"mibu.tochigi.jp":                        ICANN,
+	"moka.tochigi.jp":                        ICANN,
+	"motegi.tochigi.jp":                      ICANN,
+	"nasu.tochigi.jp":                        ICANN,
+	"nasushiobara.tochigi.jp":                ICANN,
+	"nikko.tochigi.jp":                       ICANN,
+	"nishikata.tochigi.jp":                   ICANN,
+	"nogi.tochigi.jp":                        ICANN,
+	"ohira.tochigi.jp":                       ICANN,
+	"ohtawara.tochigi.jp":                    ICANN,
+	"oyama.tochigi.jp":                       ICANN,
+	"sakura.tochigi.jp":                      ICANN,
+	"sano.tochigi.jp":                        ICANN,
+	"shimotsuke.tochigi.jp":                  ICANN,
+	"shioya.tochigi.jp":                      ICANN,
+	"takanezawa.tochigi.jp":                  ICANN,
+	"tochigi.tochigi.jp":                     ICANN,
+	"tsuga.tochigi.jp":                       ICANN,
+	"ujiie.tochigi.jp":                       ICANN,
+	"utsunomiya.tochigi.jp":                  ICANN,
+	"yaita.tochigi.jp":                       ICANN,
+	"aizumi.tokushima.jp":                    ICANN,
+	"anan.tokushima.jp":                      ICANN,
+	"ichiba.tokushima.jp":                    ICANN,
+	"itano.tokushima.jp":                     ICANN,
+	"kainan.tokushima.jp":                    ICANN,
+	"komatsushima.tokushima.jp":              ICANN,
+	"matsushige.tokushima.jp":                ICANN,
+	"mima.tokushima.jp":                      ICANN,
+	"minami.tokushima.jp":                    ICANN,
+	"miyoshi.tokushima.jp":                   ICANN,
+	"mugi.tokushima.jp":                      ICANN,
+	"nakagawa.tokushima.jp":                  ICANN,
+	"naruto.tokushima.jp":                    ICANN,
+	"sanagochi.tokushima.jp":                 ICANN,
+	"shishikui.tokushima.jp":                 ICANN,
+	"tokushima.tokushima.jp":                 ICANN,
+	"wajiki.tokushima.jp":                    ICANN,
+	"adachi.tokyo.jp":                        ICANN,
+	"akiruno.tokyo.jp":                       ICANN,
+	"akishima.tokyo.jp":                      ICANN,
+	"aogashima.tokyo.jp":                     ICANN,
+	"arakawa.tokyo.jp":                       ICANN,
+	"bunkyo.tokyo.jp":                        ICANN,
+	"chiyoda.tokyo.jp":                       ICANN,
+	"chofu.tokyo.jp":                         ICANN,
+	"chuo.tokyo.jp":                          ICANN,
+	"edogawa.tokyo.jp":                       ICANN,
+	"fuchu.tokyo.jp":                         ICANN,
+	"fussa.tokyo.jp":                         ICANN,
+	"hachijo.tokyo.jp":                       ICANN,
+	"hachioji.tokyo.jp":                      ICANN,
+	"hamura.tokyo.jp":                        ICANN,
+	"higashikurume.tokyo.jp":                 ICANN,
+	"higashimurayama.tokyo.jp":               ICANN,
+	"higashiyamato.tokyo.jp":                 ICANN,
+	"hino.tokyo.jp":                          ICANN,
+	"hinode.tokyo.jp":                        ICANN,
+	"hinohara.tokyo.jp":                      ICANN,
+	"inagi.tokyo.jp":                         ICANN,
+	"itabashi.tokyo.jp":                      ICANN,
+	"katsushika.tokyo.jp":                    ICANN,
+	"kita.tokyo.jp":                          ICANN,
+	"kiyose.tokyo.jp":                        ICANN,
+	"kodaira.tokyo.jp":                       ICANN,
+	"koganei.tokyo.jp":                       ICANN,
+	"kokubunji.tokyo.jp":                     ICANN,
+	"komae.tokyo.jp":                         ICANN,
+	"koto.tokyo.jp":                          ICANN,
+	"kouzushima.tokyo.jp":                    ICANN,
+	"kunitachi.tokyo.jp":                     ICANN,
+	"machida.tokyo.jp":                       ICANN,
+	"meguro.tokyo.jp":                        ICANN,
+	"minato.tokyo.jp":                        ICANN,
+	"mitaka.tokyo.jp":                        ICANN,
+	"mizuho.tokyo.jp":                        ICANN,
+	"musashimurayama.tokyo.jp":               ICANN,
+	"musashino.tokyo.jp":                     ICANN,
+	"nakano.tokyo.jp":                        ICANN,
+	"nerima.tokyo.jp":                        ICANN,
+	"ogasawara.tokyo.jp":                     ICANN,
+	"okutama.tokyo.jp":                       ICANN,
+	"ome.tokyo.jp":                           ICANN,
+	"oshima.tokyo.jp":                        ICANN,
+	"ota.tokyo.jp":                           ICANN,
+	"setagaya.tokyo.jp":                      ICANN,
+	"shibuya.tokyo.jp":                       ICANN,
+	"shinagawa.tokyo.jp":                     ICANN,
+	"shinjuku.tokyo.jp":                      ICANN,
+	"suginami.tokyo.jp":                      ICANN,
+	"sumida.tokyo.jp":                        ICANN,
+	"tachikawa.tokyo.jp":                     ICANN,
+	"taito.tokyo.jp":                         ICANN,
+	"tama.tokyo.jp":                          ICANN,
+	"toshima.tokyo.jp":                       ICANN,
+	"chizu.tottori.jp":                       ICANN,
+	"hino.tottori.jp":                        ICANN,
+	"kawahara.tottori.jp":                    ICANN,
+	"koge.tottori.jp":                        ICANN,
+	"kotoura.tottori.jp":                     ICANN,
+	"misasa.tottori.jp":                      ICANN,
+	"nanbu.tottori.jp":                       ICANN,
+	"nichinan.tottori.jp":                    ICANN,
+	"sakaiminato.tottori.jp":                 ICANN,
+	"tottori.tottori.jp":                     ICANN,
+	"wakasa.tottori.jp":                      ICANN,
+	"yazu.tottori.jp":                        ICANN,
+	"yonago.tottori.jp":                      ICANN,
+	"asahi.toyama.jp":                        ICANN,
+	"fuchu.toyama.jp":                        ICANN,
+	"fukumitsu.toyama.jp":                    ICANN,
+	"funahashi.toyama.jp":                    ICANN,
+	"himi.toyama.jp":                         ICANN,
+	"imizu.toyama.jp":                        ICANN,
+	"inami.toyama.jp":                        ICANN,
+	"johana.toyama.jp":                       ICANN,
+	"kamiichi.toyama.jp":                     ICANN,
+	"kurobe.toyama.jp":                       ICANN,
+	"nakaniikawa.toyama.jp":                  ICANN,
+	"namerikawa.toyama.jp":                   ICANN,
+	"nanto.toyama.jp":                        ICANN,
+	"nyuzen.toyama.jp":                       ICANN,
+	"oyabe.toyama.jp":                        ICANN,
+	"taira.toyama.jp":                        ICANN,
+	"takaoka.toyama.jp":                      ICANN,
+	"tateyama.toyama.jp":                     ICANN,
+	"toga.toyama.jp":                         ICANN,
+	"tonami.toyama.jp":                       ICANN,
+	"toyama.toyama.jp":                       ICANN,
+	"unazuki.toyama.jp":                      ICANN,
+	"uozu.toyama.jp":                         ICANN,
+	"yamada.toyama.jp":                       ICANN,
+	"arida.wakayama.jp":                      ICANN,
+	"aridagawa.wakayama.jp":                  ICANN,
+	"gobo.wakayama.jp":                       ICANN,
+	"hashimoto.wakayama.jp":                  ICANN,
+	"hidaka.wakayama.jp":                     ICANN,
+	"hirogawa.wakayama.jp":                   ICANN,
+	"inami.wakayama.jp":                      ICANN,
+	"iwade.wakayama.jp":                      ICANN,
+	"kainan.wakayama.jp":                     ICANN,
+	"kamitonda.wakayama.jp":                  ICANN,
+	"katsuragi.wakayama.jp":                  ICANN,
+	"kimino.wakayama.jp":                     ICANN,
+	"kinokawa.wakayama.jp":                   ICANN,
+	"kitayama.wakayama.jp":                   ICANN,
+	"koya.wakayama.jp":                       ICANN,
+	"koza.wakayama.jp":                       ICANN,
+	"kozagawa.wakayama.jp":                   ICANN,
+	"kudoyama.wakayama.jp":                   ICANN,
+	"kushimoto.wakayama.jp":                  ICANN,
+	"mihama.wakayama.jp":                     ICANN,
+	"misato.wakayama.jp":                     ICANN,
+	"nachikatsuura.wakayama.jp":              ICANN,
+	"shingu.wakayama.jp":                     ICANN,
+	"shirahama.wakayama.jp":                  ICANN,
+	"taiji.wakayama.jp":                      ICANN,
+	"tanabe.wakayama.jp":                     ICANN,
+	"wakayama.wakayama.jp":                   ICANN,
+	"yuasa.wakayama.jp":                      ICANN,
+	"yura.wakayama.jp":                       ICANN,
+	"asahi.yamagata.jp":                      ICANN,
+	"funagata.yamagata.jp":                   ICANN,
+	"higashine.yamagata.jp":                  ICANN,
+	"iide.yamagata.jp":                       ICANN,
+	"kahoku.yamagata.jp":                     ICANN,
+	"kaminoyama.yamagata.jp":                 ICANN,
+	"kaneyama.yamagata.jp":                   ICANN,
+	"kawanishi.yamagata.jp":                  ICANN,
+	"mamurogawa.yamagata.jp":                 ICANN,
+	"mikawa.yamagata.jp":                     ICANN,
+	"murayama.yamagata.jp":                   ICANN,
+	"nagai.yamagata.jp":                      ICANN,
+	"nakayama.yamagata.jp":                   ICANN,
+	"nanyo.yamagata.jp":                      ICANN,
+	"nishikawa.yamagata.jp":                  ICANN,
+	"obanazawa.yamagata.jp":                  ICANN,
+	"oe.yamagata.jp":                         ICANN,
+	"oguni.yamagata.jp":                      ICANN,
+	"ohkura.yamagata.jp":                     ICANN,
+	"oishida.yamagata.jp":                    ICANN,
+	"sagae.yamagata.jp":                      ICANN,
+	"sakata.yamagata.jp":                     ICANN,
+	"sakegawa.yamagata.jp":                   ICANN,
+	"shinjo.yamagata.jp":                     ICANN,
+	"shirataka.yamagata.jp":                  ICANN,
+	"shonai.yamagata.jp":                     ICANN,
+	"takahata.yamagata.jp":                   ICANN,
+	"tendo.yamagata.jp":                      ICANN,
+	"tozawa.yamagata.jp":                     ICANN,
+	"tsuruoka.yamagata.jp":                   ICANN,
+	"yamagata.yamagata.jp":                   ICANN,
+	"yamanobe.yamagata.jp":                   ICANN,
+	"yonezawa.yamagata.jp":                   ICANN,
+	"yuza.yamagata.jp":                       ICANN,
+	"abu.yamaguchi.jp":                       ICANN,
+	"hagi.yamaguchi.jp":                      ICANN,
+	"hikari.yamaguchi.jp":                    ICANN,
+	"hofu.yamaguchi.jp":                      ICANN,
+	"iwakuni.yamaguchi.jp":                   ICANN,
+	"kudamatsu.yamaguchi.jp":                 ICANN,
+	"mitou.yamaguchi.jp":                     ICANN,
+	"nagato.yamaguchi.jp":                    ICANN,
+	"oshima.yamaguchi.jp":                    ICANN,
+	"shimonoseki.yamaguchi.jp":               ICANN,
+	"shunan.yamaguchi.jp":                    ICANN,
+	"tabuse.yamaguchi.jp":                    ICANN,
+	"tokuyama.yamaguchi.jp":                  ICANN,
+	"toyota.yamaguchi.jp":                    ICANN,
+	"ube.yamaguchi.jp":                       ICANN,
+	"yuu.yamaguchi.jp":                       ICANN,
+	"chuo.yamanashi.jp":                      ICANN,
+	"doshi.yamanashi.jp":                     ICANN,
+	"fuefuki.yamanashi.jp":                   ICANN,
+	"fujikawa.yamanashi.jp":                  ICANN,
+	"fujikawaguchiko.yamanashi.jp":           ICANN,
+	"fujiyoshida.yamanashi.jp":               ICANN,
+	"hayakawa.yamanashi.jp":                  ICANN,
+	"hokuto.yamanashi.jp":                    ICANN,
+	"ichikawamisato.yamanashi.jp":            ICANN,
+	"kai.yamanashi.jp":                       ICANN,
+	"kofu.yamanashi.jp":                      ICANN,
+	"koshu.yamanashi.jp":                     ICANN,
+	"kosuge.yamanashi.jp":                    ICANN,
+	"minami-alps.yamanashi.jp":               ICANN,
+	"minobu.yamanashi.jp":                    ICANN,
+	"nakamichi.yamanashi.jp":                 ICANN,
+	"nanbu.yamanashi.jp":                     ICANN,
+	"narusawa.yamanashi.jp":                  ICANN,
+	"nirasaki.yamanashi.jp":                  ICANN,
+	"nishikatsura.yamanashi.jp":              ICANN,
+	"oshino.yamanashi.jp":                    ICANN,
+	"otsuki.yamanashi.jp":                    ICANN,
+	"showa.yamanashi.jp":                     ICANN,
+	"tabayama.yamanashi.jp":                  ICANN,
+	"tsuru.yamanashi.jp":                     ICANN,
+	"uenohara.yamanashi.jp":                  ICANN,
+	"yamanakako.yamanashi.jp":                ICANN,
+	"yamanashi.yamanashi.jp":                 ICANN,
+	"ke":                                     ICANN,
+	"ac.ke":                                  ICANN,
+	"co.ke":                                  ICANN,
+	"go.ke":                                  ICANN,
+	"info.ke":                                ICANN,
+	"me.ke":                                  ICANN,
+	"mobi.ke":                                ICANN,
+	"ne.ke":                                  ICANN,
+	"or.ke":                                  ICANN,
+	"sc.ke":                                  ICANN,
+	"kg":                                     ICANN,
+	"com.kg":                                 ICANN,
+	"edu.kg":                                 ICANN,
+	"gov.kg":                                 ICANN,
+	"mil.kg":                                 ICANN,
+	"net.kg":                                 ICANN,
+	"org.kg":                                 ICANN,
+	"kh":                                     ICANN,
+	"com.kh":                                 ICANN,
+	"edu.kh":                                 ICANN,
+	"gov.kh":                                 ICANN,
+	"net.kh":                                 ICANN,
+	"org.kh":                                 ICANN,
+	"ki":                                     ICANN,
+	"biz.ki":                                 ICANN,
+	"com.ki":                                 ICANN,
+	"edu.ki":                                 ICANN,
+	"gov.ki":                                 ICANN,
+	"info.ki":                                ICANN,
+	"net.ki":                                 ICANN,
+	"org.ki":                                 ICANN,
+	"km":                                     ICANN,
+	"ass.km":                                 ICANN,
+	"com.km":                                 ICANN,
+	"edu.km":                                 ICANN,
+	"gov.km":                                 ICANN,
+	"mil.km":                                 ICANN,
+	"nom.km":                                 ICANN,
+	"org.km":                                 ICANN,
+	"prd.km":                                 ICANN,
+	"tm.km":                                  ICANN,
+	"asso.km":                                ICANN,
+	"coop.km":                                ICANN,
+	"gouv.km":                                ICANN,
+	"medecin.km":                             ICANN,
+	"notaires.km":                            ICANN,
+	"pharmaciens.km":                         ICANN,
+	"presse.km":                              ICANN,
+	"veterinaire.km":                         ICANN,
+	"kn":                                     ICANN,
+	"edu.kn":                                 ICANN,
+	"gov.kn":                                 ICANN,
+	"net.kn":                                 ICANN,
+	"org.kn":                                 ICANN,
+	"kp":                                     ICANN,
+	"com.kp":                                 ICANN,
+	"edu.kp":                                 ICANN,
+	"gov.kp":                                 ICANN,
+	"org.kp":                                 ICANN,
+	"rep.kp":                                 ICANN,
+	"tra.kp":                                 ICANN,
+	"kr":                                     ICANN,
+	"ac.kr":                                  ICANN,
+	"ai.kr":                                  ICANN,
+	"co.kr":                                  ICANN,
+	"es.kr":                                  ICANN,
+	"go.kr":                                  ICANN,
+	"hs.kr":                                  ICANN,
+	"io.kr":                                  ICANN,
+	"it.kr":                                  ICANN,
+	"kg.kr":                                  ICANN,
+	"me.kr":                                  ICANN,
+	"mil.kr":                                 ICANN,
+	"ms.kr":                                  ICANN,
+	"ne.kr":                                  ICANN,
+	"or.kr":                                  ICANN,
+	"pe.kr":                                  ICANN,
+	"re.kr":                                  ICANN,
+	"sc.kr":                                  ICANN,
+	"busan.kr":                               ICANN,
+	"chungbuk.kr":                            ICANN,
+	"chungnam.kr":                            ICANN,
+	"daegu.kr":                               ICANN,
+	"daejeon.kr":                             ICANN,
+	"gangwon.kr":                             ICANN,
+	"gwangju.kr":                             ICANN,
+	"gyeongbuk.kr":                           ICANN,
+	"gyeonggi.kr":                            ICANN,
+	"gyeongnam.kr":                           ICANN,
+	"incheon.kr":                             ICANN,
+	"jeju.kr":                                ICANN,
+	"jeonbuk.kr":                             ICANN,
+	"jeonnam.kr":                             ICANN,
+	"seoul.kr":                               ICANN,
+	"ulsan.kr":                               ICANN,
+	"kw":                                     ICANN,
+	"com.kw":                                 ICANN,
+	"edu.kw":                                 ICANN,
+	"emb.kw":                                 ICANN,
+	"gov.kw":                                 ICANN,
+	"ind.kw":                                 ICANN,
+	"net.kw":                                 ICANN,
+	"org.kw":                                 ICANN,
+	"ky":                                     ICANN,
+	"com.ky":                                 ICANN,
+	"edu.ky":                                 ICANN,
+	"net.ky":                                 ICANN,
+	"org.ky":                                 ICANN,
+	"kz":                                     ICANN,
+	"com.kz":                                 ICANN,
+	"edu.kz":                                 ICANN,
+	"gov.kz":                                 ICANN,
+	"mil.kz":                                 ICANN,
+	"net.kz":                                 ICANN,
+	"org.kz":                                 ICANN,
+	"la":                                     ICANN,
+	"com.la":                                 ICANN,
+	"edu.la":                                 ICANN,
+	"gov.la":                                 ICANN,
+	"info.la":                                ICANN,
+	"int.la":                                 ICANN,
+	"net.la":                                 ICANN,
+	"org.la":                                 ICANN,
+	"per.la":                                 ICANN,
+	"lb":                                     ICANN,
+	"com.lb":                                 ICANN,
+	"edu.lb":                                 ICANN,
+	"gov.lb":                                 ICANN,
+	"net.lb":                                 ICANN,
+	"org.lb":                                 ICANN,
+	"lc":                                     ICANN,
+	"co.lc":                                  ICANN,
+	"com.lc":                                 ICANN,
+	"edu.lc":                                 ICANN,
+	"gov.lc":                                 ICANN,
+	"net.lc":                                 ICANN,
+	"org.lc":                                 ICANN,
+	"li":                                     ICANN,
+	"lk":                                     ICANN,
+	"ac.lk":                                  ICANN,
+	"assn.lk":                                ICANN,
+	"com.lk":                                 ICANN,
+	"edu.lk":                                 ICANN,
+	"gov.lk":                                 ICANN,
+	"grp.lk":                                 ICANN,
+	"hotel.lk":                               ICANN,
+	"int.lk":                                 ICANN,
+	"ltd.lk":                                 ICANN,
+	"net.lk":                                 ICANN,
+	"ngo.lk":                                 ICANN,
+	"org.lk":                                 ICANN,
+	"sch.lk":                                 ICANN,
+	"soc.lk":                                 ICANN,
+	"web.lk":                                 ICANN,
+	"lr":                                     ICANN,
+	"com.lr":                                 ICANN,
+	"edu.lr":                                 ICANN,
+	"gov.lr":                                 ICANN,
+	"net.lr":                                 ICANN,
+	"org.lr":                                 ICANN,
+	"ls":                                     ICANN,
+	"ac.ls":                                  ICANN,
+	"biz.ls":                                 ICANN,
+	"co.ls":                                  ICANN,
+	"edu.ls":                                 ICANN,
+	"gov.ls":                                 ICANN,
+	"info.ls":                                ICANN,
+	"net.ls":                                 ICANN,
+	"org.ls":                                 ICANN,
+	"sc.ls":                                  ICANN,
+	"lt":                                     ICANN,
+	"gov.lt":                                 ICANN,
+	"lu":                                     ICANN,
+	"lv":                                     ICANN,
+	"asn.lv":                                 ICANN,
+	"com.lv":                                 ICANN,
+	"conf.lv":                                ICANN,
+	"edu.lv":                                 ICANN,
+	"gov.lv":                                 ICANN,
+	"id.lv":                                  ICANN,
+	"mil.lv":                                 ICANN,
+	"net.lv":                                 ICANN,
+	"org.lv":                                 ICANN,
+	"ly":                                     ICANN,
+	"com.ly":                                 ICANN,
+	"edu.ly":                                 ICANN,
+	"gov.ly":                                 ICANN,
+	"id.ly":                                  ICANN,
+	"med.ly":                                 ICANN,
+	"net.ly":                                 ICANN,
+	"org.ly":                                 ICANN,
+	"plc.ly":                                 ICANN,
+	"sch.ly":                                 ICANN,
+	"ma":                                     ICANN,
+	"ac.ma":                                  ICANN,
+	"co.ma":                                  ICANN,
+	"gov.ma":                                 ICANN,
+	"net.ma":                                 ICANN,
+	"org.ma":                                 ICANN,
+	"press.ma":                               ICANN,
+	"mc":                                     ICANN,
+	"asso.mc":                                ICANN,
+	"tm.mc":                                  ICANN,
+	"md":                                     ICANN,
+	"me":                                     ICANN,
+	"ac.me":                                  ICANN,
+	"co.me":                                  ICANN,
+	"edu.me":                                 ICANN,
+	"gov.me":                                 ICANN,
+	"its.me":                                 ICANN,
+	"net.me":                                 ICANN,
+	"org.me":                                 ICANN,
+	"priv.me":                                ICANN,
+	"mg":                                     ICANN,
+	"co.mg":                                  ICANN,
+	"com.mg":                                 ICANN,
+	"edu.mg":                                 ICANN,
+	"gov.mg":                                 ICANN,
+	"mil.mg":                                 ICANN,
+	"nom.mg":                                 ICANN,
+	"org.mg":                                 ICANN,
+	"prd.mg":                                 ICANN,
+	"mh":                                     ICANN,
+	"mil":                                    ICANN,
+	"mk":                                     ICANN,
+	"com.mk":                                 ICANN,
+	"edu.mk":                                 ICANN,
+	"gov.mk":                                 ICANN,
+	"inf.mk":                                 ICANN,
+	"name.mk":                                ICANN,
+	"net.mk":                                 ICANN,
+	"org.mk":                                 ICANN,
+	"ml":                                     ICANN,
+	"ac.ml":                                  ICANN,
+	"art.ml":                                 ICANN,
+	"asso.ml":                                ICANN,
+	"com.ml":                                 ICANN,
+	"edu.ml":                                 ICANN,
+	"gouv.ml":                                ICANN,
+	"gov.ml":                                 ICANN,
+	"info.ml":                                ICANN,
+	"inst.ml":                                ICANN,
+	"net.ml":                                 ICANN,
+	"org.ml":                                 ICANN,
+	"pr.ml":                                  ICANN,
+	"presse.ml":                              ICANN,
+	"*.mm":                                   ICANN,
+	"mn":                                     ICANN,
+	"edu.mn":                                 ICANN,
+	"gov.mn":                                 ICANN,
+	"org.mn":                                 ICANN,
+	"mo":                                     ICANN,
+	"com.mo":                                 ICANN,
+	"edu.mo":                                 ICANN,
+	"gov.mo":                                 ICANN,
+	"net.mo":                                 ICANN,
+	"org.mo":                                 ICANN,
+	"mobi":                                   ICANN,
+	"mp":                                     ICANN,
+	"mq":                                     ICANN,
+	"mr":                                     ICANN,
+	"gov.mr":                                 ICANN,
+	"ms":                                     ICANN,
+	"com.ms":                                 ICANN,
+	"edu.ms":                                 ICANN,
+	"gov.ms":                                 ICANN,
+	"net.ms":                                 ICANN,
+	"org.ms":                                 ICANN,
+	"mt":                                     ICANN,
+	"com.mt":                                 ICANN,
+	"edu.mt":                                 ICANN,
+	"net.mt":                                 ICANN,
+	"org.mt":                                 ICANN,
+	"mu":                                     ICANN,
+	"ac.mu":                                  ICANN,
+	"co.mu":                                  ICANN,
+	"com.mu":                                 ICANN,
+	"gov.mu":                                 ICANN,
+	"net.mu":                                 ICANN,
+	"or.mu":                                  ICANN,
+	"org.mu":                                 ICANN,
+	"museum":                                 ICANN,
+	"mv":                                     ICANN,
+	"aero.mv":                                ICANN,
+	"biz.mv":                                 ICANN,
+	"com.mv":                                 ICANN,
+	"coop.mv":                                ICANN,
+	"edu.mv":                                 ICANN,
+	"gov.mv":                                 ICANN,
+	"info.mv":                                ICANN,
+	"int.mv":                                 ICANN,
+	"mil.mv":                                 ICANN,
+	"museum.mv":                              ICANN,
+	"name.mv":                                ICANN,
+	"net.mv":                                 ICANN,
+	"org.mv":                                 ICANN,
+	"pro.mv":                                 ICANN,
+	"mw":                                     ICANN,
+	"ac.mw":                                  ICANN,
+	"biz.mw":                                 ICANN,
+	"co.mw":                                  ICANN,
+	"com.mw":                                 ICANN,
+	"coop.mw":                                ICANN,
+	"edu.mw":                                 ICANN,
+	"gov.mw":                                 ICANN,
+	"int.mw":                                 ICANN,
+	"net.mw":                                 ICANN,
+	"org.mw":                                 ICANN,
+	"mx":                                     ICANN,
+	"com.mx":                                 ICANN,
+	"edu.mx":                                 ICANN,
+	"gob.mx":                                 ICANN,
+	"net.mx":                                 ICANN,
+	"org.mx":                                 ICANN,
+	"my":                                     ICANN,
+	"biz.my":                                 ICANN,
+	"com.my":                                 ICANN,
+	"edu.my":                                 ICANN,
+	"gov.my":                                 ICANN,
+	"mil.my":                                 ICANN,
+	"name.my":                                ICANN,
+	"net.my":                                 ICANN,
+	"org.my":                                 ICANN,
+	"mz":                                     ICANN,
+	"ac.mz":                                  ICANN,
+	"adv.mz":                                 ICANN,
+	"co.mz":                                  ICANN,
+	"edu.mz":                                 ICANN,
+	"gov.mz":                                 ICANN,
+	"mil.mz":                                 ICANN,
+	"net.mz":                                 ICANN,
+	"org.mz":                                 ICANN,
+	"na":                                     ICANN,
+	"alt.na":                                 ICANN,
+	"co.na":                                  ICANN,
+	"com.na":                                 ICANN,
+	"gov.na":                                 ICANN,
+	"net.na":                                 ICANN,
+	"org.na":                                 ICANN,
+	"name":                                   ICANN,
+	"nc":                                     ICANN,
+	"asso.nc":                                ICANN,
+	"nom.nc":                                 ICANN,
+	"ne":                                     ICANN,
+	"net":                                    ICANN,
+	"nf":                                     ICANN,
+	"arts.nf":                                ICANN,
+	"com.nf":                                 ICANN,
+	"firm.nf":                                ICANN,
+	"info.nf":                                ICANN,
+	"net.nf":                                 ICANN,
+	"other.nf":                               ICANN,
+	"per.nf":                                 ICANN,
+	"rec.nf":                                 ICANN,
+	"store.nf":                               ICANN,
+	"web.nf":                                 ICANN,
+	"ng":                                     ICANN,
+	"com.ng":                                 ICANN,
+	"edu.ng":                                 ICANN,
+	"gov.ng":                                 ICANN,
+	"i.ng":                                   ICANN,
+	"mil.ng":                                 ICANN,
+	"mobi.ng":                                ICANN,
+	"name.ng":                                ICANN,
+	"net.ng":                                 ICANN,
+	"org.ng":                                 ICANN,
+	"sch.ng":                                 ICANN,
+	"ni":                                     ICANN,
+	"ac.ni":                                  ICANN,
+	"biz.ni":                                 ICANN,
+	"co.ni":                                  ICANN,
+	"com.ni":                                 ICANN,
+	"edu.ni":                                 ICANN,
+	"gob.ni":                                 ICANN,
+	"in.ni":                                  ICANN,
+	"info.ni":                                ICANN,
+	"int.ni":                                 ICANN,
+	"mil.ni":                                 ICANN,
+	"net.ni":                                 ICANN,
+	"nom.ni":                                 ICANN,
+	"org.ni":                                 ICANN,
+	"web.ni":                                 ICANN,
+	"nl":                                     ICANN,
+	"no":                                     ICANN,
+	"fhs.no":                                 ICANN,
+	"folkebibl.no":                           ICANN,
+	"fylkesbibl.no":                          ICANN,
+	"idrett.no":                              ICANN,
+	"museum.no":                              ICANN,
+	"priv.no":                                ICANN,
+	"vgs.no":                                 ICANN,
+	"dep.no":                                 ICANN,
+	"herad.no":                               ICANN,
+	"kommune.no":                             ICANN,
+	"mil.no":                                 ICANN,
+	"stat.no":                                ICANN,
+	"aa.no":                                  ICANN,
+	"ah.no":                                  ICANN,
+	"bu.no":                                  ICANN,
+	"fm.no":                                  ICANN,
+	"hl.no":                                  ICANN,
+	"hm.no":                                  ICANN,
+	"jan-mayen.no":                           ICANN,
+	"mr.no":                                  ICANN,
+	"nl.no":                                  ICANN,
+	"nt.no":                                  ICANN,
+	"of.no":                                  ICANN,
+	"ol.no":                                  ICANN,
+	"oslo.no":                                ICANN,
+	"rl.no":                                  ICANN,
+	"sf.no":                                  ICANN,
+	"st.no":                                  ICANN,
+	"svalbard.no":                            ICANN,
+	"tm.no":                                  ICANN,
+	"tr.no":                                  ICANN,
+	"va.no":                                  ICANN,
+	"vf.no":                                  ICANN,
+	"gs.aa.no":                               ICANN,
+	"gs.ah.no":                               ICANN,
+	"gs.bu.no":                               ICANN,
+	"gs.fm.no":                               ICANN,
+	"gs.hl.no":                               ICANN,
+	"gs.hm.no":                               ICANN,
+	"gs.jan-mayen.no":                        ICANN,
+	"gs.mr.no":                               ICANN,
+	"gs.nl.no":                               ICANN,
+	"gs.nt.no":                               ICANN,
+	"gs.of.no":                               ICANN,
+	"gs.ol.no":                               ICANN,
+	"gs.oslo.no":                             ICANN,
+	"gs.rl.no":                               ICANN,
+	"gs.sf.no":                               ICANN,
+	"gs.st.no":                               ICANN,
+	"gs.svalbard.no":                         ICANN,
+	"gs.tm.no":                               ICANN,
+	"gs.tr.no":                               ICANN,
+	"gs.va.no":                               ICANN,
+	"gs.vf.no":                               ICANN,
+	"akrehamn.no":                            ICANN,
+	"xn--krehamn-dxa.no":                     ICANN,
+	"algard.no":                              ICANN,
+	"xn--lgrd-poac.no":                       ICANN,
+	"arna.no":                                ICANN,
+	"bronnoysund.no":                         ICANN,
+	"xn--brnnysund-m8ac.no":                  ICANN,
+	"brumunddal.no":                          ICANN,
+	"bryne.no":                               ICANN,
+	"drobak.no":                              ICANN,
+	"xn--drbak-wua.no":                       ICANN,
+	"egersund.no":                            ICANN,
+	"fetsund.no":                             ICANN,
+	"floro.no":                               ICANN,
+	"xn--flor-jra.no":                        ICANN,
+	"fredrikstad.no":                         ICANN,
+	"hokksund.no":                            ICANN,
+	"honefoss.no":                            ICANN,
+	"xn--hnefoss-q1a.no":                     ICANN,
+	"jessheim.no":                            ICANN,
+	"jorpeland.no":                           ICANN,
+	"xn--jrpeland-54a.no":                    ICANN,
+	"kirkenes.no":                            ICANN,
+	"kopervik.no":                            ICANN,
+	"krokstadelva.no":                        ICANN,
+	"langevag.no":                            ICANN,
+	"xn--langevg-jxa.no":                     ICANN,
+	"leirvik.no":                             ICANN,
+	"mjondalen.no":                           ICANN,
+	"xn--mjndalen-64a.no":                    ICANN,
+	"mo-i-rana.no":                           ICANN,
+	"mosjoen.no":                             ICANN,
+	"xn--mosjen-eya.no":                      ICANN,
+	"nesoddtangen.no":                        ICANN,
+	"orkanger.no":                            ICANN,
+	"osoyro.no":                              ICANN,
+	"xn--osyro-wua.no":                       ICANN,
+	"raholt.no":                              ICANN,
+	"xn--rholt-mra.no":                       ICANN,
+	"sandnessjoen.no":                        ICANN,
+	"xn--sandnessjen-ogb.no":                 ICANN,
+	"skedsmokorset.no":                       ICANN,
+	"slattum.no":                             ICANN,
+	"spjelkavik.no":                          ICANN,
+	"stathelle.no":                           ICANN,
+	"stavern.no":                             ICANN,
+	"stjordalshalsen.no":                     ICANN,
+	"xn--stjrdalshalsen-sqb.no":              ICANN,
+	"tananger.no":                            ICANN,
+	"tranby.no":                              ICANN,
+	"vossevangen.no":                         ICANN,
+	"aarborte.no":                            ICANN,
+	"aejrie.no":                              ICANN,
+	"afjord.no":                              ICANN,
+	"xn--fjord-lra.no":                       ICANN,
+	"agdenes.no":                             ICANN,
+	"nes.akershus.no":                        ICANN,
+	"aknoluokta.no":                          ICANN,
+	"xn--koluokta-7ya57h.no":                 ICANN,
+	"al.no":                                  ICANN,
+	"xn--l-1fa.no":                           ICANN,
+	"alaheadju.no":                           ICANN,
+	"xn--laheadju-7ya.no":                    ICANN,
+	"alesund.no":                             ICANN,
+	"xn--lesund-hua.no":                      ICANN,
+	"alstahaug.no":                           ICANN,
+	"alta.no":                                ICANN,
+	"xn--lt-liac.no":                         ICANN,
+	"alvdal.no":                              ICANN,
+	"amli.no":                                ICANN,
+	"xn--mli-tla.no":                         ICANN,
+	"amot.no":                                ICANN,
+	"xn--mot-tla.no":                         ICANN,
+	"andasuolo.no":                           ICANN,
+	"andebu.no":                              ICANN,
+	"andoy.no":                               ICANN,
+	"xn--andy-ira.no":                        ICANN,
+	"ardal.no":                               ICANN,
+	"xn--rdal-poa.no":                        ICANN,
+	"aremark.no":                             ICANN,
+	"arendal.no":                             ICANN,
+	"xn--s-1fa.no":                           ICANN,
+	"aseral.no":                              ICANN,
+	"xn--seral-lra.no":                       ICANN,
+	"asker.no":                               ICANN,
+	"askim.no":                               ICANN,
+	"askoy.no":                               ICANN,
+	"xn--asky-ira.no":                        ICANN,
+	"askvoll.no":                             ICANN,
+	"asnes.no":                               ICANN,
+	"xn--snes-poa.no":                        ICANN,
+	"audnedaln.no":                           ICANN,
+	"aukra.no":                               ICANN,
+	"aure.no":                                ICANN,
+	"aurland.no":                             ICANN,
+	"aurskog-holand.no":                      ICANN,
+	"xn--aurskog-hland-jnb.no":               ICANN,
+	"austevoll.no":                           ICANN,
+	"austrheim.no":                           ICANN,
+	"averoy.no":                              ICANN,
+	"xn--avery-yua.no":                       ICANN,
+	"badaddja.no":                            ICANN,
+	"xn--bdddj-mrabd.no":                     ICANN,
+	"xn--brum-voa.no":                        ICANN,
+	"bahcavuotna.no":                         ICANN,
+	"xn--bhcavuotna-s4a.no":                  ICANN,
+	"bahccavuotna.no":                        ICANN,
+	"xn--bhccavuotna-k7a.no":                 ICANN,
+	"baidar.no":                              ICANN,
+	"xn--bidr-5nac.no":                       ICANN,
+	"bajddar.no":                             ICANN,
+	"xn--bjddar-pta.no":                      ICANN,
+	"balat.no":                               ICANN,
+	"xn--blt-elab.no":                        ICANN,
+	"balestrand.no":                          ICANN,
+	"ballangen.no":                           ICANN,
+	"balsfjord.no":                           ICANN,
+	"bamble.no":                              ICANN,
+	"bardu.no":                               ICANN,
+	"barum.no":                               ICANN,
+	"batsfjord.no":                           ICANN,
+	"xn--btsfjord-9za.no":                    ICANN,
+	"bearalvahki.no":                         ICANN,
+	"xn--bearalvhki-y4a.no":                  ICANN,
+	"beardu.no":                              ICANN,
+	"beiarn.no":                              ICANN,
+	"berg.no":                                ICANN,
+	"bergen.no":                              ICANN,
+	"berlevag.no":                            ICANN,
+	"xn--berlevg-jxa.no":                     ICANN,
+	"bievat.no":                              ICANN,
+	"xn--bievt-0qa.no":                       ICANN,
+	"bindal.no":                              ICANN,
+	"birkenes.no":                            ICANN,
+	"bjerkreim.no":                           ICANN,
+	"bjugn.no":                               ICANN,
+	"bodo.no":                                ICANN,
+	"xn--bod-2na.no":                         ICANN,
+	"bokn.no":                                ICANN,
+	"bomlo.no":                               ICANN,
+	"xn--bmlo-gra.no":                        ICANN,
+	"bremanger.no":                           ICANN,
+	"bronnoy.no":                             ICANN,
+	"xn--brnny-wuac.no":                      ICANN,
+	"budejju.no":                             ICANN,
+	"nes.buskerud.no":                        ICANN,
+	"bygland.no":                             ICANN,
+	"bykle.no":                               ICANN,
+	"cahcesuolo.no":                          ICANN,
+	"xn--hcesuolo-7ya35b.no":                 ICANN,
+	"davvenjarga.no":                         ICANN,
+	"xn--davvenjrga-y4a.no":                  ICANN,
+	"davvesiida.no":                          ICANN,
+	"deatnu.no":                              ICANN,
+	"dielddanuorri.no":                       ICANN,
+	"divtasvuodna.no":                        ICANN,
+	"divttasvuotna.no":                       ICANN,
+	"donna.no":                               ICANN,
+	"xn--dnna-gra.no":                        ICANN,
+	"dovre.no":                               ICANN,
+	"drammen.no":                             ICANN,
+	"drangedal.no":                           ICANN,
+	"dyroy.no":                               ICANN,
+	"xn--dyry-ira.no":                        ICANN,
+	"eid.no":                                 ICANN,
+	"eidfjord.no":                            ICANN,
+	"eidsberg.no":                            ICANN,
+	"eidskog.no":                             ICANN,
+	"eidsvoll.no":                            ICANN,
+	"eigersund.no":                           ICANN,
+	"elverum.no":                             ICANN,
+	"enebakk.no":                             ICANN,
+	"engerdal.no":                            ICANN,
+	"etne.no":                                ICANN,
+	"etnedal.no":                             ICANN,
+	"evenassi.no":                            ICANN,
+	"xn--eveni-0qa01ga.no":                   ICANN,
+	"evenes.no":                              ICANN,
+	"evje-og-hornnes.no":                     ICANN,
+	"farsund.no":                             ICANN,
+	"fauske.no":                              ICANN,
+	"fedje.no":                               ICANN,
+	"fet.no":                                 ICANN,
+	"finnoy.no":                              ICANN,
+	"xn--finny-yua.no":                       ICANN,
+	"fitjar.no":                              ICANN,
+	"fjaler.no":                              ICANN,
+	"fjell.no":                               ICANN,
+	"fla.no":                                 ICANN,
+	"xn--fl-zia.no":                          ICANN,
+	"flakstad.no":                            ICANN,
+	"flatanger.no":                           ICANN,
+	"flekkefjord.no":                         ICANN,
+	"flesberg.no":                            ICANN,
+	"flora.no":                               ICANN,
+	"folldal.no":                             ICANN,
+	"forde.no":                               ICANN,
+	"xn--frde-gra.no":                        ICANN,
+	"forsand.no":                             ICANN,
+	"fosnes.no":                              ICANN,
+	"xn--frna-woa.no":                        ICANN,
+	"frana.no":                               ICANN,
+	"frei.no":                                ICANN,
+	"frogn.no":                               ICANN,
+	"froland.no":                             ICANN,
+	"frosta.no":                              ICANN,
+	"froya.no":                               ICANN,
+	"xn--frya-hra.no":                        ICANN,
+	"fuoisku.no":                             ICANN,
+	"fuossko.no":                             ICANN,
+	"fusa.no":                                ICANN,
+	"fyresdal.no":                            ICANN,
+	"gaivuotna.no":                           ICANN,
+	"xn--givuotna-8ya.no":                    ICANN,
+	"galsa.no":                               ICANN,
+	"xn--gls-elac.no":                        ICANN,
+	"gamvik.no":                              ICANN,
+	"gangaviika.no":                          ICANN,
+	"xn--ggaviika-8ya47h.no":                 ICANN,
+	"gaular.no":                              ICANN,
+	"gausdal.no":                             ICANN,
+	"giehtavuoatna.no":                       ICANN,
+	"gildeskal.no":                           ICANN,
+	"xn--gildeskl-g0a.no":                    ICANN,
+	"giske.no":                               ICANN,
+	"gjemnes.no":                             ICANN,
+	"gjerdrum.no":                            ICANN,
+	"gjerstad.no":                            ICANN,
+	"gjesdal.no":                             ICANN,
+	"gjovik.no":                              ICANN,
+	"xn--gjvik-wua.no":                       ICANN,
+	"gloppen.no":                             ICANN,
+	"gol.no":                                 ICANN,
+	"gran.no":                                ICANN,
+	"grane.no":                               ICANN,
+	"granvin.no":                             ICANN,
+	"gratangen.no":                           ICANN,
+	"grimstad.no":                            ICANN,
+	"grong.no":                               ICANN,
+	"grue.no":                                ICANN,
+	"gulen.no":                               ICANN,
+	"guovdageaidnu.no":                       ICANN,
+	"ha.no":                                  ICANN,
+	"xn--h-2fa.no":                           ICANN,
+	"habmer.no":                              ICANN,
+	"xn--hbmer-xqa.no":                       ICANN,
+	"hadsel.no":                              ICANN,
+	"xn--hgebostad-g3a.no":                   ICANN,
+	"hagebostad.no":                          ICANN,
+	"halden.no":                              ICANN,
+	"halsa.no":                               ICANN,
+	"hamar.no":                               ICANN,
+	"hamaroy.no":                             ICANN,
+	"hammarfeasta.no":                        ICANN,
+	"xn--hmmrfeasta-s4ac.no":                 ICANN,
+	"hammerfest.no":                          ICANN,
+	"hapmir.no":                              ICANN,
+	"xn--hpmir-xqa.no":                       ICANN,
+	"haram.no":                               ICANN,
+	"hareid.no":                              ICANN,
+	"harstad.no":                             ICANN,
+	"hasvik.no":                              ICANN,
+	"hattfjelldal.no":                        ICANN,
+	"haugesund.no":                           ICANN,
+	"os.hedmark.no":                          ICANN,
+	"valer.hedmark.no":                       ICANN,
+	"xn--vler-qoa.hedmark.no":                ICANN,
+	"hemne.no":                               ICANN,
+	"hemnes.no":                              ICANN,
+	"hemsedal.no":                            ICANN,
+	"hitra.no":                               ICANN,
+	"hjartdal.no":                            ICANN,
+	"hjelmeland.no":                          ICANN,
+	"hobol.no":                               ICANN,
+	"xn--hobl-ira.no":                        ICANN,
+	"hof.no":                                 ICANN,
+	"hol.no":                                 ICANN,
+	"hole.no":                                ICANN,
+	"holmestrand.no":                         ICANN,
+	"holtalen.no":                            ICANN,
+	"xn--holtlen-hxa.no":                     ICANN,
+	"os.hordaland.no":                        ICANN,
+	"hornindal.no":                           ICANN,
+	"horten.no":                              ICANN,
+	"hoyanger.no":                            ICANN,
+	"xn--hyanger-q1a.no":                     ICANN,
+	"hoylandet.no":                           ICANN,
+	"xn--hylandet-54a.no":                    ICANN,
+	"hurdal.no":                              ICANN,
+	"hurum.no":                               ICANN,
+	"hvaler.no":                              ICANN,
+	"hyllestad.no":                           ICANN,
+	"ibestad.no":                             ICANN,
+	"inderoy.no":                             ICANN,
+	"xn--indery-fya.no":                      ICANN,
+	"iveland.no":                             ICANN,
+	"ivgu.no":                                ICANN,
+	"jevnaker.no":                            ICANN,
+	"jolster.no":                             ICANN,
+	"xn--jlster-bya.no":                      ICANN,
+	"jondal.no":                              ICANN,
+	"kafjord.no":                             ICANN,
+	"xn--kfjord-iua.no":                      ICANN,
+	"karasjohka.no":                          ICANN,
+	"xn--krjohka-hwab49j.no":                 ICANN,
+	"karasjok.no":                            ICANN,
+	"karlsoy.no":                             ICANN,
+	"karmoy.no":                              ICANN,
+	"xn--karmy-yua.no":                       ICANN,
+	"kautokeino.no":                          ICANN,
+	"klabu.no":                               ICANN,
+	"xn--klbu-woa.no":                        ICANN,
+	"klepp.no":                               ICANN,
+	"kongsberg.no":                           ICANN,
+	"kongsvinger.no":                         ICANN,
+	"kraanghke.no":                           ICANN,
+	"xn--kranghke-b0a.no":                    ICANN,
+	"kragero.no":                             ICANN,
+	"xn--krager-gya.no":                      ICANN,
+	"kristiansand.no":                        ICANN,
+	"kristiansund.no":                        ICANN,
+	"krodsherad.no":                          ICANN,
+	"xn--krdsherad-m8a.no":                   ICANN,
+	"xn--kvfjord-nxa.no":                     ICANN,
+	"xn--kvnangen-k0a.no":                    ICANN,
+	"kvafjord.no":                            ICANN,
+	"kvalsund.no":                            ICANN,
+	"kvam.no":                                ICANN,
+	"kvanangen.no":                           ICANN,
+	"kvinesdal.no":                           ICANN,
+	"kvinnherad.no":                          ICANN,
+	"kviteseid.no":                           ICANN,
+	"kvitsoy.no":                             ICANN,
+	"xn--kvitsy-fya.no":                      ICANN,
+	"laakesvuemie.no":                        ICANN,
+	"xn--lrdal-sra.no":                       ICANN,
+	"lahppi.no":                              ICANN,
+	"xn--lhppi-xqa.no":                       ICANN,
+	"lardal.no":                              ICANN,
+	"larvik.no":                              ICANN,
+	"lavagis.no":                             ICANN,
+	"lavangen.no":                            ICANN,
+	"leangaviika.no":                         ICANN,
+	"xn--leagaviika-52b.no":                  ICANN,
+	"lebesby.no":                             ICANN,
+	"leikanger.no":                           ICANN,
+	"leirfjord.no":                           ICANN,
+	"leka.no":                                ICANN,
+	"leksvik.no":                             ICANN,
+	"lenvik.no":                              ICANN,
+	"lerdal.no":                              ICANN,
+	"lesja.no":                               ICANN,
+	"levanger.no":                            ICANN,
+	"lier.no":                                ICANN,
+	"lierne.no":                              ICANN,
+	"lillehammer.no":                         ICANN,
+	"lillesand.no":                           ICANN,
+	"lindas.no":                              ICANN,
+	"xn--linds-pra.no":                       ICANN,
+	"lindesnes.no":                           ICANN,
+	"loabat.no":                              ICANN,
+	"xn--loabt-0qa.no":                       ICANN,
+	"lodingen.no":                            ICANN,
+	"xn--ldingen-q1a.no":                     ICANN,
+	"lom.no":                                 ICANN,
+	"loppa.no":                               ICANN,
+	"lorenskog.no":                           ICANN,
+	"xn--lrenskog-54a.no":                    ICANN,
+	"loten.no":                               ICANN,
+	"xn--lten-gra.no":                        ICANN,
+	"lund.no":                                ICANN,
+	"lunner.no":                              ICANN,
+	"luroy.no":                               ICANN,
+	"xn--lury-ira.no":                        ICANN,
+	"luster.no":                              ICANN,
+	"lyngdal.no":                             ICANN,
+	"lyngen.no":                              ICANN,
+	"malatvuopmi.no":                         ICANN,
+	"xn--mlatvuopmi-s4a.no":                  ICANN,
+	"malselv.no":                             ICANN,
+	"xn--mlselv-iua.no":                      ICANN,
+	"malvik.no":                              ICANN,
+	"mandal.no":                              ICANN,
+	"marker.no":                              ICANN,
+	"marnardal.no":                           ICANN,
+	"masfjorden.no":                          ICANN,
+	"masoy.no":                               ICANN,
+	"xn--msy-ula0h.no":                       ICANN,
+	"matta-varjjat.no":                       ICANN,
+	"xn--mtta-vrjjat-k7af.no":                ICANN,
+	"meland.no":                              ICANN,
+	"meldal.no":                              ICANN,
+	"melhus.no":                              ICANN,
+	"meloy.no":                               ICANN,
+	"xn--mely-ira.no":                        ICANN,
+	"meraker.no":                             ICANN,
+	"xn--merker-kua.no":                      ICANN,
+	"midsund.no":                             ICANN,
+	"midtre-gauldal.no":                      ICANN,
+	"moareke.no":                             ICANN,
+	"xn--moreke-jua.no":                      ICANN,
+	"modalen.no":                             ICANN,
+	"modum.no":                               ICANN,
+	"molde.no":                               ICANN,
+	"heroy.more-og-romsdal.no":               ICANN,
+	"sande.more-og-romsdal.no":               ICANN,
+	"xn--hery-ira.xn--mre-og-romsdal-qqb.no": ICANN,
+	"sande.xn--mre-og-romsdal-qqb.no":        ICANN,
+	"moskenes.no":                            ICANN,
+	"moss.no":                                ICANN,
+	"muosat.no":                              ICANN,
+	"xn--muost-0qa.no":                       ICANN,
+	"naamesjevuemie.no":                      ICANN,
+	"xn--nmesjevuemie-tcba.no":               ICANN,
+	"xn--nry-yla5g.no":                       ICANN,
+	"namdalseid.no":                          ICANN,
+	"namsos.no":                              ICANN,
+	"namsskogan.no":                          ICANN,
+	"nannestad.no":                           ICANN,
+	"naroy.no":                               ICANN,
+	"narviika.no":                            ICANN,
+	"narvik.no":                              ICANN,
+	"naustdal.no":                            ICANN,
+	"navuotna.no":                            ICANN,
+	"xn--nvuotna-hwa.no":                     ICANN,
+	"nedre-eiker.no":                         ICANN,
+	"nesna.no":                               ICANN,
+	"nesodden.no":                            ICANN,
+	"nesseby.no":                             ICANN,
+	"nesset.no":                              ICANN,
+	"nissedal.no":                            ICANN,
+	"nittedal.no":                            ICANN,
+	"nord-aurdal.no":                         ICANN,
+	"nord-fron.no":                           ICANN,
+	"nord-odal.no":                           ICANN,
+	"norddal.no":                             ICANN,
+	"nordkapp.no":                            ICANN,
+	"bo.nordland.no":                         ICANN,
+	"xn--b-5ga.nordland.no":                  ICANN,
+	"heroy.nordland.no":                      ICANN,
+	"xn--hery-ira.nordland.no":               ICANN,
+	"nordre-land.no":                         ICANN,
+	"nordreisa.no":                           ICANN,
+	"nore-og-uvdal.no":                       ICANN,
+	"notodden.no":                            ICANN,
+	"notteroy.no":                            ICANN,
+	"xn--nttery-byae.no":                     ICANN,
+	"odda.no":                                ICANN,
+	"oksnes.no":                              ICANN,
+	"xn--ksnes-uua.no":                       ICANN,
+	"omasvuotna.no":                          ICANN,
+	"oppdal.no":                              ICANN,
+	"oppegard.no":                            ICANN,
+	"xn--oppegrd-ixa.no":                     ICANN,
+	"orkdal.no":                              ICANN,
+	"orland.no":                              ICANN,
+	"xn--rland-uua.no":                       ICANN,
+	"orskog.no":                              ICANN,
+	"xn--rskog-uua.no":                       ICANN,
+	"orsta.no":                               ICANN,
+	"xn--rsta-fra.no":                        ICANN,
+	"osen.no":                                ICANN,
+	"osteroy.no":                             ICANN,
+	"xn--ostery-fya.no":                      ICANN,
+	"valer.ostfold.no":                       ICANN,
+	"xn--vler-qoa.xn--stfold-9xa.no":         ICANN,
+	"ostre-toten.no":                         ICANN,
+	"xn--stre-toten-zcb.no":                  ICANN,
+	"overhalla.no":                           ICANN,
+	"ovre-eiker.no":                          ICANN,
+	"xn--vre-eiker-k8a.no":                   ICANN,
+	"oyer.no":                                ICANN,
+	"xn--yer-zna.no":                         ICANN,
+	"oygarden.no":                            ICANN,
+	"xn--ygarden-p1a.no":                     ICANN,
+	"oystre-slidre.no":                       ICANN,
+	"xn--ystre-slidre-ujb.no":                ICANN,
+	"porsanger.no":                           ICANN,
+	"porsangu.no":                            ICANN,
+	"xn--porsgu-sta26f.no":                   ICANN,
+	"porsgrunn.no":                           ICANN,
+	"rade.no":                                ICANN,
+	"xn--rde-ula.no":                         ICANN,
+	"radoy.no":                               ICANN,
+	"xn--rady-ira.no":                        ICANN,
+	"xn--rlingen-mxa.no":                     ICANN,
+	"rahkkeravju.no":                         ICANN,
+	"xn--rhkkervju-01af.no":                  ICANN,
+	"raisa.no":                               ICANN,
+	"xn--risa-5na.no":                        ICANN,
+	"rakkestad.no":                           ICANN,
+	"ralingen.no":                            ICANN,
+	"rana.no":                                ICANN,
+	"randaberg.no":                           ICANN,
+	"rauma.no":                               ICANN,
+	"rendalen.no":                            ICANN,
+	"rennebu.no":                             ICANN,
+	"rennesoy.no":                            ICANN,
+	"xn--rennesy-v1a.no":                     ICANN,
+	"rindal.no":                              ICANN,
+	"ringebu.no":                             ICANN,
+	"ringerike.no":                           ICANN,
+	"ringsaker.no":                           ICANN,
+	"risor.no":                               ICANN,
+	"xn--risr-ira.no":                        ICANN,
+	"rissa.no":                               ICANN,
+	"roan.no":                                ICANN,
+	"rodoy.no":                               ICANN,
+	"xn--rdy-0nab.no":                        ICANN,
+	"rollag.no":                              ICANN,
+	"romsa.no":                               ICANN,
+	"romskog.no":                             ICANN,
+	"xn--rmskog-bya.no":                      ICANN,
+	"roros.no":                               ICANN,
+	"xn--rros-gra.no":                        ICANN,
+	"rost.no":                                ICANN,
+	"xn--rst-0na.no":                         ICANN,
+	"royken.no":                              ICANN,
+	"xn--ryken-vua.no":                       ICANN,
+	"royrvik.no":                             ICANN,
+	"xn--ryrvik-bya.no":                      ICANN,
+	"ruovat.no":                              ICANN,
+	"rygge.no":                               ICANN,
+	"salangen.no":                            ICANN,
+	"salat.no":                               ICANN,
+	"xn--slat-5na.no":                        ICANN,
+	"xn--slt-elab.no":                        ICANN,
+	"saltdal.no":                             ICANN,
+	"samnanger.no":                           ICANN,
+	"sandefjord.no":                          ICANN,
+	"sandnes.no":                             ICANN,
+	"sandoy.no":                              ICANN,
+	"xn--sandy-yua.no":                       ICANN,
+	"sarpsborg.no":                           ICANN,
+	"sauda.no":                               ICANN,
+	"sauherad.no":                            ICANN,
+	"sel.no":                                 ICANN,
+	"selbu.no":                               ICANN,
+	"selje.no":                               ICANN,
+	"seljord.no":                             ICANN,
+	"siellak.no":                             ICANN,
+	"sigdal.no":                              ICANN,
+	"siljan.no":                              ICANN,
+	"sirdal.no":                              ICANN,
+	"skanit.no":                              ICANN,
+	"xn--sknit-yqa.no":                       ICANN,
+	"skanland.no":                            ICANN,
+	"xn--sknland-fxa.no":                     ICANN,
+	"skaun.no":                               ICANN,
+	"skedsmo.no":                             ICANN,
+	"ski.no":                                 ICANN,
+	"skien.no":                               ICANN,
+	"skierva.no":                             ICANN,
+	"xn--skierv-uta.no":                      ICANN,
+	"skiptvet.no":                            ICANN,
+	"skjak.no":                               ICANN,
+	"xn--skjk-soa.no":                        ICANN,
+	"skjervoy.no":                            ICANN,
+	"xn--skjervy-v1a.no":                     ICANN,
+	"skodje.no":                              ICANN,
+	"smola.no":                               ICANN,
+	"xn--smla-hra.no":                        ICANN,
+	"snaase.no":                              ICANN,
+	"xn--snase-nra.no":                       ICANN,
+	"snasa.no":                               ICANN,
+	"xn--snsa-roa.no":                        ICANN,
+	"snillfjord.no":                          ICANN,
+	"snoasa.no":                              ICANN,
+	"sogndal.no":                             ICANN,
+	"sogne.no":                               ICANN,
+	"xn--sgne-gra.no":                        ICANN,
+	"sokndal.no":                             ICANN,
+	"sola.no":                                ICANN,
+	"solund.no":                              ICANN,
+	"somna.no":                               ICANN,
+	"xn--smna-gra.no":                        ICANN,
+	"sondre-land.no":                         ICANN,
+	"xn--sndre-land-0cb.no":                  ICANN,
+	"songdalen.no":                           ICANN,
+	"sor-aurdal.no":                          ICANN,
+	"xn--sr-aurdal-l8a.no":                   ICANN,
+	"sor-fron.no":                            ICANN,
+	"xn--sr-fron-q1a.no":                     ICANN,
+	"sor-odal.no":                            ICANN,
+	"xn--sr-odal-q1a.no":                     ICANN,
+	"sor-varanger.no":                        ICANN,
+	"xn--sr-varanger-ggb.no":                 ICANN,
+	"sorfold.no":                             ICANN,
+	"xn--srfold-bya.no":                      ICANN,
+	"sorreisa.no":                            ICANN,
+	"xn--srreisa-q1a.no":                     ICANN,
+	"sortland.no":                            ICANN,
+	"sorum.no":                               ICANN,
+	"xn--srum-gra.no":                        ICANN,
+	"spydeberg.no":                           ICANN,
+	"stange.no":                              ICANN,
+	"stavanger.no":                           ICANN,
+	"steigen.no":                             ICANN,
+	"steinkjer.no":                           ICANN,
+	"stjordal.no":                            ICANN,
+	"xn--stjrdal-s1a.no":                     ICANN,
+	"stokke.no":                              ICANN,
+	"stor-elvdal.no":                         ICANN,
+	"stord.no":                               ICANN,
+	"stordal.no":                             ICANN,
+	"storfjord.no":                           ICANN,
+	"strand.no":                              ICANN,
+	"stranda.no":                             ICANN,
+	"stryn.no":                               ICANN,
+	"sula.no":                                ICANN,
+	"suldal.no":                              ICANN,
+	"sund.no":                                ICANN,
+	"sunndal.no":                             ICANN,
+	"surnadal.no":                            ICANN,
+	"sveio.no":                               ICANN,
+	"svelvik.no":                             ICANN,
+	"sykkylven.no":                           ICANN,
+	"tana.no":                                ICANN,
+	"bo.telemark.no":                         ICANN,
+	"xn--b-5ga.telemark.no":                  ICANN,
+	"time.no":                                ICANN,
+	"tingvoll.no":                            ICANN,
+	"tinn.no":                                ICANN,
+	"tjeldsund.no":                           ICANN,
+	"tjome.no":                               ICANN,
+	"xn--tjme-hra.no":                        ICANN,
+	"tokke.no":                               ICANN,
+	"tolga.no":                               ICANN,
+	"tonsberg.no":                            ICANN,
+	"xn--tnsberg-q1a.no":                     ICANN,
+	"torsken.no":                             ICANN,
+	"xn--trna-woa.no":                        ICANN,
+	"trana.no":                               ICANN,
+	"tranoy.no":                              ICANN,
+	"xn--trany-yua.no":                       ICANN,
+	"troandin.no":                            ICANN,
+	"trogstad.no":                            ICANN,
+	"xn--trgstad-r1a.no":                     ICANN,
+	"tromsa.no":                              ICANN,
+	"tromso.no":                              ICANN,
+	"xn--troms-zua.no":                       ICANN,
+	"trondheim.no":                           ICANN,
+	"trysil.no":                              ICANN,
+	"tvedestrand.no":                         ICANN,
+	"tydal.no":                               ICANN,
+	"tynset.no":                              ICANN,
+	"tysfjord.no":                            ICANN,
+	"tysnes.no":                              ICANN,
+	"xn--tysvr-vra.no":                       ICANN,
+	"tysvar.no":                              ICANN,
+	"ullensaker.no":                          ICANN,
+	"ullensvang.no":                          ICANN,
+	"ulvik.no":                               ICANN,
+	"unjarga.no":                             ICANN,
+	"xn--unjrga-rta.no":                      ICANN,
+	"utsira.no":                              ICANN,
+	"vaapste.no":                             ICANN,
+	"vadso.no":                               ICANN,
+	"xn--vads-jra.no":                        ICANN,
+	"xn--vry-yla5g.no":                       ICANN,
+	"vaga.no":                                ICANN,
+	"xn--vg-yiab.no":                         ICANN,
+	"vagan.no":                               ICANN,
+	"xn--vgan-qoa.no":                        ICANN,
+	"vagsoy.no":                              ICANN,
+	"xn--vgsy-qoa0j.no":                      ICANN,
+	"vaksdal.no":                             ICANN,
+	"valle.no":                               ICANN,
+	"vang.no":                                ICANN,
+	"vanylven.no":                            ICANN,
+	"vardo.no":                               ICANN,
+	"xn--vard-jra.no":                        ICANN,
+	"varggat.no":                             ICANN,
+	"xn--vrggt-xqad.no":                      ICANN,
+	"varoy.no":                               ICANN,
+	"vefsn.no":                               ICANN,
+	"vega.no":                                ICANN,
+	"vegarshei.no":                           ICANN,
+	"xn--vegrshei-c0a.no":                    ICANN,
+	"vennesla.no":                            ICANN,
+	"verdal.no":                              ICANN,
+	"verran.no":                              ICANN,
+	"vestby.no":                              ICANN,
+	"sande.vestfold.no":                      ICANN,
+	"vestnes.no":                             ICANN,
+	"vestre-slidre.no":                       ICANN,
+	"vestre-toten.no":                        ICANN,
+	"vestvagoy.no":                           ICANN,
+	"xn--vestvgy-ixa6o.no":                   ICANN,
+	"vevelstad.no":                           ICANN,
+	"vik.no":                                 ICANN,
+	"vikna.no":                               ICANN,
+	"vindafjord.no":                          ICANN,
+	"voagat.no":                              ICANN,
+	"volda.no":                               ICANN,
+	"voss.no":                                ICANN,
+	"*.np":                                   ICANN,
+	"nr":                                     ICANN,
+	"biz.nr":                                 ICANN,
+	"com.nr":                                 ICANN,
+	"edu.nr":                                 ICANN,
+	"gov.nr":                                 ICANN,
+	"info.nr":                                ICANN,
+	"net.nr":                                 ICANN,
+	"org.nr":                                 ICANN,
+	"nu":                                     ICANN,
+	"nz":                                     ICANN,
+	"ac.nz":                                  ICANN,
+	"co.nz":                                  ICANN,
+	"cri.nz":                                 ICANN,
+	"geek.nz":                                ICANN,
+	"gen.nz":                                 ICANN,
+	"govt.nz":                                ICANN,
+	"health.nz":                              ICANN,
+	"iwi.nz":                                 ICANN,
+	"kiwi.nz":                                ICANN,
+	"maori.nz":                               ICANN,
+	"xn--mori-qsa.nz":                        ICANN,
+	"mil.nz":                                 ICANN,
+	"net.nz":                                 ICANN,
+	"org.nz":                                 ICANN,
+	"parliament.nz":                          ICANN,
+	"school.nz":                              ICANN,
+	"om":                                     ICANN,
+	"co.om":                                  ICANN,
+	"com.om":                                 ICANN,
+	"edu.om":                                 ICANN,
+	"gov.om":                                 ICANN,
+	"med.om":                                 ICANN,
+	"museum.om":                              ICANN,
+	"net.om":                                 ICANN,
+	"org.om":                                 ICANN,
+	"pro.om":                                 ICANN,
+	"onion":                                  ICANN,
+	"org":                                    ICANN,
+	"pa":                                     ICANN,
+	"abo.pa":                                 ICANN,
+	"ac.pa":                                  ICANN,
+	"com.pa":                                 ICANN,
+	"edu.pa":                                 ICANN,
+	"gob.pa":                                 ICANN,
+	"ing.pa":                                 ICANN,
+	"med.pa":                                 ICANN,
+	"net.pa":                                 ICANN,
+	"nom.pa":                                 ICANN,
+	"org.pa":                                 ICANN,
+	"sld.pa":                                 ICANN,
+	"pe":                                     ICANN,
+	"com.pe":                                 ICANN,
+	"edu.pe":                                 ICANN,
+	"gob.pe":                                 ICANN,
+	"mil.pe":                                 ICANN,
+	"net.pe":                                 ICANN,
+	"nom.pe":                                 ICANN,
+	"org.pe":                                 ICANN,
+	"pf":                                     ICANN,
+	"com.pf":                                 ICANN,
+	"edu.pf":                                 ICANN,
+	"org.pf":                                 ICANN,
+	"*.pg":                                   ICANN,
+	"ph":                                     ICANN,
+	"com.ph":                                 ICANN,
+	"edu.ph":                                 ICANN,
+	"gov.ph":                                 ICANN,
+	"i.ph":                                   ICANN,
+	"mil.ph":                                 ICANN,
+	"net.ph":                                 ICANN,
+	"ngo.ph":                                 ICANN,
+	"org.ph":                                 ICANN,
+	"pk":                                     ICANN,
+	"ac.pk":                                  ICANN,
+	"biz.pk":                                 ICANN,
+	"com.pk":                                 ICANN,
+	"edu.pk":                                 ICANN,
+	"fam.pk":                                 ICANN,
+	"gkp.pk":                                 ICANN,
+	"gob.pk":                                 ICANN,
+	"gog.pk":                                 ICANN,
+	"gok.pk":                                 ICANN,
+	"gop.pk":                                 ICANN,
+	"gos.pk":                                 ICANN,
+	"gov.pk":                                 ICANN,
+	"net.pk":                                 ICANN,
+	"org.pk":                                 ICANN,
+	"web.pk":                                 ICANN,
+	"pl":                                     ICANN,
+	"com.pl":                                 ICANN,
+	"net.pl":                                 ICANN,
+	"org.pl":                                 ICANN,
+	"agro.pl":                                ICANN,
+	"aid.pl":                                 ICANN,
+	"atm.pl":                                 ICANN,
+	"auto.pl":                                ICANN,
+	"biz.pl":                                 ICANN,
+	"edu.pl":                                 ICANN,
+	"gmina.pl":                               ICANN,
+	"gsm.pl":                                 ICANN,
+	"info.pl":                                ICANN,
+	"mail.pl":                                ICANN,
+	"media.pl":                               ICANN,
+	"miasta.pl":                              ICANN,
+	"mil.pl":                                 ICANN,
+	"nieruchomosci.pl":                       ICANN,
+	"nom.pl":                                 ICANN,
+	"pc.pl":                                  ICANN,
+	"powiat.pl":                              ICANN,
+	"priv.pl":                                ICANN,
+	"realestate.pl":                          ICANN,
+	"rel.pl":                                 ICANN,
+	"sex.pl":                                 ICANN,
+	"shop.pl":                                ICANN,
+	"sklep.pl":                               ICANN,
+	"sos.pl":                                 ICANN,
+	"szkola.pl":                              ICANN,
+	"targi.pl":                               ICANN,
+	"tm.pl":                                  ICANN,
+	"tourism.pl":                             ICANN,
+	"travel.pl":                              ICANN,
+	"turystyka.pl":                           ICANN,
+	"gov.pl":                                 ICANN,
+	"ap.gov.pl":                              ICANN,
+	"griw.gov.pl":                            ICANN,
+	"ic.gov.pl":                              ICANN,
+	"is.gov.pl":                              ICANN,
+	"kmpsp.gov.pl":                           ICANN,
+	"konsulat.gov.pl":                        ICANN,
+	"kppsp.gov.pl":                           ICANN,
+	"kwp.gov.pl":                             ICANN,
+	"kwpsp.gov.pl":                           ICANN,
+	"mup.gov.pl":                             ICANN,
+	"mw.gov.pl":                              ICANN,
+	"oia.gov.pl":                             ICANN,
+	"oirm.gov.pl":                            ICANN,
+	"oke.gov.pl":                             ICANN,
+	"oow.gov.pl":                             ICANN,
+	"oschr.gov.pl":                           ICANN,
+	"oum.gov.pl":                             ICANN,
+	"pa.gov.pl":                              ICANN,
+	"pinb.gov.pl":                            ICANN,
+	"piw.gov.pl":                             ICANN,
+	"po.gov.pl":                              ICANN,
+	"pr.gov.pl":                              ICANN,
+	"psp.gov.pl":                             ICANN,
+	"psse.gov.pl":                            ICANN,
+	"pup.gov.pl":                             ICANN,
+	"rzgw.gov.pl":                            ICANN,
+	"sa.gov.pl":                              ICANN,
+	"sdn.gov.pl":                             ICANN,
+	"sko.gov.pl":                             ICANN,
+	"so.gov.pl":                              ICANN,
+	"sr.gov.pl":                              ICANN,
+	"starostwo.gov.pl":                       ICANN,
+	"ug.gov.pl":                              ICANN,
+	"ugim.gov.pl":                            ICANN,
+	"um.gov.pl":                              ICANN,
+	"umig.gov.pl":                            ICANN,
+	"upow.gov.pl":                            ICANN,
+	"uppo.gov.pl":                            ICANN,
+	"us.gov.pl":                              ICANN,
+	"uw.gov.pl":                              ICANN,
+	"uzs.gov.pl":                             ICANN,
+	"wif.gov.pl":                             ICANN,
+	"wiih.gov.pl":                            ICANN,
+	"winb.gov.pl":                            ICANN,
+	"wios.gov.pl":                            ICANN,
+	"witd.gov.pl":                            ICANN,
+	"wiw.gov.pl":                             ICANN,
+	"wkz.gov.pl":                             ICANN,
+	"wsa.gov.pl":                             ICANN,
+	"wskr.gov.pl":                            ICANN,
+	"wsse.gov.pl":                            ICANN,
+	"wuoz.gov.pl":                            ICANN,
+	"wzmiuw.gov.pl":                          ICANN,
+	"zp.gov.pl":                              ICANN,
+	"zpisdn.gov.pl":                          ICANN,
+	"augustow.pl":                            ICANN,
+	"babia-gora.pl":                          ICANN,
+	"bedzin.pl":                              ICANN,
+	"beskidy.pl":                             ICANN,
+	"bialowieza.pl":                          ICANN,
+	"bialystok.pl":                           ICANN,
+	"bielawa.pl":                             ICANN,
+	"bieszczady.pl":                          ICANN,
+	"boleslawiec.pl":                         ICANN,
+	"bydgoszcz.pl":                           ICANN,
+	"bytom.pl":                               ICANN,
+	"cieszyn.pl":                             ICANN,
+	"czeladz.pl":                             ICANN,
+	"czest.pl":                               ICANN,
+	"dlugoleka.pl":                           ICANN,
+	"elblag.pl":                              ICANN,
+	"elk.pl":                                 ICANN,
+	"glogow.pl":                              ICANN,
+	"gniezno.pl":                             ICANN,
+	"gorlice.pl":                             ICANN,
+	"grajewo.pl":                             ICANN,
+	"ilawa.pl":                               ICANN,
+	"jaworzno.pl":                            ICANN,
+	"jelenia-gora.pl":                        ICANN,
+	"jgora.pl":                               ICANN,
+	"kalisz.pl":                              ICANN,
+	"karpacz.pl":                             ICANN,
+	"kartuzy.pl":                             ICANN,
+	"kaszuby.pl":                             ICANN,
+	"katowice.pl":                            ICANN,
+	"kazimierz-dolny.pl":                     ICANN,
+	"kepno.pl":                               ICANN,
+	"ketrzyn.pl":                             ICANN,
+	"klodzko.pl":                             ICANN,
+	"kobierzyce.pl":                          ICANN,
+	"kolobrzeg.pl":                           ICANN,
+	"konin.pl":                               ICANN,
+	"konskowola.pl":                          ICANN,
+	"kutno.pl":                               ICANN,
+	"lapy.pl":                                ICANN,
+	"lebork.pl":                              ICANN,
+	"legnica.pl":                             ICANN,
+	"lezajsk.pl":                             ICANN,
+	"limanowa.pl":                            ICANN,
+	"lomza.pl":                               ICANN,
+	"lowicz.pl":                              ICANN,
+	"lubin.pl":                               ICANN,
+	"lukow.pl":                               ICANN,
+	"malbork.pl":                             ICANN,
+	"malopolska.pl":                          ICANN,
+	"mazowsze.pl":                            ICANN,
+	"mazury.pl":                              ICANN,
+	"mielec.pl":                              ICANN,
+	"mielno.pl":                              ICANN,
+	"mragowo.pl":                             ICANN,
+	"naklo.pl":                               ICANN,
+	"nowaruda.pl":                            ICANN,
+	"nysa.pl":                                ICANN,
+	"olawa.pl":                               ICANN,
+	"olecko.pl":                              ICANN,
+	"olkusz.pl":                              ICANN,
+	"olsztyn.pl":                             ICANN,
+	"opoczno.pl":                             ICANN,
+	"opole.pl":                               ICANN,
+	"ostroda.pl":                             ICANN,
+	"ostroleka.pl":                           ICANN,
+	"ostrowiec.pl":                           ICANN,
+	"ostrowwlkp.pl":                          ICANN,
+	"pila.pl":                                ICANN,
+	"pisz.pl":                                ICANN,
+	"podhale.pl":                             ICANN,
+	"podlasie.pl":                            ICANN,
+	"polkowice.pl":                           ICANN,
+	"pomorskie.pl":                           ICANN,
+	"pomorze.pl":                             ICANN,
+	"prochowice.pl":                          ICANN,
+	"pruszkow.pl":                            ICANN,
+	"przeworsk.pl":                           ICANN,
+	"pulawy.pl":                              ICANN,
+	"radom.pl":                               ICANN,
+	"rawa-maz.pl":                            ICANN,
+	"rybnik.pl":                              ICANN,
+	"rzeszow.pl":                             ICANN,
+	"sanok.pl":                               ICANN,
+	"sejny.pl":                               ICANN,
+	"skoczow.pl":                             ICANN,
+	"slask.pl":                               ICANN,
+	"slupsk.pl":                              ICANN,
+	"sosnowiec.pl":                           ICANN,
+	"stalowa-wola.pl":                        ICANN,
+	"starachowice.pl":                        ICANN,
+	"stargard.pl":                            ICANN,
+	"suwalki.pl":                             ICANN,
+	"swidnica.pl":                            ICANN,
+	"swiebodzin.pl":                          ICANN,
+	"swinoujscie.pl":                         ICANN,
+	"szczecin.pl":                            ICANN,
+	"szczytno.pl":                            ICANN,
+	"tarnobrzeg.pl":                          ICANN,
+	"tgory.pl":                               ICANN,
+	"turek.pl":                               ICANN,
+	"tychy.pl":                               ICANN,
+	"ustka.pl":                               ICANN,
+	"walbrzych.pl":                           ICANN,
+	"warmia.pl":                              ICANN,
+	"warszawa.pl":                            ICANN,
+	"waw.pl":                                 ICANN,
+	"wegrow.pl":                              ICANN,
+	"wielun.pl":                              ICANN,
+	"wlocl.pl":                               ICANN,
+	"wloclawek.pl":                           ICANN,
+	"wodzislaw.pl":                           ICANN,
+	"wolomin.pl":                             ICANN,
+	"wroclaw.pl":                             ICANN,
+	"zachpomor.pl":                           ICANN,
+	"zagan.pl":                               ICANN,
+	"zarow.pl":                               ICANN,
+	"zgora.pl":                               ICANN,
+	"zgorzelec.pl":                           ICANN,
+	"pm":                                     ICANN,
+	"pn":                                     ICANN,
+	"co.pn":                                  ICANN,
+	"edu.pn":                                 ICANN,
+	"gov.pn":                                 ICANN,
+	"net.pn":                                 ICANN,
+	"org.pn":                                 ICANN,
+	"post":                                   ICANN,
+	"pr":                                     ICANN,
+	"biz.pr":                                 ICANN,
+	"com.pr":                                 ICANN,
+	"edu.pr":                                 ICANN,
+	"gov.pr":                                 ICANN,
+	"info.pr":                                ICANN,
+	"isla.pr":                                ICANN,
+	"name.pr":                                ICANN,
+	"net.pr":                                 ICANN,
+	"org.pr":                                 ICANN,
+	"pro.pr":                                 ICANN,
+	"ac.pr":                                  ICANN,
+	"est.pr":                                 ICANN,
+	"prof.pr":                                ICANN,
+	"pro":                                    ICANN,
+	"aaa.pro":                                ICANN,
+	"aca.pro":                                ICANN,
+	"acct.pro":                               ICANN,
+	"avocat.pro":                             ICANN,
+	"bar.pro":                                ICANN,
+	"cpa.pro":                                ICANN,
+	"eng.pro":                                ICANN,
+	"jur.pro":                                ICANN,
+	"law.pro":                                ICANN,
+	"med.pro":                                ICANN,
+	"recht.pro":                              ICANN,
+	"ps":                                     ICANN,
+	"com.ps":                                 ICANN,
+	"edu.ps":                                 ICANN,
+	"gov.ps":                                 ICANN,
+	"net.ps":                                 ICANN,
+	"org.ps":                                 ICANN,
+	"plo.ps":                                 ICANN,
+	"sec.ps":                                 ICANN,
+	"pt":                                     ICANN,
+	"com.pt":                                 ICANN,
+	"edu.pt":                                 ICANN,
+	"gov.pt":                                 ICANN,
+	"int.pt":                                 ICANN,
+	"net.pt":                                 ICANN,
+	"nome.pt":                                ICANN,
+	"org.pt":                                 ICANN,
+	"publ.pt":                                ICANN,
+	"pw":                                     ICANN,
+	"gov.pw":                                 ICANN,
+	"py":                                     ICANN,
+	"com.py":                                 ICANN,
+	"coop.py":                                ICANN,
+	"edu.py":                                 ICANN,
+	"gov.py":                                 ICANN,
+	"mil.py":                                 ICANN,
+	"net.py":                                 ICANN,
+	"org.py":                                 ICANN,
+	"qa":                                     ICANN,
+	"com.qa":                                 ICANN,
+	"edu.qa":                                 ICANN,
+	"gov.qa":                                 ICANN,
+	"mil.qa":                                 ICANN,
+	"name.qa":                                ICANN,
+	"net.qa":                                 ICANN,
+	"org.qa":                                 ICANN,
+	"sch.qa":                                 ICANN,
+	"re":                                     ICANN,
+	"asso.re":                                ICANN,
+	"com.re":                                 ICANN,
+	"ro":                                     ICANN,
+	"arts.ro":                                ICANN,
+	"com.ro":                                 ICANN,
+	"firm.ro":                                ICANN,
+	"info.ro":                                ICANN,
+	"nom.ro":                                 ICANN,
+	"nt.ro":                                  ICANN,
+	"org.ro":                                 ICANN,
+	"rec.ro":                                 ICANN,
+	"store.ro":                               ICANN,
+	"tm.ro":                                  ICANN,
+	"www.ro":                                 ICANN,
+	"rs":                                     ICANN,
+	"ac.rs":                                  ICANN,
+	"co.rs":                                  ICANN,
+	"edu.rs":                                 ICANN,
+	"gov.rs":                                 ICANN,
+	"in.rs":                                  ICANN,
+	"org.rs":                                 ICANN,
+	"ru":                                     ICANN,
+	"rw":                                     ICANN,
+	"ac.rw":                                  ICANN,
+	"co.rw":                                  ICANN,
+	"coop.rw":                                ICANN,
+	"gov.rw":                                 ICANN,
+	"mil.rw":                                 ICANN,
+	"net.rw":                                 ICANN,
+	"org.rw":                                 ICANN,
+	"sa":                                     ICANN,
+	"com.sa":                                 ICANN,
+	"edu.sa":                                 ICANN,
+	"gov.sa":                                 ICANN,
+	"med.sa":                                 ICANN,
+	"net.sa":                                 ICANN,
+	"org.sa":                                 ICANN,
+	"pub.sa":                                 ICANN,
+	"sch.sa":                                 ICANN,
+	"sb":                                     ICANN,
+	"com.sb":                                 ICANN,
+	"edu.sb":                                 ICANN,
+	"gov.sb":                                 ICANN,
+	"net.sb":                                 ICANN,
+	"org.sb":                                 ICANN,
+	"sc":                                     ICANN,
+	"com.sc":                                 ICANN,
+	"edu.sc":                                 ICANN,
+	"gov.sc":                                 ICANN,
+	"net.sc":                                 ICANN,
+	"org.sc":                                 ICANN,
+	"sd":                                     ICANN,
+	"com.sd":                                 ICANN,
+	"edu.sd":                                 ICANN,
+	"gov.sd":                                 ICANN,
+	"info.sd":                                ICANN,
+	"med.sd":                                 ICANN,
+	"net.sd":                                 ICANN,
+	"org.sd":                                 ICANN,
+	"tv.sd":                                  ICANN,
+	"se":                                     ICANN,
+	"a.se":                                   ICANN,
+	"ac.se":                                  ICANN,
+	"b.se":                                   ICANN,
+	"bd.se":                                  ICANN,
+	"brand.se":                               ICANN,
+	"c.se":                                   ICANN,
+	"d.se":                                   ICANN,
+	"e.se":                                   ICANN,
+	"f.se":                                   ICANN,
+	"fh.se":                                  ICANN,
+	"fhsk.se":                                ICANN,
+	"fhv.se":                                 ICANN,
+	"g.se":                                   ICANN,
+	"h.se":                                   ICANN,
+	"i.se":                                   ICANN,
+	"k.se":                                   ICANN,
+	"komforb.se":                             ICANN,
+	"kommunalforbund.se":                     ICANN,
+	"komvux.se":                              ICANN,
+	"l.se":                                   ICANN,
+	"lanbib.se":                              ICANN,
+	"m.se":                                   ICANN,
+	"n.se":                                   ICANN,
+	"naturbruksgymn.se":                      ICANN,
+	"o.se":                                   ICANN,
+	"org.se":                                 ICANN,
+	"p.se":                                   ICANN,
+	"parti.se":                               ICANN,
+	"pp.se":                                  ICANN,
+	"press.se":                               ICANN,
+	"r.se":                                   ICANN,
+	"s.se":                                   ICANN,
+	"t.se":                                   ICANN,
+	"tm.se":                                  ICANN,
+	"u.se":                                   ICANN,
+	"w.se":                                   ICANN,
+	"x.se":                                   ICANN,
+	"y.se":                                   ICANN,
+	"z.se":                                   ICANN,
+	"sg":                                     ICANN,
+	"com.sg":                                 ICANN,
+	"edu.sg":                                 ICANN,
+	"gov.sg":                                 ICANN,
+	"net.sg":                                 ICANN,
+	"org.sg":                                 ICANN,
+	"sh":                                     ICANN,
+	"com.sh":                                 ICANN,
+	"gov.sh":                                 ICANN,
+	"mil.sh":                                 ICANN,
+	"net.sh":                                 ICANN,
+	"org.sh":                                 ICANN,
+	"si":                                     ICANN,
+	"sj":                                     ICANN,
+	"sk":                                     ICANN,
+	"org.sk":                                 ICANN,
+	"sl":                                     ICANN,
+	"com.sl":                                 ICANN,
+	"edu.sl":                                 ICANN,
+	"gov.sl":                                 ICANN,
+	"net.sl":                                 ICANN,
+	"org.sl":                                 ICANN,
+	"sm":                                     ICANN,
+	"sn":                                     ICANN,
+	"art.sn":                                 ICANN,
+	"com.sn":                                 ICANN,
+	"edu.sn":                                 ICANN,
+	"gouv.sn":                                ICANN,
+	"org.sn":                                 ICANN,
+	"univ.sn":                                ICANN,
+	"so":                                     ICANN,
+	"com.so":                                 ICANN,
+	"edu.so":                                 ICANN,
+	"gov.so":                                 ICANN,
+	"me.so":                                  ICANN,
+	"net.so":                                 ICANN,
+	"org.so":                                 ICANN,
+	"sr":                                     ICANN,
+	"ss":                                     ICANN,
+	"biz.ss":                                 ICANN,
+	"co.ss":                                  ICANN,
+	"com.ss":                                 ICANN,
+	"edu.ss":                                 ICANN,
+	"gov.ss":                                 ICANN,
+	"me.ss":                                  ICANN,
+	"net.ss":                                 ICANN,
+	"org.ss":                                 ICANN,
+	"sch.ss":                                 ICANN,
+	"st":                                     ICANN,
+	"co.st":                                  ICANN,
+	"com.st":                                 ICANN,
+	"consulado.st":                           ICANN,
+	"edu.st":                                 ICANN,
+	"embaixada.st":                           ICANN,
+	"mil.st":                                 ICANN,
+	"net.st":                                 ICANN,
+	"org.st":                                 ICANN,
+	"principe.st":                            ICANN,
+	"saotome.st":                             ICANN,
+	"store.st":                               ICANN,
+	"su":                                     ICANN,
+	"sv":                                     ICANN,
+	"com.sv":                                 ICANN,
+	"edu.sv":                                 ICANN,
+	"gob.sv":                                 ICANN,
+	"org.sv":                                 ICANN,
+	"red.sv":                                 ICANN,
+	"sx":                                     ICANN,
+	"gov.sx":                                 ICANN,
+	"sy":                                     ICANN,
+	"com.sy":                                 ICANN,
+	"edu.sy":                                 ICANN,
+	"gov.sy":                                 ICANN,
+	"mil.sy":                                 ICANN,
+	"net.sy":                                 ICANN,
+	"org.sy":                                 ICANN,
+	"sz":                                     ICANN,
+	"ac.sz":                                  ICANN,
+	"co.sz":                                  ICANN,
+	"org.sz":                                 ICANN,
+	"tc":                                     ICANN,
+	"td":                                     ICANN,
+	"tel":                                    ICANN,
+	"tf":                                     ICANN,
+	"tg":                                     ICANN,
+	"th":                                     ICANN,
+	"ac.th":                                  ICANN,
+	"co.th":                                  ICANN,
+	"go.th":                                  ICANN,
+	"in.th":                                  ICANN,
+	"mi.th":                                  ICANN,
+	"net.th":                                 ICANN,
+	"or.th":                                  ICANN,
+	"tj":                                     ICANN,
+	"ac.tj":                                  ICANN,
+	"biz.tj":                                 ICANN,
+	"co.tj":                                  ICANN,
+	"com.tj":                                 ICANN,
+	"edu.tj":                                 ICANN,
+	"go.tj":                                  ICANN,
+	"gov.tj":                                 ICANN,
+	"int.tj":                                 ICANN,
+	"mil.tj":                                 ICANN,
+	"name.tj":                                ICANN,
+	"net.tj":                                 ICANN,
+	"nic.tj":                                 ICANN,
+	"org.tj":                                 ICANN,
+	"test.tj":                                ICANN,
+	"web.tj":                                 ICANN,
+	"tk":                                     ICANN,
+	"tl":                                     ICANN,
+	"gov.tl":                                 ICANN,
+	"tm":                                     ICANN,
+	"co.tm":                                  ICANN,
+	"com.tm":                                 ICANN,
+	"edu.tm":                                 ICANN,
+	"gov.tm":                                 ICANN,
+	"mil.tm":                                 ICANN,
+	"net.tm":                                 ICANN,
+	"nom.tm":                                 ICANN,
+	"org.tm":                                 ICANN,
+	"tn":                                     ICANN,
+	"com.tn":                                 ICANN,
+	"ens.tn":                                 ICANN,
+	"fin.tn":                                 ICANN,
+	"gov.tn":                                 ICANN,
+	"ind.tn":                                 ICANN,
+	"info.tn":                                ICANN,
+	"intl.tn":                                ICANN,
+	"mincom.tn":                              ICANN,
+	"nat.tn":                                 ICANN,
+	"net.tn":                                 ICANN,
+	"org.tn":                                 ICANN,
+	"perso.tn":                               ICANN,
+	"tourism.tn":                             ICANN,
+	"to":                                     ICANN,
+	"com.to":                                 ICANN,
+	"edu.to":                                 ICANN,
+	"gov.to":                                 ICANN,
+	"mil.to":                                 ICANN,
+	"net.to":                                 ICANN,
+	"org.to":                                 ICANN,
+	"tr":                                     ICANN,
+	"av.tr":                                  ICANN,
+	"bbs.tr":                                 ICANN,
+	"bel.tr":                                 ICANN,
+	"biz.tr":                                 ICANN,
+	"com.tr":                                 ICANN,
+	"dr.tr":                                  ICANN,
+	"edu.tr":                                 ICANN,
+	"gen.tr":                                 ICANN,
+	"gov.tr":                                 ICANN,
+	"info.tr":                                ICANN,
+	"k12.tr":                                 ICANN,
+	"kep.tr":                                 ICANN,
+	"mil.tr":                                 ICANN,
+	"name.tr":                                ICANN,
+	"net.tr":                                 ICANN,
+	"org.tr":                                 ICANN,
+	"pol.tr":                                 ICANN,
+	"tel.tr":                                 ICANN,
+	"tsk.tr":                                 ICANN,
+	"tv.tr":                                  ICANN,
+	"web.tr":                                 ICANN,
+	"nc.tr":                                  ICANN,
+	"gov.nc.tr":                              ICANN,
+	"tt":                                     ICANN,
+	"biz.tt":                                 ICANN,
+	"co.tt":                                  ICANN,
+	"com.tt":                                 ICANN,
+	"edu.tt":                                 ICANN,
+	"gov.tt":                                 ICANN,
+	"info.tt":                                ICANN,
+	"mil.tt":                                 ICANN,
+	"name.tt":                                ICANN,
+	"net.tt":                                 ICANN,
+	"org.tt":                                 ICANN,
+	"pro.tt":                                 ICANN,
+	"tv":                                     ICANN,
+	"tw":                                     ICANN,
+	"club.tw":                                ICANN,
+	"com.tw":                                 ICANN,
+	"ebiz.tw":                                ICANN,
+	"edu.tw":                                 ICANN,
+	"game.tw":                                ICANN,
+	"gov.tw":                                 ICANN,
+	"idv.tw":                                 ICANN,
+	"mil.tw":                                 ICANN,
+	"net.tw":                                 ICANN,
+	"org.tw":                                 ICANN,
+	"tz":                                     ICANN,
+	"ac.tz":                                  ICANN,
+	"co.tz":                                  ICANN,
+	"go.tz":                                  ICANN,
+	"hotel.tz":                               ICANN,
+	"info.tz":                                ICANN,
+	"me.tz":                                  ICANN,
+	"mil.tz":                                 ICANN,
+	"mobi.tz":                                ICANN,
+	"ne.tz":                                  ICANN,
+	"or.tz":                                  ICANN,
+	"sc.tz":                                  ICANN,
+	"tv.tz":                                  ICANN,
+	"ua":                                     ICANN,
+	"com.ua":                                 ICANN,
+	"edu.ua":                                 ICANN,
+	"gov.ua":                                 ICANN,
+	"in.ua":                                  ICANN,
+	"net.ua":                                 ICANN,
+	"org.ua":                                 ICANN,
+	"cherkassy.ua":                           ICANN,
+	"cherkasy.ua":                            ICANN,
+	"chernigov.ua":                           ICANN,
+	"chernihiv.ua":                           ICANN,
+	"chernivtsi.ua":                          ICANN,
+	"chernovtsy.ua":                          ICANN,
+	"ck.ua":                                  ICANN,
+	"cn.ua":                                  ICANN,
+	"cr.ua":                                  ICANN,
+	"crimea.ua":                              ICANN,
+	"cv.ua":                                  ICANN,
+	"dn.ua":                                  ICANN,
+	"dnepropetrovsk.ua":                      ICANN,
+	"dnipropetrovsk.ua":                      ICANN,
+	"donetsk.ua":                             ICANN,
+	"dp.ua":                                  ICANN,
+	"if.ua":                                  ICANN,
+	"ivano-frankivsk.ua":                     ICANN,
+	"kh.ua":                                  ICANN,
+	"kharkiv.ua":                             ICANN,
+	"kharkov.ua":                             ICANN,
+	"kherson.ua":                             ICANN,
+	"khmelnitskiy.ua":                        ICANN,
+	"khmelnytskyi.ua":                        ICANN,
+	"kiev.ua":                                ICANN,
+	"kirovograd.ua":                          ICANN,
+	"km.ua":                                  ICANN,
+	"kr.ua":                                  ICANN,
+	"kropyvnytskyi.ua":                       ICANN,
+	"krym.ua":                                ICANN,
+	"ks.ua":                                  ICANN,
+	"kv.ua":                                  ICANN,
+	"kyiv.ua":                                ICANN,
+	"lg.ua":                                  ICANN,
+	"lt.ua":                                  ICANN,
+	"lugansk.ua":                             ICANN,
+	"luhansk.ua":                             ICANN,
+	"lutsk.ua":                               ICANN,
+	"lv.ua":                                  ICANN,
+	"lviv.ua":                                ICANN,
+	"mk.ua":                                  ICANN,
+	"mykolaiv.ua":                            ICANN,
+	"nikolaev.ua":                            ICANN,
+	"od.ua":                                  ICANN,
+	"odesa.ua":                               ICANN,
+	"odessa.ua":                              ICANN,
+	"pl.ua":                                  ICANN,
+	"poltava.ua":                             ICANN,
+	"rivne.ua":                               ICANN,
+	"rovno.ua":                               ICANN,
+	"rv.ua":                                  ICANN,
+	"sb.ua":                                  ICANN,
+	"sebastopol.ua":                          ICANN,
+	"sevastopol.ua":                          ICANN,
+	"sm.ua":                                  ICANN,
+	"sumy.ua":                                ICANN,
+	"te.ua":                                  ICANN,
+	"ternopil.ua":                            ICANN,
+	"uz.ua":                                  ICANN,
+	"uzhgorod.ua":                            ICANN,
+	"uzhhorod.ua":                            ICANN,
+	"vinnica.ua":                             ICANN,
+	"vinnytsia.ua":                           ICANN,
+	"vn.ua":                                  ICANN,
+	"volyn.ua":                               ICANN,
+	"yalta.ua":                               ICANN,
+	"zakarpattia.ua":                         ICANN,
+	"zaporizhzhe.ua":                         ICANN,
+	"zaporizhzhia.ua":                        ICANN,
+	"zhitomir.ua":                            ICANN,
+	"zhytomyr.ua":                            ICANN,
+	"zp.ua":                                  ICANN,
+	"zt.ua":                                  ICANN,
+	"ug":                                     ICANN,
+	"ac.ug":                                  ICANN,
+	"co.ug":                                  ICANN,
+	"com.ug":                                 ICANN,
+	"edu.ug":                                 ICANN,
+	"go.ug":                                  ICANN,
+	"gov.ug":                                 ICANN,
+	"mil.ug":                                 ICANN,
+	"ne.ug":                                  ICANN,
+	"or.ug":                                  ICANN,
+	"org.ug":                                 ICANN,
+	"sc.ug":                                  ICANN,
+	"us.ug":                                  ICANN,
+	"uk":                                     ICANN,
+	"ac.uk":                                  ICANN,
+	"co.uk":                                  ICANN,
+	"gov.uk":                                 ICANN,
+	"ltd.uk":                                 ICANN,
+	"me.uk":                                  ICANN,
+	"net.uk":                                 ICANN,
+	"nhs.uk":                                 ICANN,
+	"org.uk":                                 ICANN,
+	"plc.uk":                                 ICANN,
+	"police.uk":                              ICANN,
+	"*.sch.uk":                               ICANN,
+	"us":                                     ICANN,
+	"dni.us":                                 ICANN,
+	"isa.us":                                 ICANN,
+	"nsn.us":                                 ICANN,
+	"ak.us":                                  ICANN,
+	"al.us":                                  ICANN,
+	"ar.us":                                  ICANN,
+	"as.us":                                  ICANN,
+	"az.us":                                  ICANN,
+	"ca.us":                                  ICANN,
+	"co.us":                                  ICANN,
+	"ct.us":                                  ICANN,
+	"dc.us":                                  ICANN,
+	"de.us":                                  ICANN,
+	"fl.us":                                  ICANN,
+	"ga.us":                                  ICANN,
+	"gu.us":                                  ICANN,
+	"hi.us":                                  ICANN,
+	"ia.us":                                  ICANN,
+	"id.us":                                  ICANN,
+	"il.us":                                  ICANN,
+	"in.us":                                  ICANN,
+	"ks.us":                                  ICANN,
+	"ky.us":                                  ICANN,
+	"la.us":                                  ICANN,
+	"ma.us":                                  ICANN,
+	"md.us":                                  ICANN,
+	"me.us":                                  ICANN,
+	"mi.us":                                  ICANN,
+	"mn.us":                                  ICANN,
+	"mo.us":                                  ICANN,
+	"ms.us":                                  ICANN,
+	"mt.us":                                  ICANN,
+	"nc.us":                                  ICANN,
+	"nd.us":                                  ICANN,
+	"ne.us":                                  ICANN,
+	"nh.us":                                  ICANN,
+	"nj.us":                                  ICANN,
+	"nm.us":                                  ICANN,
+	"nv.us":                                  ICANN,
+	"ny.us":                                  ICANN,
+	"oh.us":                                  ICANN,
+	"ok.us":                                  ICANN,
+	"or.us":                                  ICANN,
+	"pa.us":                                  ICANN,
+	"pr.us":                                  ICANN,
+	"ri.us":                                  ICANN,
+	"sc.us":                                  ICANN,
+	"sd.us":                                  ICANN,
+	"tn.us":                                  ICANN,
+	"tx.us":                                  ICANN,
+	"ut.us":                                  ICANN,
+	"va.us":                                  ICANN,
+	"vi.us":                                  ICANN,
+	"vt.us":                                  ICANN,
+	"wa.us":                                  ICANN,
+	"wi.us":                                  ICANN,
+	"wv.us":                                  ICANN,
+	"wy.us":                                  ICANN,
+	"k12.ak.us":                              ICANN,
+	"k12.al.us":                              ICANN,
+	"k12.ar.us":                              ICANN,
+	"k12.as.us":                              ICANN,
+	"k12.az.us":                              ICANN,
+	"k12.ca.us":                              ICANN,
+	"k12.co.us":                              ICANN,
+	"k12.ct.us":                              ICANN,
+	"k12.dc.us":                              ICANN,
+	"k12.fl.us":                              ICANN,
+	"k12.ga.us":                              ICANN,
+	"k12.gu.us":                              ICANN,
+	"k12.ia.us":                              ICANN,
+	"k12.id.us":                              ICANN,
+	"k12.il.us":                              ICANN,
+	"k12.in.us":                              ICANN,
+	"k12.ks.us":                              ICANN,
+	"k12.ky.us":                              ICANN,
+	"k12.la.us":                              ICANN,
+	"k12.ma.us":                              ICANN,
+	"k12.md.us":                              ICANN,
+	"k12.me.us":                              ICANN,
+	"k12.mi.us":                              ICANN,
+	"k12.mn.us":                              ICANN,
+	"k12.mo.us":                              ICANN,
+	"k12.ms.us":                              ICANN,
+	"k12.mt.us":                              ICANN,
+	"k12.nc.us":                              ICANN,
+	"k12.ne.us":                              ICANN,
+	"k12.nh.us":                              ICANN,
+	"k12.nj.us":                              ICANN,
+	"k12.nm.us":                              ICANN,
+	"k12.nv.us":                              ICANN,
+	"k12.ny.us":                              ICANN,
+	"k12.oh.us":                              ICANN,
+	"k12.ok.us":                              ICANN,
+	"k12.or.us":                              ICANN,
+	"k12.pa.us":                              ICANN,
+	"k12.pr.us":                              ICANN,
+	"k12.sc.us":                              ICANN,
+	"k12.tn.us":                              ICANN,
+	"k12.tx.us":                              ICANN,
+	"k12.ut.us":                              ICANN,
+	"k12.va.us":                              ICANN,
+	"k12.vi.us":                              ICANN,
+	"k12.vt.us":                              ICANN,
+	"k12.wa.us":                              ICANN,
+	"k12.wi.us":                              ICANN,
+	"cc.ak.us":                               ICANN,
+	"lib.ak.us":                              ICANN,
+	"cc.al.us":                               ICANN,
+	"lib.al.us":                              ICANN,
+	"cc.ar.us":                               ICANN,
+	"lib.ar.us":                              ICANN,
+	"cc.as.us":                               ICANN,
+	"lib.as.us":                              ICANN,
+	"cc.az.us":                               ICANN,
+	"lib.az.us":                              ICANN,
+	"cc.ca.us":                               ICANN,
+	"lib.ca.us":                              ICANN,
+	"cc.co.us":                               ICANN,
+	"lib.co.us":                              ICANN,
+	"cc.ct.us":                               ICANN,
+	"lib.ct.us":                              ICANN,
+	"cc.dc.us":                               ICANN,
+	"lib.dc.us":                              ICANN,
+	"cc.de.us":                               ICANN,
+	"cc.fl.us":                               ICANN,
+	"lib.fl.us":                              ICANN,
+	"cc.ga.us":                               ICANN,
+	"lib.ga.us":                              ICANN,
+	"cc.gu.us":                               ICANN,
+	"lib.gu.us":                              ICANN,
+	"cc.hi.us":                               ICANN,
+	"lib.hi.us":                              ICANN,
+	"cc.ia.us":                               ICANN,
+	"lib.ia.us":                              ICANN,
+	"cc.id.us":                               ICANN,
+	"lib.id.us":                              ICANN,
+	"cc.il.us":                               ICANN,
+	"lib.il.us":                              ICANN,
+	"cc.in.us":                               ICANN,
+	"lib.in.us":                              ICANN,
+	"cc.ks.us":                               ICANN,
+	"lib.ks.us":                              ICANN,
+	"cc.ky.us":                               ICANN,
+	"lib.ky.us":                              ICANN,
+	"cc.la.us":                               ICANN,
+	"lib.la.us":                              ICANN,
+	"cc.ma.us":                               ICANN,
+	"lib.ma.us":                              ICANN,
+	"cc.md.us":                               ICANN,
+	"lib.md.us":                              ICANN,
+	"cc.me.us":                               ICANN,
+	"lib.me.us":                              ICANN,
+	"cc.mi.us":                               ICANN,
+	"lib.mi.us":                              ICANN,
+	"cc.mn.us":                               ICANN,
+	"lib.mn.us":                              ICANN,
+	"cc.mo.us":                               ICANN,
+	"lib.mo.us":                              ICANN,
+	"cc.ms.us":                               ICANN,
+	"cc.mt.us":                               ICANN,
+	"lib.mt.us":                              ICANN,
+	"cc.nc.us":                               ICANN,
+	"lib.nc.us":                              ICANN,
+	"cc.nd.us":                               ICANN,
+	"lib.nd.us":                              ICANN,
+	"cc.ne.us":                               ICANN,
+	"lib.ne.us":                              ICANN,
+	"cc.nh.us":                               ICANN,
+	"lib.nh.us":                              ICANN,
+	"cc.nj.us":                               ICANN,
+	"lib.nj.us":                              ICANN,
+	"cc.nm.us":                               ICANN,
+	"lib.nm.us":                              ICANN,
+	"cc.nv.us":                               ICANN,
+	"lib.nv.us":                              ICANN,
+	"cc.ny.us":                               ICANN,
+	"lib.ny.us":                              ICANN,
+	"cc.oh.us":                               ICANN,
+	"lib.oh.us":                              ICANN,
+	"cc.ok.us":                               ICANN,
+	"lib.ok.us":                              ICANN,
+	"cc.or.us":                               ICANN,
+	"lib.or.us":                              ICANN,
+	"cc.pa.us":                               ICANN,
+	"lib.pa.us":                              ICANN,
+	"cc.pr.us":                               ICANN,
+	"lib.pr.us":                              ICANN,
+	"cc.ri.us":                               ICANN,
+	"lib.ri.us":                              ICANN,
+	"cc.sc.us":                               ICANN,
+	"lib.sc.us":                              ICANN,
+	"cc.sd.us":                               ICANN,
+	"lib.sd.us":                              ICANN,
+	"cc.tn.us":                               ICANN,
+	"lib.tn.us":                              ICANN,
+	"cc.tx.us":                               ICANN,
+	"lib.tx.us":                              ICANN,
+	"cc.ut.us":                               ICANN,
+	"lib.ut.us":                              ICANN,
+	"cc.va.us":                               ICANN,
+	"lib.va.us":                              ICANN,
+	"cc.vi.us":                               ICANN,
+	"lib.vi.us":                              ICANN,
+	"cc.vt.us":                               ICANN,
+	"lib.vt.us":                              ICANN,
+	"cc.wa.us":                               ICANN,
+	"lib.wa.us":                              ICANN,
+	"cc.wi.us":                               ICANN,
+	"lib.wi.us":                              ICANN,
+	"cc.wv.us":                               ICANN,
+	"cc.wy.us":                               ICANN,
+	"k12.wy.us":                              ICANN,
+	"lib.wy.us":                              ICANN,
+	"chtr.k12.ma.us":                         ICANN,
+	"paroch.k12.ma.us":                       ICANN,
+	"pvt.k12.ma.us":                          ICANN,
+	"ann-arbor.mi.us":                        ICANN,
+	"cog.mi.us":                              ICANN,
+	"dst.mi.us":                              ICANN,
+	"eaton.mi.us":                            ICANN,
+	"gen.mi.us":                              ICANN,
+	"mus.mi.us":                              ICANN,
+	"tec.mi.us":                              ICANN,
+	"washtenaw.mi.us":                        ICANN,
+	"uy":                                     ICANN,
+	"com.uy":                                 ICANN,
+	"edu.uy":                                 ICANN,
+	"gub.uy":                                 ICANN,
+	"mil.uy":                                 ICANN,
+	"net.uy":                                 ICANN,
+	"org.uy":                                 ICANN,
+	"uz":                                     ICANN,
+	"co.uz":                                  ICANN,
+	"com.uz":                                 ICANN,
+	"net.uz":                                 ICANN,
+	"org.uz":                                 ICANN,
+	"va":                                     ICANN,
+	"vc":                                     ICANN,
+	"com.vc":                                 ICANN,
+	"edu.vc":                                 ICANN,
+	"gov.vc":                                 ICANN,
+	"mil.vc":                                 ICANN,
+	"net.vc":                                 ICANN,
+	"org.vc":                                 ICANN,
+	"ve":                                     ICANN,
+	"arts.ve":                                ICANN,
+	"bib.ve":                                 ICANN,
+	"co.ve":                                  ICANN,
+	"com.ve":                                 ICANN,
+	"e12.ve":                                 ICANN,
+	"edu.ve":                                 ICANN,
+	"emprende.ve":                            ICANN,
+	"firm.ve":                                ICANN,
+	"gob.ve":                                 ICANN,
+	"gov.ve":                                 ICANN,
+	"ia.ve":                                  ICANN,
+	"info.ve":                                ICANN,
+	"int.ve":                                 ICANN,
+	"mil.ve":                                 ICANN,
+	"net.ve":                                 ICANN,
+	"nom.ve":                                 ICANN,
+	"org.ve":                                 ICANN,
+	"rar.ve":                                 ICANN,
+	"rec.ve":                                 ICANN,
+	"store.ve":                               ICANN,
+	"tec.ve":                                 ICANN,
+	"web.ve":                                 ICANN,
+	"vg":                                     ICANN,
+	"edu.vg":                                 ICANN,
+	"vi":                                     ICANN,
+	"co.vi":                                  ICANN,
+	"com.vi":                                 ICANN,
+	"k12.vi":                                 ICANN,
+	"net.vi":                                 ICANN,
+	"org.vi":                                 ICANN,
+	"vn":                                     ICANN,
+	"ac.vn":                                  ICANN,
+	"ai.vn":                                  ICANN,
+	"biz.vn":                                 ICANN,
+	"com.vn":                                 ICANN,
+	"edu.vn":                                 ICANN,
+	"gov.vn":                                 ICANN,
+	"health.vn":                              ICANN,
+	"id.vn":                                  ICANN,
+	"info.vn":                                ICANN,
+	"int.vn":                                 ICANN,
+	"io.vn":                                  ICANN,
+	"name.vn":                                ICANN,
+	"net.vn":                                 ICANN,
+	"org.vn":                                 ICANN,
+	"pro.vn":                                 ICANN,
+	"angiang.vn":                             ICANN,
+	"bacgiang.vn":                            ICANN,
+	"backan.vn":                              ICANN,
+	"baclieu.vn":                             ICANN,
+	"bacninh.vn":                             ICANN,
+	"baria-vungtau.vn":                       ICANN,
+	"bentre.vn":                              ICANN,
+	"binhdinh.vn":                            ICANN,
+	"binhduong.vn":                           ICANN,
+	"binhphuoc.vn":                           ICANN,
+	"binhthuan.vn":                           ICANN,
+	"camau.vn":                               ICANN,
+	"cantho.vn":                              ICANN,
+	"caobang.vn":                             ICANN,
+	"daklak.vn":                              ICANN,
+	"daknong.vn":                             ICANN,
+	"danang.vn":                              ICANN,
+	"dienbien.vn":                            ICANN,
+	"dongnai.vn":                             ICANN,
+	"dongthap.vn":                            ICANN,
+	"gialai.vn":                              ICANN,
+	"hagiang.vn":                             ICANN,
+	"haiduong.vn":                            ICANN,
+	"haiphong.vn":                            ICANN,
+	"hanam.vn":                               ICANN,
+	"hanoi.vn":                               ICANN,
+	"hatinh.vn":                              ICANN,
+	"haugiang.vn":                            ICANN,
+	"hoabinh.vn":                             ICANN,
+	"hue.vn":                                 ICANN,
+	"hungyen.vn":                             ICANN,
+	"khanhhoa.vn":                            ICANN,
+	"kiengiang.vn":                           ICANN,
+	"kontum.vn":                              ICANN,
+	"laichau.vn":                             ICANN,
+	"lamdong.vn":                             ICANN,
+	"langson.vn":                             ICANN,
+	"laocai.vn":                              ICANN,
+	"longan.vn":                              ICANN,
+	"namdinh.vn":                             ICANN,
+	"nghean.vn":                              ICANN,
+	"ninhbinh.vn":                            ICANN,
+	"ninhthuan.vn":                           ICANN,
+	"phutho.vn":                              ICANN,
+	"phuyen.vn":                              ICANN,
+	"quangbinh.vn":                           ICANN,
+	"quangnam.vn":                            ICANN,
+	"quangngai.vn":                           ICANN,
+	"quangninh.vn":                           ICANN,
+	"quangtri.vn":                            ICANN,
+	"soctrang.vn":                            ICANN,
+	"sonla.vn":                               ICANN,
+	"tayninh.vn":                             ICANN,
+	"thaibinh.vn":                            ICANN,
+	"thainguyen.vn":                          ICANN,
+	"thanhhoa.vn":                            ICANN,
+	"thanhphohochiminh.vn":                   ICANN,
+	"thuathienhue.vn":                        ICANN,
+	"tiengiang.vn":                           ICANN,
+	"travinh.vn":                             ICANN,
+	"tuyenquang.vn":                          ICANN,
+	"vinhlong.vn":                            ICANN,
+	"vinhphuc.vn":                            ICANN,
+	"yenbai.vn":                              ICANN,
+	"vu":                                     ICANN,
+	"com.vu":                                 ICANN,
+	"edu.vu":                                 ICANN,
+	"net.vu":                                 ICANN,
+	"org.vu":                                 ICANN,
+	"wf":                                     ICANN,
+	"ws":                                     ICANN,
+	"com.ws":                                 ICANN,
+	"edu.ws":                                 ICANN,
+	"gov.ws":                                 ICANN,
+	"net.ws":                                 ICANN,
+	"org.ws":                                 ICANN,
+	"yt":                                     ICANN,
+	"xn--mgbaam7a8h":                         ICANN,
+	"xn--y9a3aq":                             ICANN,
+	"xn--54b7fta0cc":                         ICANN,
+	"xn--90ae":                               ICANN,
+	"xn--mgbcpq6gpa1a":                       ICANN,
+	"xn--90ais":                              ICANN,
+	"xn--fiqs8s":                             ICANN,
+	"xn--fiqz9s":                             ICANN,
+	"xn--lgbbat1ad8j":                        ICANN,
+	"xn--wgbh1c":                             ICANN,
+	"xn--e1a4c":                              ICANN,
+	"xn--qxa6a":                              ICANN,
+	"xn--mgbah1a3hjkrd":                      ICANN,
+	"xn--node":                               ICANN,
+	"xn--qxam":                               ICANN,
+	"xn--j6w193g":                            ICANN,
+	"xn--gmqw5a.xn--j6w193g":                 ICANN,
+	"xn--55qx5d.xn--j6w193g":                 ICANN,
+	"xn--mxtq1m.xn--j6w193g":                 ICANN,
+	"xn--wcvs22d.xn--j6w193g":                ICANN,
+	"xn--uc0atv.xn--j6w193g":                 ICANN,
+	"xn--od0alg.xn--j6w193g":                 ICANN,
+	"xn--2scrj9c":                            ICANN,
+	"xn--3hcrj9c":                            ICANN,
+	"xn--45br5cyl":                           ICANN,
+	"xn--h2breg3eve":                         ICANN,
+	"xn--h2brj9c8c":                          ICANN,
+	"xn--mgbgu82a":                           ICANN,
+	"xn--rvc1e0am3e":                         ICANN,
+	"xn--h2brj9c":                            ICANN,
+	"xn--mgbbh1a":                            ICANN,
+	"xn--mgbbh1a71e":                         ICANN,
+	"xn--fpcrj9c3d":                          ICANN,
+	"xn--gecrj9c":                            ICANN,
+	"xn--s9brj9c":                            ICANN,
+	"xn--45brj9c":                            ICANN,
+	"xn--xkc2dl3a5ee0h":                      ICANN,
+	"xn--mgba3a4f16a":                        ICANN,
+	"xn--mgba3a4fra":                         ICANN,
+	"xn--mgbtx2b":                            ICANN,
+	"xn--mgbayh7gpa":                         ICANN,
+	"xn--3e0b707e":                           ICANN,
+	"xn--80ao21a":                            ICANN,
+	"xn--q7ce6a":                             ICANN,
+	"xn--fzc2c9e2c":                          ICANN,
+	"xn--xkc2al3hye2a":                       ICANN,
+	"xn--mgbc0a9azcg":                        ICANN,
+	"xn--d1alf":                              ICANN,
+	"xn--l1acc":                              ICANN,
+	"xn--mix891f":                            ICANN,
+	"xn--mix082f":                            ICANN,
+	"xn--mgbx4cd0ab":                         ICANN,
+	"xn--mgb9awbf":                           ICANN,
+	"xn--mgbai9azgqp6j":                      ICANN,
+	"xn--mgbai9a5eva00b":                     ICANN,
+	"xn--ygbi2ammx":                          ICANN,
+	"xn--90a3ac":                             ICANN,
+	"xn--80au.xn--90a3ac":                    ICANN,
+	"xn--90azh.xn--90a3ac":                   ICANN,
+	"xn--d1at.xn--90a3ac":                    ICANN,
+	"xn--c1avg.xn--90a3ac":                   ICANN,
+	"xn--o1ac.xn--90a3ac":                    ICANN,
+	"xn--o1ach.xn--90a3ac":                   ICANN,
+	"xn--p1ai":                               ICANN,
+	"xn--wgbl6a":                             ICANN,
+	"xn--mgberp4a5d4ar":                      ICANN,
+	"xn--mgberp4a5d4a87g":                    ICANN,
+	"xn--mgbqly7c0a67fbc":                    ICANN,
+	"xn--mgbqly7cvafr":                       ICANN,
+	"xn--mgbpl2fh":                           ICANN,
+	"xn--yfro4i67o":                          ICANN,
+	"xn--clchc0ea0b2g2a9gcd":                 ICANN,
+	"xn--ogbpf8fl":                           ICANN,
+	"xn--mgbtf8fl":                           ICANN,
+	"xn--o3cw4h":                             ICANN,
+	"xn--o3cyx2a.xn--o3cw4h":                 ICANN,
+	"xn--12co0c3b4eva.xn--o3cw4h":            ICANN,
+	"xn--m3ch0j3a.xn--o3cw4h":                ICANN,
+	"xn--h3cuzk1di.xn--o3cw4h":               ICANN,
+	"xn--12c1fe0br.xn--o3cw4h":               ICANN,
+	"xn--12cfi8ixb8l.xn--o3cw4h":             ICANN,
+	"xn--pgbs0dh":                            ICANN,
+	"xn--kpry57d":                            ICANN,
+	"xn--kprw13d":                            ICANN,
+	"xn--nnx388a":                            ICANN,
+	"xn--j1amh":                              ICANN,
+	"xn--mgb2ddes":                           ICANN,
+	"xxx":                                    ICANN,
+	"ye":                                     ICANN,
+	"com.ye":                                 ICANN,
+	"edu.ye":                                 ICANN,
+	"gov.ye":                                 ICANN,
+	"mil.ye":                                 ICANN,
+	"net.ye":                                 ICANN,
+	"org.ye":                                 ICANN,
+	"ac.za":                                  ICANN,
+	"agric.za":                               ICANN,
+	"alt.za":                                 ICANN,
+	"co.za":                                  ICANN,
+	"edu.za":                                 ICANN,
+	"gov.za":                                 ICANN,
+	"grondar.za":                             ICANN,
+	"law.za":                                 ICANN,
+	"mil.za":                                 ICANN,
+	"net.za":                                 ICANN,
+	"ngo.za":                                 ICANN,
+	"nic.za":                                 ICANN,
+	"nis.za":                                 ICANN,
+	"nom.za":                                 ICANN,
+	"org.za":                                 ICANN,
+	"school.za":                              ICANN,
+	"tm.za":                                  ICANN,
+	"web.za":                                 ICANN,
+	"zm":                                     ICANN,
+	"ac.zm":                                  ICANN,
+	"biz.zm":                                 ICANN,
+	"co.zm":                                  ICANN,
+	"com.zm":                                 ICANN,
+	"edu.zm":                                 ICANN,
+	"gov.zm":                                 ICANN,
+	"info.zm":                                ICANN,
+	"mil.zm":                                 ICANN,
+	"net.zm":                                 ICANN,
+	"org.zm":                                 ICANN,
+	"sch.zm":                                 ICANN,
+	"zw":                                     ICANN,
+	"ac.zw":                                  ICANN,
+	"co.zw":                                  ICANN,
+	"gov.zw":                                 ICANN,
+	"mil.zw":                                 ICANN,
+	"org.zw":                                 ICANN,
+	"aaa":                                    ICANN,
+	"aarp":                                   ICANN,
+	"abb":                                    ICANN,
+	"abbott":                                 ICANN,
+	"abbvie":                                 ICANN,
+	"abc":                                    ICANN,
+	"able":                                   ICANN,
+	"abogado":                                ICANN,
+	"abudhabi":                               ICANN,
+	"academy":                                ICANN,
+	"accenture":                              ICANN,
+	"accountant":                             ICANN,
+	"accountants":                            ICANN,
+	"aco":                                    ICANN,
+	"actor":                                  ICANN,
+	"ads":                                    ICANN,
+	"adult":                                  ICANN,
+	"aeg":                                    ICANN,
+	"aetna":                                  ICANN,
+	"afl":                                    ICANN,
+	"africa":                                 ICANN,
+	"agakhan":                                ICANN,
+	"agency":                                 ICANN,
+	"aig":                                    ICANN,
+	"airbus":                                 ICANN,
+	"airforce":                               ICANN,
+	"airtel":                                 ICANN,
+	"akdn":                                   ICANN,
+	"alibaba":                                ICANN,
+	"alipay":                                 ICANN,
+	"allfinanz":                              ICANN,
+	"allstate":                               ICANN,
+	"ally":                                   ICANN,
+	"alsace":                                 ICANN,
+	"alstom":                                 ICANN,
+	"amazon":                                 ICANN,
+	"americanexpress":                        ICANN,
+	"americanfamily":                         ICANN,
+	"amex":                                   ICANN,
+	"amfam":                                  ICANN,
+	"amica":                                  ICANN,
+	"amsterdam":                              ICANN,
+	"analytics":                              ICANN,
+	"android":                                ICANN,
+	"anquan":                                 ICANN,
+	"anz":                                    ICANN,
+	"aol":                                    ICANN,
+	"apartments":                             ICANN,
+	"app":                                    ICANN,
+	"apple":                                  ICANN,
+	"aquarelle":                              ICANN,
+	"arab":                                   ICANN,
+	"aramco":                                 ICANN,
+	"archi":                                  ICANN,
+	"army":                                   ICANN,
+	"art":                                    ICANN,
+	"arte":                                   ICANN,
+	"asda":                                   ICANN,
+	"associates":                             ICANN,
+	"athleta":                                ICANN,
+	"attorney":                               ICANN,
+	"auction":                                ICANN,
+	"audi":                                   ICANN,
+	"audible":                                ICANN,
+	"audio":                                  ICANN,
+	"auspost":                                ICANN,
+	"author":                                 ICANN,
+	"auto":                                   ICANN,
+	"autos":                                  ICANN,
+	"aws":                                    ICANN,
+	"axa":                                    ICANN,
+	"azure":                                  ICANN,
+	"baby":                                   ICANN,
+	"baidu":                                  ICANN,
+	"banamex":                                ICANN,
+	"band":                                   ICANN,
+	"bank":                                   ICANN,
+	"bar":                                    ICANN,
+	"barcelona":                              ICANN,
+	"barclaycard":                            ICANN,
+	"barclays":                               ICANN,
+	"barefoot":                               ICANN,
+	"bargains":                               ICANN,
+	"baseball":                               ICANN,
+	"basketball":                             ICANN,
+	"bauhaus":                                ICANN,
+	"bayern":                                 ICANN,
+	"bbc":                                    ICANN,
+	"bbt":                                    ICANN,
+	"bbva":                                   ICANN,
+	"bcg":                                    ICANN,
+	"bcn":                                    ICANN,
+	"beats":                                  ICANN,
+	"beauty":                                 ICANN,
+	"beer":                                   ICANN,
+	"berlin":                                 ICANN,
+	"best":                                   ICANN,
+	"bestbuy":                                ICANN,
+	"bet":                                    ICANN,
+	"bharti":                                 ICANN,
+	"bible":                                  ICANN,
+	"bid":                                    ICANN,
+	"bike":                                   ICANN,
+	"bing":                                   ICANN,
+	"bingo":                                  ICANN,
+	"bio":                                    ICANN,
+	"black":                                  ICANN,
+	"blackfriday":                            ICANN,
+	"blockbuster":                            ICANN,
+	"blog":                                   ICANN,
+	"bloomberg":                              ICANN,
+	"blue":                                   ICANN,
+	"bms":                                    ICANN,
+	"bmw":                                    ICANN,
+	"bnpparibas":                             ICANN,
+	"boats":                                  ICANN,
+	"boehringer":                             ICANN,
+	"bofa":                                   ICANN,
+	"bom":                                    ICANN,
+	"bond":                                   ICANN,
+	"boo":                                    ICANN,
+	"book":                                   ICANN,
+	"booking":                                ICANN,
+	"bosch":                                  ICANN,
+	"bostik":                                 ICANN,
+	"boston":                                 ICANN,
+	"bot":                                    ICANN,
+	"boutique":                               ICANN,
+	"box":                                    ICANN,
+	"bradesco":                               ICANN,
+	"bridgestone":                            ICANN,
+	"broadway":                               ICANN,
+	"broker":                                 ICANN,
+	"brother":                                ICANN,
+	"brussels":                               ICANN,
+	"build":                                  ICANN,
+	"builders":                               ICANN,
+	"business":                               ICANN,
+	"buy":                                    ICANN,
+	"buzz":                                   ICANN,
+	"bzh":                                    ICANN,
+	"cab":                                    ICANN,
+	"cafe":                                   ICANN,
+	"cal":                                    ICANN,
+	"call":                                   ICANN,
+	"calvinklein":                            ICANN,
+	"cam":                                    ICANN,
+	"camera":                                 ICANN,
+	"camp":                                   ICANN,
+	"canon":                                  ICANN,
+	"capetown":                               ICANN,
+	"capital":                                ICANN,
+	"capitalone":                             ICANN,
+	"car":                                    ICANN,
+	"caravan":                                ICANN,
+	"cards":                                  ICANN,
+	"care":                                   ICANN,
+	"career":                                 ICANN,
+	"careers":                                ICANN,
+	"cars":                                   ICANN,
+	"casa":                                   ICANN,
+	"case":                                   ICANN,
+	"cash":                                   ICANN,
+	"casino":                                 ICANN,
+	"catering":                               ICANN,
+	"catholic":                               ICANN,
+	"cba":                                    ICANN,
+	"cbn":                                    ICANN,
+	"cbre":                                   ICANN,
+	"center":                                 ICANN,
+	"ceo":                                    ICANN,
+	"cern":                                   ICANN,
+	"cfa":                                    ICANN,
+	"cfd":                                    ICANN,
+	"chanel":                                 ICANN,
+	"channel":                                ICANN,
+	"charity":                                ICANN,
+	"chase":                                  ICANN,
+	"chat":                                   ICANN,
+	"cheap":                                  ICANN,
+	"chintai":                                ICANN,
+	"christmas":                              ICANN,
+	"chrome":                                 ICANN,
+	"church":                                 ICANN,
+	"cipriani":                               ICANN,
+	"circle":                                 ICANN,
+	"cisco":                                  ICANN,
+	"citadel":                                ICANN,
+	"citi":                                   ICANN,
+	"citic":                                  ICANN,
+	"city":                                   ICANN,
+	"claims":                                 ICANN,
+	"cleaning":                               ICANN,
+	"click":                                  ICANN,
+	"clinic":                                 ICANN,
+	"clinique":                               ICANN,
+	"clothing":                               ICANN,
+	"cloud":                                  ICANN,
+	"club":                                   ICANN,
+	"clubmed":                                ICANN,
+	"coach":                                  ICANN,
+	"codes":                                  ICANN,
+	"coffee":                                 ICANN,
+	"college":                                ICANN,
+	"cologne":                                ICANN,
+	"commbank":                               ICANN,
+	"community":                              ICANN,
+	"company":                                ICANN,
+	"compare":                                ICANN,
+	"computer":                               ICANN,
+	"comsec":                                 ICANN,
+	"condos":                                 ICANN,
+	"construction":                           ICANN,
+	"consulting":                             ICANN,
+	"contact":                                ICANN,
+	"contractors":                            ICANN,
+	"cooking":                                ICANN,
+	"cool":                                   ICANN,
+	"corsica":                                ICANN,
+	"country":                                ICANN,
+	"coupon":                                 ICANN,
+	"coupons":                                ICANN,
+	"courses":                                ICANN,
+	"cpa":                                    ICANN,
+	"credit":                                 ICANN,
+	"creditcard":                             ICANN,
+	"creditunion":                            ICANN,
+	"cricket":                                ICANN,
+	"crown":                                  ICANN,
+	"crs":                                    ICANN,
+	"cruise":                                 ICANN,
+	"cruises":                                ICANN,
+	"cuisinella":                             ICANN,
+	"cymru":                                  ICANN,
+	"cyou":                                   ICANN,
+	"dad":                                    ICANN,
+	"dance":                                  ICANN,
+	"data":                                   ICANN,
+	"date":                                   ICANN,
+	"dating":                                 ICANN,
+	"datsun":                                 ICANN,
+	"day":                                    ICANN,
+	"dclk":                                   ICANN,
+	"dds":                                    ICANN,
+	"deal":                                   ICANN,
+	"dealer":                                 ICANN,
+	"deals":                                  ICANN,
+	"degree":                                 ICANN,
+	"delivery":                               ICANN,
+	"dell":                                   ICANN,
+	"deloitte":                               ICANN,
+	"delta":                                  ICANN,
+	"democrat":                               ICANN,
+	"dental":                                 ICANN,
+	"dentist":                                ICANN,
+	"desi":                                   ICANN,
+	"design":                                 ICANN,
+	"dev":                                    ICANN,
+	"dhl":                                    ICANN,
+	"diamonds":                               ICANN,
+	"diet":                                   ICANN,
+	"digital":                                ICANN,
+	"direct":                                 ICANN,
+	"directory":                              ICANN,
+	"discount":                               ICANN,
+	"discover":                               ICANN,
+	"dish":                                   ICANN,
+	"diy":                                    ICANN,
+	"dnp":                                    ICANN,
+	"docs":                                   ICANN,
+	"doctor":                                 ICANN,
+	"dog":                                    ICANN,
+	"domains":                                ICANN,
+	"dot":                                    ICANN,
+	"download":                               ICANN,
+	"drive":                                  ICANN,
+	"dtv":                                    ICANN,
+	"dubai":                                  ICANN,
+	"dupont":                                 ICANN,
+	"durban":                                 ICANN,
+	"dvag":                                   ICANN,
+	"dvr":                                    ICANN,
+	"earth":                                  ICANN,
+	"eat":                                    ICANN,
+	"eco":                                    ICANN,
+	"edeka":                                  ICANN,
+	"education":                              ICANN,
+	"email":                                  ICANN,
+	"emerck":                                 ICANN,
+	"energy":                                 ICANN,
+	"engineer":                               ICANN,
+	"engineering":                            ICANN,
+	"enterprises":                            ICANN,
+	"epson":                                  ICANN,
+	"equipment":                              ICANN,
+	"ericsson":                               ICANN,
+	"erni":                                   ICANN,
+	"esq":                                    ICANN,
+	"estate":                                 ICANN,
+	"eurovision":                             ICANN,
+	"eus":                                    ICANN,
+	"events":                                 ICANN,
+	"exchange":                               ICANN,
+	"expert":                                 ICANN,
+	"exposed":                                ICANN,
+	"express":                                ICANN,
+	"extraspace":                             ICANN,
+	"fage":                                   ICANN,
+	"fail":                                   ICANN,
+	"fairwinds":                              ICANN,
+	"faith":                                  ICANN,
+	"family":                                 ICANN,
+	"fan":                                    ICANN,
+	"fans":                                   ICANN,
+	"farm":                                   ICANN,
+	"farmers":                                ICANN,
+	"fashion":                                ICANN,
+	"fast":                                   ICANN,
+	"fedex":                                  ICANN,
+	"feedback":                               ICANN,
+	"ferrari":                                ICANN,
+	"ferrero":                                ICANN,
+	"fidelity":                               ICANN,
+	"fido":                                   ICANN,
+	"film":                                   ICANN,
+	"final":                                  ICANN,
+	"finance":                                ICANN,
+	"financial":                              ICANN,
+	"fire":                                   ICANN,
+	"firestone":                              ICANN,
+	"firmdale":                               ICANN,
+	"fish":                                   ICANN,
+	"fishing":                                ICANN,
+	"fit":                                    ICANN,
+	"fitness":                                ICANN,
+	"flickr":                                 ICANN,
+	"flights":                                ICANN,
+	"flir":                                   ICANN,
+	"florist":                                ICANN,
+	"flowers":                                ICANN,
+	"fly":                                    ICANN,
+	"foo":                                    ICANN,
+	"food":                                   ICANN,
+	"football":                               ICANN,
+	"ford":                                   ICANN,
+	"forex":                                  ICANN,
+	"forsale":                                ICANN,
+	"forum":                                  ICANN,
+	"foundation":                             ICANN,
+	"fox":                                    ICANN,
+	"free":                                   ICANN,
+	"fresenius":                              ICANN,
+	"frl":                                    ICANN,
+	"frogans":                                ICANN,
+	"frontier":                               ICANN,
+	"ftr":                                    ICANN,
+	"fujitsu":                                ICANN,
+	"fun":                                    ICANN,
+	"fund":                                   ICANN,
+	"furniture":                              ICANN,
+	"futbol":                                 ICANN,
+	"fyi":                                    ICANN,
+	"gal":                                    ICANN,
+	"gallery":                                ICANN,
+	"gallo":                                  ICANN,
+	"gallup":                                 ICANN,
+	"game":                                   ICANN,
+	"games":                                  ICANN,
+	"gap":                                    ICANN,
+	"garden":                                 ICANN,
+	"gay":                                    ICANN,
+	"gbiz":                                   ICANN,
+	"gdn":                                    ICANN,
+	"gea":                                    ICANN,
+	"gent":                                   ICANN,
+	"genting":                                ICANN,
+	"george":                                 ICANN,
+	"ggee":                                   ICANN,
+	"gift":                                   ICANN,
+	"gifts":                                  ICANN,
+	"gives":                                  ICANN,
+	"giving":                                 ICANN,
+	"glass":                                  ICANN,
+	"gle":                                    ICANN,
+	"global":                                 ICANN,
+	"globo":                                  ICANN,
+	"gmail":                                  ICANN,
+	"gmbh":                                   ICANN,
+	"gmo":                                    ICANN,
+	"gmx":                                    ICANN,
+	"godaddy":                                ICANN,
+	"gold":                                   ICANN,
+	"goldpoint":                              ICANN,
+	"golf":                                   ICANN,
+	"goodyear":                               ICANN,
+	"goog":                                   ICANN,
+	"google":                                 ICANN,
+	"gop":                                    ICANN,
+	"got":                                    ICANN,
+	"grainger":                               ICANN,
+	"graphics":                               ICANN,
+	"gratis":                                 ICANN,
+	"green":                                  ICANN,
+	"gripe":                                  ICANN,
+	"grocery":                                ICANN,
+	"group":                                  ICANN,
+	"gucci":                                  ICANN,
+	"guge":                                   ICANN,
+	"guide":                                  ICANN,
+	"guitars":                                ICANN,
+	"guru":                                   ICANN,
+	"hair":                                   ICANN,
+	"hamburg":                                ICANN,
+	"hangout":                                ICANN,
+	"haus":                                   ICANN,
+	"hbo":                                    ICANN,
+	"hdfc":                                   ICANN,
+	"hdfcbank":                               ICANN,
+	"health":                                 ICANN,
+	"healthcare":                             ICANN,
+	"help":                                   ICANN,
+	"helsinki":                               ICANN,
+	"here":                                   ICANN,
+	"hermes":                                 ICANN,
+	"hiphop":                                 ICANN,
+	"hisamitsu":                              ICANN,
+	"hitachi":                                ICANN,
+	"hiv":                                    ICANN,
+	"hkt":                                    ICANN,
+	"hockey":                                 ICANN,
+	"holdings":                               ICANN,
+	"holiday":                                ICANN,
+	"homedepot":                              ICANN,
+	"homegoods":                              ICANN,
+	"homes":                                  ICANN,
+	"homesense":                              ICANN,
+	"honda":                                  ICANN,
+	"horse":                                  ICANN,
+	"hospital":                               ICANN,
+	"host":                                   ICANN,
+	"hosting":                                ICANN,
+	"hot":                                    ICANN,
+	"hotel":                                  ICANN,
+	"hotels":                                 ICANN,
+	"hotmail":                                ICANN,
+	"house":                                  ICANN,
+	"how":                                    ICANN,
+	"hsbc":                                   ICANN,
+	"hughes":                                 ICANN,
+	"hyatt":                                  ICANN,
+	"hyundai":                                ICANN,
+	"ibm":                                    ICANN,
+	"icbc":                                   ICANN,
+	"ice":                                    ICANN,
+	"icu":                                    ICANN,
+	"ieee":                                   ICANN,
+	"ifm":                                    ICANN,
+	"ikano":                                  ICANN,
+	"imamat":                                 ICANN,
+	"imdb":                                   ICANN,
+	"immo":                                   ICANN,
+	"immobilien":                             ICANN,
+	"inc":                                    ICANN,
+	"industries":                             ICANN,
+	"infiniti":                               ICANN,
+	"ing":                                    ICANN,
+	"ink":                                    ICANN,
+	"institute":                              ICANN,
+	"insurance":                              ICANN,
+	"insure":                                 ICANN,
+	"international":                          ICANN,
+	"intuit":                                 ICANN,
+	"investments":                            ICANN,
+	"ipiranga":                               ICANN,
+	"irish":                                  ICANN,
+	"ismaili":                                ICANN,
+	"ist":                                    ICANN,
+	"istanbul":                               ICANN,
+	"itau":                                   ICANN,
+	"itv":                                    ICANN,
+	"jaguar":                                 ICANN,
+	"java":                                   ICANN,
+	"jcb":                                    ICANN,
+	"jeep":                                   ICANN,
+	"jetzt":                                  ICANN,
+	"jewelry":                                ICANN,
+	"jio":                                    ICANN,
+	"jll":                                    ICANN,
+	"jmp":                                    ICANN,
+	"jnj":                                    ICANN,
+	"joburg":                                 ICANN,
+	"jot":                                    ICANN,
+	"joy":                                    ICANN,
+	"jpmorgan":                               ICANN,
+	"jprs":                                   ICANN,
+	"juegos":                                 ICANN,
+	"juniper":                                ICANN,
+	"kaufen":                                 ICANN,
+	"kddi":                                   ICANN,
+	"kerryhotels":                            ICANN,
+	"kerryproperties":                        ICANN,
+	"kfh":                                    ICANN,
+	"kia":                                    ICANN,
+	"kids":                                   ICANN,
+	"kim":                                    ICANN,
+	"kindle":                                 ICANN,
+	"kitchen":                                ICANN,
+	"kiwi":                                   ICANN,
+	"koeln":                                  ICANN,
+	"komatsu":                                ICANN,
+	"kosher":                                 ICANN,
+	"kpmg":                                   ICANN,
+	"kpn":                                    ICANN,
+	"krd":                                    ICANN,
+	"kred":                                   ICANN,
+	"kuokgroup":                              ICANN,
+	"kyoto":                                  ICANN,
+	"lacaixa":                                ICANN,
+	"lamborghini":                            ICANN,
+	"lamer":                                  ICANN,
+	"land":                                   ICANN,
+	"landrover":                              ICANN,
+	"lanxess":                                ICANN,
+	"lasalle":                                ICANN,
+	"lat":                                    ICANN,
+	"latino":                                 ICANN,
+	"latrobe":                                ICANN,
+	"law":                                    ICANN,
+	"lawyer":                                 ICANN,
+	"lds":                                    ICANN,
+	"lease":                                  ICANN,
+	"leclerc":                                ICANN,
+	"lefrak":                                 ICANN,
+	"legal":                                  ICANN,
+	"lego":                                   ICANN,
+	"lexus":                                  ICANN,
+	"lgbt":                                   ICANN,
+	"lidl":                                   ICANN,
+	"life":                                   ICANN,
+	"lifeinsurance":                          ICANN,
+	"lifestyle":                              ICANN,
+	"lighting":                               ICANN,
+	"like":                                   ICANN,
+	"lilly":                                  ICANN,
+	"limited":                                ICANN,
+	"limo":                                   ICANN,
+	"lincoln":                                ICANN,
+	"link":                                   ICANN,
+	"live":                                   ICANN,
+	"living":                                 ICANN,
+	"llc":                                    ICANN,
+	"llp":                                    ICANN,
+	"loan":                                   ICANN,
+	"loans":                                  ICANN,
+	"locker":                                 ICANN,
+	"locus":                                  ICANN,
+	"lol":                                    ICANN,
+	"london":                                 ICANN,
+	"lotte":                                  ICANN,
+	"lotto":                                  ICANN,
+	"love":                                   ICANN,
+	"lpl":                                    ICANN,
+	"lplfinancial":                           ICANN,
+	"ltd":                                    ICANN,
+	"ltda":                                   ICANN,
+	"lundbeck":                               ICANN,
+	"luxe":                                   ICANN,
+	"luxury":                                 ICANN,
+	"madrid":                                 ICANN,
+	"maif":                                   ICANN,
+	"maison":                                 ICANN,
+	"makeup":                                 ICANN,
+	"man":                                    ICANN,
+	"management":                             ICANN,
+	"mango":                                  ICANN,
+	"map":                                    ICANN,
+	"market":                                 ICANN,
+	"marketing":                              ICANN,
+	"markets":                                ICANN,
+	"marriott":                               ICANN,
+	"marshalls":                              ICANN,
+	"mattel":                                 ICANN,
+	"mba":                                    ICANN,
+	"mckinsey":                               ICANN,
+	"med":                                    ICANN,
+	"media":                                  ICANN,
+	"meet":                                   ICANN,
+	"melbourne":                              ICANN,
+	"meme":                                   ICANN,
+	"memorial":                               ICANN,
+	"men":                                    ICANN,
+	"menu":                                   ICANN,
+	"merck":                                  ICANN,
+	"merckmsd":                               ICANN,
+	"miami":                                  ICANN,
+	"microsoft":                              ICANN,
+	"mini":                                   ICANN,
+	"mint":                                   ICANN,
+	"mit":                                    ICANN,
+	"mitsubishi":                             ICANN,
+	"mlb":                                    ICANN,
+	"mls":                                    ICANN,
+	"mma":                                    ICANN,
+	"mobile":                                 ICANN,
+	"moda":                                   ICANN,
+	"moe":                                    ICANN,
+	"moi":                                    ICANN,
+	"mom":                                    ICANN,
+	"monash":                                 ICANN,
+	"money":                                  ICANN,
+	"monster":                                ICANN,
+	"mormon":                                 ICANN,
+	"mortgage":                               ICANN,
+	"moscow":                                 ICANN,
+	"moto":                                   ICANN,
+	"motorcycles":                            ICANN,
+	"mov":                                    ICANN,
+	"movie":                                  ICANN,
+	"msd":                                    ICANN,
+	"mtn":                                    ICANN,
+	"mtr":                                    ICANN,
+	"music":                                  ICANN,
+	"nab":                                    ICANN,
+	"nagoya":                                 ICANN,
+	"navy":                                   ICANN,
+	"nba":                                    ICANN,
+	"nec":                                    ICANN,
+	"netbank":                                ICANN,
+	"netflix":                                ICANN,
+	"network":                                ICANN,
+	"neustar":                                ICANN,
+	"new":                                    ICANN,
+	"news":                                   ICANN,
+	"next":                                   ICANN,
+	"nextdirect":                             ICANN,
+	"nexus":                                  ICANN,
+	"nfl":                                    ICANN,
+	"ngo":                                    ICANN,
+	"nhk":                                    ICANN,
+	"nico":                                   ICANN,
+	"nike":                                   ICANN,
+	"nikon":                                  ICANN,
+	"ninja":                                  ICANN,
+	"nissan":                                 ICANN,
+	"nissay":                                 ICANN,
+	"nokia":                                  ICANN,
+	"norton":                                 ICANN,
+	"now":                                    ICANN,
+	"nowruz":                                 ICANN,
+	"nowtv":                                  ICANN,
+	"nra":                                    ICANN,
+	"nrw":                                    ICANN,
+	"ntt":                                    ICANN,
+	"nyc":                                    ICANN,
+	"obi":                                    ICANN,
+	"observer":                               ICANN,
+	"office":                                 ICANN,
+	"okinawa":                                ICANN,
+	"olayan":                                 ICANN,
+	"olayangroup":                            ICANN,
+	"ollo":                                   ICANN,
+	"omega":                                  ICANN,
+	"one":                                    ICANN,
+	"ong":                                    ICANN,
+	"onl":                                    ICANN,
+	"online":                                 ICANN,
+	"ooo":                                    ICANN,
+	"open":                                   ICANN,
+	"oracle":                                 ICANN,
+	"orange":                                 ICANN,
+	"organic":                                ICANN,
+	"origins":                                ICANN,
+	"osaka":                                  ICANN,
+	"otsuka":                                 ICANN,
+	"ott":                                    ICANN,
+	"ovh":                                    ICANN,
+	"page":                                   ICANN,
+	"panasonic":                              ICANN,
+	"paris":                                  ICANN,
+	"pars":                                   ICANN,
+	"partners":                               ICANN,
+	"parts":                                  ICANN,
+	"party":                                  ICANN,
+	"pay":                                    ICANN,
+	"pccw":                                   ICANN,
+	"pet":                                    ICANN,
+	"pfizer":                                 ICANN,
+	"pharmacy":                               ICANN,
+	"phd":                                    ICANN,
+	"philips":                                ICANN,
+	"phone":                                  ICANN,
+	"photo":                                  ICANN,
+	"photography":                            ICANN,
+	"photos":                                 ICANN,
+	"physio":                                 ICANN,
+	"pics":                                   ICANN,
+	"pictet":                                 ICANN,
+	"pictures":                               ICANN,
+	"pid":                                    ICANN,
+	"pin":                                    ICANN,
+	"ping":                                   ICANN,
+	"pink":                                   ICANN,
+	"pioneer":                                ICANN,
+	"pizza":                                  ICANN,
+	"place":                                  ICANN,
+	"play":                                   ICANN,
+	"playstation":                            ICANN,
+	"plumbing":                               ICANN,
+	"plus":                                   ICANN,
+	"pnc":                                    ICANN,
+	"pohl":                                   ICANN,
+	"poker":                                  ICANN,
+	"politie":                                ICANN,
+	"porn":                                   ICANN,
+	"praxi":                                  ICANN,
+	"press":                                  ICANN,
+	"prime":                                  ICANN,
+	"prod":                                   ICANN,
+	"productions":                            ICANN,
+	"prof":                                   ICANN,
+	"progressive":                            ICANN,
+	"promo":                                  ICANN,
+	"properties":                             ICANN,
+	"property":                               ICANN,
+	"protection":                             ICANN,
+	"pru":                                    ICANN,
+	"prudential":                             ICANN,
+	"pub":                                    ICANN,
+	"pwc":                                    ICANN,
+	"qpon":                                   ICANN,
+	"quebec":                                 ICANN,
+	"quest":                                  ICANN,
+	"racing":                                 ICANN,
+	"radio":                                  ICANN,
+	"read":                                   ICANN,
+	"realestate":                             ICANN,
+	"realtor":                                ICANN,
+	"realty":                                 ICANN,
+	"recipes":                                ICANN,
+	"red":                                    ICANN,
+	"redumbrella":                            ICANN,
+	"rehab":                                  ICANN,
+	"reise":                                  ICANN,
+	"reisen":                                 ICANN,
+	"reit":                                   ICANN,
+	"reliance":                               ICANN,
+	"ren":                                    ICANN,
+	"rent":                                   ICANN,
+	"rentals":                                ICANN,
+	"repair":                                 ICANN,
+	"report":                                 ICANN,
+	"republican":                             ICANN,
+	"rest":                                   ICANN,
+	"restaurant":                             ICANN,
+	"review":                                 ICANN,
+	"reviews":                                ICANN,
+	"rexroth":                                ICANN,
+	"rich":                                   ICANN,
+	"richardli":                              ICANN,
+	"ricoh":                                  ICANN,
+	"ril":                                    ICANN,
+	"rio":                                    ICANN,
+	"rip":                                    ICANN,
+	"rocks":                                  ICANN,
+	"rodeo":                                  ICANN,
+	"rogers":                                 ICANN,
+	"room":                                   ICANN,
+	"rsvp":                                   ICANN,
+	"rugby":                                  ICANN,
+	"ruhr":                                   ICANN,
+	"run":                                    ICANN,
+	"rwe":                                    ICANN,
+	"ryukyu":                                 ICANN,
+	"saarland":                               ICANN,
+	"safe":                                   ICANN,
+	"safety":                                 ICANN,
+	"sakura":                                 ICANN,
+	"sale":                                   ICANN,
+	"salon":                                  ICANN,
+	"samsclub":                               ICANN,
+	"samsung":                                ICANN,
+	"sandvik":                                ICANN,
+	"sandvikcoromant":                        ICANN,
+	"sanofi":                                 ICANN,
+	"sap":                                    ICANN,
+	"sarl":                                   ICANN,
+	"sas":                                    ICANN,
+	"save":                                   ICANN,
+	"saxo":                                   ICANN,
+	"sbi":                                    ICANN,
+	"sbs":                                    ICANN,
+	"scb":                                    ICANN,
+	"schaeffler":                             ICANN,
+	"schmidt":                                ICANN,
+	"scholarships":                           ICANN,
+	"school":                                 ICANN,
+	"schule":                                 ICANN,
+	"schwarz":                                ICANN,
+	"science":                                ICANN,
+	"scot":                                   ICANN,
+	"search":                                 ICANN,
+	"seat":                                   ICANN,
+	"secure":                                 ICANN,
+	"security":                               ICANN,
+	"seek":                                   ICANN,
+	"select":                                 ICANN,
+	"sener":                                  ICANN,
+	"services":                               ICANN,
+	"seven":                                  ICANN,
+	"sew":                                    ICANN,
+	"sex":                                    ICANN,
+	"sexy":                                   ICANN,
+	"sfr":                                    ICANN,
+	"shangrila":                              ICANN,
+	"sharp":                                  ICANN,
+	"shell":                                  ICANN,
+	"shia":                                   ICANN,
+	"shiksha":                                ICANN,
+	"shoes":                                  ICANN,
+	"shop":                                   ICANN,
+	"shopping":                               ICANN,
+	"shouji":                                 ICANN,
+	"show":                                   ICANN,
+	"silk":                                   ICANN,
+	"sina":                                   ICANN,
+	"singles":                                ICANN,
+	"site":                                   ICANN,
+	"ski":                                    ICANN,
+	"skin":                                   ICANN,
+	"sky":                                    ICANN,
+	"skype":                                  ICANN,
+	"sling":                                  ICANN,
+	"smart":                                  ICANN,
+	"smile":                                  ICANN,
+	"sncf":                                   ICANN,
+	"soccer":                                 ICANN,
+	"social":                                 ICANN,
+	"softbank":                               ICANN,
+	"software":                               ICANN,
+	"sohu":                                   ICANN,
+	"solar":                                  ICANN,
+	"solutions":                              ICANN,
+	"song":                                   ICANN,
+	"sony":                                   ICANN,
+	"soy":                                    ICANN,
+	"spa":                                    ICANN,
+	"space":                                  ICANN,
+	"sport":                                  ICANN,
+	"spot":                                   ICANN,
+	"srl":                                    ICANN,
+	"stada":                                  ICANN,
+	"staples":                                ICANN,
+	"star":                                   ICANN,
+	"statebank":                              ICANN,
+	"statefarm":                              ICANN,
+	"stc":                                    ICANN,
+	"stcgroup":                               ICANN,
+	"stockholm":                              ICANN,
+	"storage":                                ICANN,
+	"store":                                  ICANN,
+	"stream":                                 ICANN,
+	"studio":                                 ICANN,
+	"study":                                  ICANN,
+	"style":                                  ICANN,
+	"sucks":                                  ICANN,
+	"supplies":                               ICANN,
+	"supply":                                 ICANN,
+	"support":                                ICANN,
+	"surf":                                   ICANN,
+	"surgery":                                ICANN,
+	"suzuki":                                 ICANN,
+	"swatch":                                 ICANN,
+	"swiss":                                  ICANN,
+	"sydney":                                 ICANN,
+	"systems":                                ICANN,
+	"tab":                                    ICANN,
+	"taipei":                                 ICANN,
+	"talk":                                   ICANN,
+	"taobao":                                 ICANN,
+	"target":                                 ICANN,
+	"tatamotors":                             ICANN,
+	"tatar":                                  ICANN,
+	"tattoo":                                 ICANN,
+	"tax":                                    ICANN,
+	"taxi":                                   ICANN,
+	"tci":                                    ICANN,
+	"tdk":                                    ICANN,
+	"team":                                   ICANN,
+	"tech":                                   ICANN,
+	"technology":                             ICANN,
+	"temasek":                                ICANN,
+	"tennis":                                 ICANN,
+	"teva":                                   ICANN,
+	"thd":                                    ICANN,
+	"theater":                                ICANN,
+	"theatre":                                ICANN,
+	"tiaa":                                   ICANN,
+	"tickets":                                ICANN,
+	"tienda":                                 ICANN,
+	"tips":                                   ICANN,
+	"tires":                                  ICANN,
+	"tirol":                                  ICANN,
+	"tjmaxx":                                 ICANN,
+	"tjx":                                    ICANN,
+	"tkmaxx":                                 ICANN,
+	"tmall":                                  ICANN,
+	"today":                                  ICANN,
+	"tokyo":                                  ICANN,
+	"tools":                                  ICANN,
+	"top":                                    ICANN,
+	"toray":                                  ICANN,
+	"toshiba":                                ICANN,
+	"total":                                  ICANN,
+	"tours":                                  ICANN,
+	"town":                                   ICANN,
+	"toyota":                                 ICANN,
+	"toys":                                   ICANN,
+	"trade":                                  ICANN,
+	"trading":                                ICANN,
+	"training":                               ICANN,
+	"travel":                                 ICANN,
+	"travelers":                              ICANN,
+	"travelersinsurance":                     ICANN,
+	"trust":                                  ICANN,
+	"trv":                                    ICANN,
+	"tube":                                   ICANN,
+	"tui":                                    ICANN,
+	"tunes":                                  ICANN,
+	"tushu":                                  ICANN,
+	"tvs":                                    ICANN,
+	"ubank":                                  ICANN,
+	"ubs":                                    ICANN,
+	"unicom":                                 ICANN,
+	"university":                             ICANN,
+	"uno":                                    ICANN,
+	"uol":                                    ICANN,
+	"ups":                                    ICANN,
+	"vacations":                              ICANN,
+	"vana":                                   ICANN,
+	"vanguard":                               ICANN,
+	"vegas":                                  ICANN,
+	"ventures":                               ICANN,
+	"verisign":                               ICANN,
+	"versicherung":                           ICANN,
+	"vet":                                    ICANN,
+	"viajes":                                 ICANN,
+	"video":                                  ICANN,
+	"vig":                                    ICANN,
+	"viking":                                 ICANN,
+	"villas":                                 ICANN,
+	"vin":                                    ICANN,
+	"vip":                                    ICANN,
+	"virgin":                                 ICANN,
+	"visa":                                   ICANN,
+	"vision":                                 ICANN,
+	"viva":                                   ICANN,
+	"vivo":                                   ICANN,
+	"vlaanderen":                             ICANN,
+	"vodka":                                  ICANN,
+	"volvo":                                  ICANN,
+	"vote":                                   ICANN,
+	"voting":                                 ICANN,
+	"voto":                                   ICANN,
+	"voyage":                                 ICANN,
+	"wales":                                  ICANN,
+	"walmart":                                ICANN,
+	"walter":                                 ICANN,
+	"wang":                                   ICANN,
+	"wanggou":                                ICANN,
+	"watch":                                  ICANN,
+	"watches":                                ICANN,
+	"weather":                                ICANN,
+	"weatherchannel":                         ICANN,
+	"webcam":                                 ICANN,
+	"weber":                                  ICANN,
+	"website":                                ICANN,
+	"wed":                                    ICANN,
+	"wedding":                                ICANN,
+	"weibo":                                  ICANN,
+	"weir":                                   ICANN,
+	"whoswho":                                ICANN,
+	"wien":                                   ICANN,
+	"wiki":                                   ICANN,
+	"williamhill":                            ICANN,
+	"win":                                    ICANN,
+	"windows":                                ICANN,
+	"wine":                                   ICANN,
+	"winners":                                ICANN,
+	"wme":                                    ICANN,
+	"woodside":                               ICANN,
+	"work":                                   ICANN,
+	"works":                                  ICANN,
+	"world":                                  ICANN,
+	"wow":                                    ICANN,
+	"wtc":                                    ICANN,
+	"wtf":                                    ICANN,
+	"xbox":                                   ICANN,
+	"xerox":                                  ICANN,
+	"xihuan":                                 ICANN,
+	"xin":                                    ICANN,
+	"xn--11b4c3d":                            ICANN,
+	"xn--1ck2e1b":                            ICANN,
+	"xn--1qqw23a":                            ICANN,
+	"xn--30rr7y":                             ICANN,
+	"xn--3bst00m":                            ICANN,
+	"xn--3ds443g":                            ICANN,
+	"xn--3pxu8k":                             ICANN,
+	"xn--42c2d9a":                            ICANN,
+	"xn--45q11c":                             ICANN,
+	"xn--4gbrim":                             ICANN,
+	"xn--55qw42g":                            ICANN,
+	"xn--55qx5d":                             ICANN,
+	"xn--5su34j936bgsg":                      ICANN,
+	"xn--5tzm5g":                             ICANN,
+	"xn--6frz82g":                            ICANN,
+	"xn--6qq986b3xl":                         ICANN,
+	"xn--80adxhks":                           ICANN,
+	"xn--80aqecdr1a":                         ICANN,
+	"xn--80asehdb":                           ICANN,
+	"xn--80aswg":                             ICANN,
+	"xn--8y0a063a":                           ICANN,
+	"xn--9dbq2a":                             ICANN,
+	"xn--9et52u":                             ICANN,
+	"xn--9krt00a":                            ICANN,
+	"xn--b4w605ferd":                         ICANN,
+	"xn--bck1b9a5dre4c":                      ICANN,
+	"xn--c1avg":                              ICANN,
+	"xn--c2br7g":                             ICANN,
+	"xn--cck2b3b":                            ICANN,
+	"xn--cckwcxetd":                          ICANN,
+	"xn--cg4bki":                             ICANN,
+	"xn--czr694b":                            ICANN,
+	"xn--czrs0t":                             ICANN,
+	"xn--czru2d":                             ICANN,
+	"xn--d1acj3b":                            ICANN,
+	"xn--eckvdtc9d":                          ICANN,
+	"xn--efvy88h":                            ICANN,
+	"xn--fct429k":                            ICANN,
+	"xn--fhbei":                              ICANN,
+	"xn--fiq228c5hs":                         ICANN,
+	"xn--fiq64b":                             ICANN,
+	"xn--fjq720a":                            ICANN,
+	"xn--flw351e":                            ICANN,
+	"xn--fzys8d69uvgm":                       ICANN,
+	"xn--g2xx48c":                            ICANN,
+	"xn--gckr3f0f":                           ICANN,
+	"xn--gk3at1e":                            ICANN,
+	"xn--hxt814e":                            ICANN,
+	"xn--i1b6b1a6a2e":                        ICANN,
+	"xn--imr513n":                            ICANN,
+	"xn--io0a7i":                             ICANN,
+	"xn--j1aef":                              ICANN,
+	"xn--jlq480n2rg":                         ICANN,
+	"xn--jvr189m":                            ICANN,
+	"xn--kcrx77d1x4a":                        ICANN,
+	"xn--kput3i":                             ICANN,
+	"xn--mgba3a3ejt":                         ICANN,
+	"xn--mgba7c0bbn0a":                       ICANN,
+	"xn--mgbab2bd":                           ICANN,
+	"xn--mgbca7dzdo":                         ICANN,
+	"xn--mgbi4ecexp":                         ICANN,
+	"xn--mgbt3dhd":                           ICANN,
+	"xn--mk1bu44c":                           ICANN,
+	"xn--mxtq1m":                             ICANN,
+	"xn--ngbc5azd":                           ICANN,
+	"xn--ngbe9e0a":                           ICANN,
+	"xn--ngbrx":                              ICANN,
+	"xn--nqv7f":                              ICANN,
+	"xn--nqv7fs00ema":                        ICANN,
+	"xn--nyqy26a":                            ICANN,
+	"xn--otu796d":                            ICANN,
+	"xn--p1acf":                              ICANN,
+	"xn--pssy2u":                             ICANN,
+	"xn--q9jyb4c":                            ICANN,
+	"xn--qcka1pmc":                           ICANN,
+	"xn--rhqv96g":                            ICANN,
+	"xn--rovu88b":                            ICANN,
+	"xn--ses554g":                            ICANN,
+	"xn--t60b56a":                            ICANN,
+	"xn--tckwe":                              ICANN,
+	"xn--tiq49xqyj":                          ICANN,
+	"xn--unup4y":                             ICANN,
+	"xn--vermgensberater-ctb":                ICANN,
+	"xn--vermgensberatung-pwb":               ICANN,
+	"xn--vhquv":                              ICANN,
+	"xn--vuq861b":                            ICANN,
+	"xn--w4r85el8fhu5dnra":                   ICANN,
+	"xn--w4rs40l":                            ICANN,
+	"xn--xhq521b":                            ICANN,
+	"xn--zfr164b":                            ICANN,
+	"xyz":                                    ICANN,
+	"yachts":                                 ICANN,
+	"yahoo":                                  ICANN,
+	"yamaxun":                                ICANN,
+	"yandex":                                 ICANN,
+	"yodobashi":                              ICANN,
+	"yoga":                                   ICANN,
+	"yokohama":                               ICANN,
+	"you":                                    ICANN,
+	"youtube":                                ICANN,
+	"yun":                                    ICANN,
+	"zappos":                                 ICANN,
+	"zara":                                   ICANN,
+	"zero":                                   ICANN,
+	"zip":                                    ICANN,
+	"zone":                                   ICANN,
+	"zuerich":                                ICANN,
+	"co.krd":                                 Private,
+	"edu.krd":                                Private,
+	"art.pl":                                 Private,
+	"gliwice.pl":                             Private,
+	"krakow.pl":                              Private,
+	"poznan.pl":                              Private,
+	"wroc.pl":                                Private,
+	"zakopane.pl":                            Private,
+	"cc.ua":                                  Private,
+	"inf.ua":                                 Private,
+	"ltd.ua":                                 Private,
+	"611.to":                                 Private,
+	"a2hosted.com":                           Private,
+	"cpserver.com":                           Private,
+	"*.on-acorn.io":                          Private,
+	"activetrail.biz":                        Private,
+	"adaptable.app":                          Private,
+	"myaddr.dev":                             Private,
+	"myaddr.io":                              Private,
+	"dyn.addr.tools":                         Private,
+	"myaddr.tools":                           Private,
+	"adobeaemcloud.com":                      Private,
+	"*.dev.adobeaemcloud.com":                Private,
+	"aem.live":                               Private,
+	"hlx.live":                               Private,
+	"adobeaemcloud.net":                      Private,
+	"aem.network":                            Private,
+	"aem.page":                               Private,
+	"hlx.page":                               Private,
+	"aem.reviews":                            Private,
+	"adobeio-static.net":                     Private,
+	"adobeioruntime.net":                     Private,
+	"africa.com":                             Private,
+	"*.auiusercontent.com":                   Private,
+	"beep.pl":                                Private,
+	"aiven.app":                              Private,
+	"aivencloud.com":                         Private,
+	"akadns.net":                             Private,
+	"akamai.net":                             Private,
+	"akamai-staging.net":                     Private,
+	"akamaiedge.net":                         Private,
+	"akamaiedge-staging.net":                 Private,
+	"akamaihd.net":                           Private,
+	"akamaihd-staging.net":                   Private,
+	"akamaiorigin.net":                       Private,
+	"akamaiorigin-staging.net":               Private,
+	"akamaized.net":                          Private,
+	"akamaized-staging.net":                  Private,
+	"edgekey.net":                            Private,
+	"edgekey-staging.net":                    Private,
+	"edgesuite.net":                          Private,
+	"edgesuite-staging.net":                  Private,
+	"barsy.ca":                               Private,
+	"*.compute.estate":                       Private,
+	"*.alces.network":                        Private,
+	"alibabacloudcs.com":                     Private,
+	"ms.fun":                                 Private,
+	"ms.show":                                Private,
+	"kasserver.com":                          Private,
+	"altervista.org":                         Private,
+	"alwaysdata.net":                         Private,
+	"myamaze.net":                            Private,
+	"execute-api.cn-north-1.amazonaws.com.cn":                   Private,
+	"execute-api.cn-northwest-1.amazonaws.com.cn":               Private,
+	"execute-api.af-south-1.amazonaws.com":                      Private,
+	"execute-api.ap-east-1.amazonaws.com":                       Private,
+	"execute-api.ap-northeast-1.amazonaws.com":                  Private,
+	"execute-api.ap-northeast-2.amazonaws.com":                  Private,
+	"execute-api.ap-northeast-3.amazonaws.com":                  Private,
+	"execute-api.ap-south-1.amazonaws.com":                      Private,
+	"execute-api.ap-south-2.amazonaws.com":                      Private,
+	"execute-api.ap-southeast-1.amazonaws.com":                  Private,
+	"execute-api.ap-southeast-2.amazonaws.com":                  Private,
+	"execute-api.ap-southeast-3.amazonaws.com":                  Private,
+	"execute-api.ap-southeast-4.amazonaws.com":                  Private,
+	"execute-api.ap-southeast-5.amazonaws.com":                  Private,
+	"execute-api.ca-central-1.amazonaws.com":                    Private,
+	"execute-api.ca-west-1.amazonaws.com":                       Private,
+	"execute-api.eu-central-1.amazonaws.com":                    Private,
+	"execute-api.eu-central-2.amazonaws.com":                    Private,
+	"execute-api.eu-north-1.amazonaws.com":                      Private,
+	"execute-api.eu-south-1.amazonaws.com":                      Private,
+	"execute-api.eu-south-2.amazonaws.com":                      Private,
+	"execute-api.eu-west-1.amazonaws.com":                       Private,
+	"execute-api.eu-west-2.amazonaws.com":                       Private,
+	"execute-api.eu-west-3.amazonaws.com":                       Private,
+	"execute-api.il-central-1.amazonaws.com":                    Private,
+	"execute-api.me-central-1.amazonaws.com":                    Private,
+	"execute-api.me-south-1.amazonaws.com":                      Private,
+	"execute-api.sa-east-1.amazonaws.com":                       Private,
+	"execute-api.us-east-1.amazonaws.com":                       Private,
+	"execute-api.us-east-2.amazonaws.com":                       Private,
+	"execute-api.us-gov-east-1.amazonaws.com":                   Private,
+	"execute-api.us-gov-west-1.amazonaws.com":                   Private,
+	"execute-api.us-west-1.amazonaws.com":                       Private,
+	"execute-api.us-west-2.amazonaws.com":                       Private,
+	"cloudfront.net":                                            Private,
+	"auth.af-south-1.amazoncognito.com":                         Private,
+	"auth.ap-east-1.amazoncognito.com":                          Private,
+	"auth.ap-northeast-1.amazoncognito.com":                     Private,
+	"auth.ap-northeast-2.amazoncognito.com":                     Private,
+	"auth.ap-northeast-3.amazoncognito.com":                     Private,
+	"auth.ap-south-1.amazoncognito.com":                         Private,
+	"auth.ap-south-2.amazoncognito.com":                         Private,
+	"auth.ap-southeast-1.amazoncognito.com":                     Private,
+	"auth.ap-southeast-2.amazoncognito.com":                     Private,
+	"auth.ap-southeast-3.amazoncognito.com":                     Private,
+	"auth.ap-southeast-4.amazoncognito.com":                     Private,
+	"auth.ap-southeast-5.amazoncognito.com":                     Private,
+	"auth.ap-southeast-7.amazoncognito.com":                     Private,
+	"auth.ca-central-1.amazoncognito.com":                       Private,
+	"auth.ca-west-1.amazoncognito.com":                          Private,
+	"auth.eu-central-1.amazoncognito.com":                       Private,
+	"auth.eu-central-2.amazoncognito.com":                       Private,
+	"auth.eu-north-1.amazoncognito.com":                         Private,
+	"auth.eu-south-1.amazoncognito.com":                         Private,
+	"auth.eu-south-2.amazoncognito.com":                         Private,
+	"auth.eu-west-1.amazoncognito.com":                          Private,
+	"auth.eu-west-2.amazoncognito.com":                          Private,
+	"auth.eu-west-3.amazoncognito.com":                          Private,
+	"auth.il-central-1.amazoncognito.com":                       Private,
+	"auth.me-central-1.amazoncognito.com":                       Private,
+	"auth.me-south-1.amazoncognito.com":                         Private,
+	"auth.mx-central-1.amazoncognito.com":                       Private,
+	"auth.sa-east-1.amazoncognito.com":                          Private,
+	"auth.us-east-1.amazoncognito.com":                          Private,
+	"auth-fips.us-east-1.amazoncognito.com":                     Private,
+	"auth.us-east-2.amazoncognito.com":                          Private,
+	"auth-fips.us-east-2.amazoncognito.com":                     Private,
+	"auth-fips.us-gov-east-1.amazoncognito.com":                 Private,
+	"auth-fips.us-gov-west-1.amazoncognito.com":                 Private,
+	"auth.us-west-1.amazoncognito.com":                          Private,
+	"auth-fips.us-west-1.amazoncognito.com":                     Private,
+	"auth.us-west-2.amazoncognito.com":                          Private,
+	"auth-fips.us-west-2.amazoncognito.com":                     Private,
+	"auth.cognito-idp.eusc-de-east-1.on.amazonwebservices.eu":   Private,
+	"*.compute.amazonaws.com.cn":                                Private,
+	"*.compute.amazonaws.com":                                   Private,
+	"*.compute-1.amazonaws.com":                                 Private,
+	"us-east-1.amazonaws.com":                                   Private,
+	"emrappui-prod.cn-north-1.amazonaws.com.cn":                 Private,
+	"emrnotebooks-prod.cn-north-1.amazonaws.com.cn":             Private,
+	"emrstudio-prod.cn-north-1.amazonaws.com.cn":                Private,
+	"emrappui-prod.cn-northwest-1.amazonaws.com.cn":             Private,
+	"emrnotebooks-prod.cn-northwest-1.amazonaws.com.cn":         Private,
+	"emrstudio-prod.cn-northwest-1.amazonaws.com.cn":            Private,
+	"emrappui-prod.af-south-1.amazonaws.com":                    Private,
+	"emrnotebooks-prod.af-south-1.amazonaws.com":                Private,
+	"emrstudio-prod.af-south-1.amazonaws.com":                   Private,
+	"emrappui-prod.ap-east-1.amazonaws.com":                     Private,
+	"emrnotebooks-prod.ap-east-1.amazonaws.com":                 Private,
+	"emrstudio-prod.ap-east-1.amazonaws.com":                    Private,
+	"emrappui-prod.ap-northeast-1.amazonaws.com":                Private,
+	"emrnotebooks-prod.ap-northeast-1.amazonaws.com":            Private,
+	"emrstudio-prod.ap-northeast-1.amazonaws.com":               Private,
+	"emrappui-prod.ap-northeast-2.amazonaws.com":                Private,
+	"emrnotebooks-prod.ap-northeast-2.amazonaws.com":            Private,
+	"emrstudio-prod.ap-northeast-2.amazonaws.com":               Private,
+	"emrappui-prod.ap-northeast-3.amazonaws.com":                Private,
+	"emrnotebooks-prod.ap-northeast-3.amazonaws.com":            Private,
+	"emrstudio-prod.ap-northeast-3.amazonaws.com":               Private,
+	"emrappui-prod.ap-south-1.amazonaws.com":                    Private,
+	"emrnotebooks-prod.ap-south-1.amazonaws.com":                Private,
+	"emrstudio-prod.ap-south-1.amazonaws.com":                   Private,
+	"emrappui-prod.ap-south-2.amazonaws.com":                    Private,
+	"emrnotebooks-prod.ap-south-2.amazonaws.com":                Private,
+	"emrstudio-prod.ap-south-2.amazonaws.com":                   Private,
+	"emrappui-prod.ap-southeast-1.amazonaws.com":                Private,
+	"emrnotebooks-prod.ap-southeast-1.amazonaws.com":            Private,
+	"emrstudio-prod.ap-southeast-1.amazonaws.com":               Private,
+	"emrappui-prod.ap-southeast-2.amazonaws.com":                Private,
+	"emrnotebooks-prod.ap-southeast-2.amazonaws.com":            Private,
+	"emrstudio-prod.ap-southeast-2.amazonaws.com":               Private,
+	"emrappui-prod.ap-southeast-3.amazonaws.com":                Private,
+	"emrnotebooks-prod.ap-southeast-3.amazonaws.com":            Private,
+	"emrstudio-prod.ap-southeast-3.amazonaws.com":               Private,
+	"emrappui-prod.ap-southeast-4.amazonaws.com":                Private,
+	"emrnotebooks-prod.ap-southeast-4.amazonaws.com":            Private,
+	"emrstudio-prod.ap-southeast-4.amazonaws.com":               Private,
+	"emrappui-prod.ca-central-1.amazonaws.com":                  Private,
+	"emrnotebooks-prod.ca-central-1.amazonaws.com":              Private,
+	"emrstudio-prod.ca-central-1.amazonaws.com":                 Private,
+	"emrappui-prod.ca-west-1.amazonaws.com":                     Private,
+	"emrnotebooks-prod.ca-west-1.amazonaws.com":                 Private,
+	"emrstudio-prod.ca-west-1.amazonaws.com":                    Private,
+	"emrappui-prod.eu-central-1.amazonaws.com":                  Private,
+	"emrnotebooks-prod.eu-central-1.amazonaws.com":              Private,
+	"emrstudio-prod.eu-central-1.amazonaws.com":                 Private,
+	"emrappui-prod.eu-central-2.amazonaws.com":                  Private,
+	"emrnotebooks-prod.eu-central-2.amazonaws.com":              Private,
+	"emrstudio-prod.eu-central-2.amazonaws.com":                 Private,
+	"emrappui-prod.eu-north-1.amazonaws.com":                    Private,
+	"emrnotebooks-prod.eu-north-1.amazonaws.com":                Private,
+	"emrstudio-prod.eu-north-1.amazonaws.com":                   Private,
+	"emrappui-prod.eu-south-1.amazonaws.com":                    Private,
+	"emrnotebooks-prod.eu-south-1.amazonaws.com":                Private,
+	"emrstudio-prod.eu-south-1.amazonaws.com":                   Private,
+	"emrappui-prod.eu-south-2.amazonaws.com":                    Private,
+	"emrnotebooks-prod.eu-south-2.amazonaws.com":                Private,
+	"emrstudio-prod.eu-south-2.amazonaws.com":                   Private,
+	"emrappui-prod.eu-west-1.amazonaws.com":                     Private,
+	"emrnotebooks-prod.eu-west-1.amazonaws.com":                 Private,
+	"emrstudio-prod.eu-west-1.amazonaws.com":                    Private,
+	"emrappui-prod.eu-west-2.amazonaws.com":                     Private,
+	"emrnotebooks-prod.eu-west-2.amazonaws.com":                 Private,
+	"emrstudio-prod.eu-west-2.amazonaws.com":                    Private,
+	"emrappui-prod.eu-west-3.amazonaws.com":                     Private,
+	"emrnotebooks-prod.eu-west-3.amazonaws.com":                 Private,
+	"emrstudio-prod.eu-west-3.amazonaws.com":                    Private,
+	"emrappui-prod.il-central-1.amazonaws.com":                  Private,
+	"emrnotebooks-prod.il-central-1.amazonaws.com":              Private,
+	"emrstudio-prod.il-central-1.amazonaws.com":                 Private,
+	"emrappui-prod.me-central-1.amazonaws.com":                  Private,
+	"emrnotebooks-prod.me-central-1.amazonaws.com":              Private,
+	"emrstudio-prod.me-central-1.amazonaws.com":                 Private,
+	"emrappui-prod.me-south-1.amazonaws.com":                    Private,
+	"emrnotebooks-prod.me-south-1.amazonaws.com":                Private,
+	"emrstudio-prod.me-south-1.amazonaws.com":                   Private,
+	"emrappui-prod.sa-east-1.amazonaws.com":                     Private,
+	"emrnotebooks-prod.sa-east-1.amazonaws.com":                 Private,
+	"emrstudio-prod.sa-east-1.amazonaws.com":                    Private,
+	"emrappui-prod.us-east-1.amazonaws.com":                     Private,
+	"emrnotebooks-prod.us-east-1.amazonaws.com":                 Private,
+	"emrstudio-prod.us-east-1.amazonaws.com":                    Private,
+	"emrappui-prod.us-east-2.amazonaws.com":                     Private,
+	"emrnotebooks-prod.us-east-2.amazonaws.com":                 Private,
+	"emrstudio-prod.us-east-2.amazonaws.com":                    Private,
+	"emrappui-prod.us-gov-east-1.amazonaws.com":                 Private,
+	"emrnotebooks-prod.us-gov-east-1.amazonaws.com":             Private,
+	"emrstudio-prod.us-gov-east-1.amazonaws.com":                Private,
+	"emrappui-prod.us-gov-west-1.amazonaws.com":                 Private,
+	"emrnotebooks-prod.us-gov-west-1.amazonaws.com":             Private,
+	"emrstudio-prod.us-gov-west-1.amazonaws.com":                Private,
+	"emrappui-prod.us-west-1.amazonaws.com":                     Private,
+	"emrnotebooks-prod.us-west-1.amazonaws.com":                 Private,
+	"emrstudio-prod.us-west-1.amazonaws.com":                    Private,
+	"emrappui-prod.us-west-2.amazonaws.com":                     Private,
+	"emrnotebooks-prod.us-west-2.amazonaws.com":                 Private,
+	"emrstudio-prod.us-west-2.amazonaws.com":                    Private,
+	"*.airflow.af-south-1.on.aws":                               Private,
+	"*.airflow.ap-east-1.on.aws":                                Private,
+	"*.airflow.ap-northeast-1.on.aws":                           Private,
+	"*.airflow.ap-northeast-2.on.aws":                           Private,
+	"*.airflow.ap-northeast-3.on.aws":                           Private,
+	"*.airflow.ap-south-1.on.aws":                               Private,
+	"*.airflow.ap-south-2.on.aws":                               Private,
+	"*.airflow.ap-southeast-1.on.aws":                           Private,
+	"*.airflow.ap-southeast-2.on.aws":                           Private,
+	"*.airflow.ap-southeast-3.on.aws":                           Private,
+	"*.airflow.ap-southeast-4.on.aws":                           Private,
+	"*.airflow.ap-southeast-5.on.aws":                           Private,
+	"*.airflow.ca-central-1.on.aws":                             Private,
+	"*.airflow.ca-west-1.on.aws":                                Private,
+	"*.airflow.eu-central-1.on.aws":                             Private,
+	"*.airflow.eu-central-2.on.aws":                             Private,
+	"*.airflow.eu-north-1.on.aws":                               Private,
+	"*.airflow.eu-south-1.on.aws":                               Private,
+	"*.airflow.eu-south-2.on.aws":                               Private,
+	"*.airflow.eu-west-1.on.aws":                                Private,
+	"*.airflow.eu-west-2.on.aws":                                Private,
+	"*.airflow.eu-west-3.on.aws":                                Private,
+	"*.airflow.il-central-1.on.aws":                             Private,
+	"*.airflow.me-central-1.on.aws":                             Private,
+	"*.airflow.me-south-1.on.aws":                               Private,
+	"*.airflow.sa-east-1.on.aws":                                Private,
+	"*.airflow.us-east-1.on.aws":                                Private,
+	"*.airflow.us-east-2.on.aws":                                Private,
+	"*.airflow.us-west-1.on.aws":                                Private,
+	"*.airflow.us-west-2.on.aws":                                Private,
+	"*.cn-north-1.airflow.amazonaws.com.cn":                     Private,
+	"*.cn-northwest-1.airflow.amazonaws.com.cn":                 Private,
+	"*.airflow.cn-north-1.on.amazonwebservices.com.cn":          Private,
+	"*.airflow.cn-northwest-1.on.amazonwebservices.com.cn":      Private,
+	"*.af-south-1.airflow.amazonaws.com":                        Private,
+	"*.ap-east-1.airflow.amazonaws.com":                         Private,
+	"*.ap-northeast-1.airflow.amazonaws.com":                    Private,
+	"*.ap-northeast-2.airflow.amazonaws.com":                    Private,
+	"*.ap-northeast-3.airflow.amazonaws.com":                    Private,
+	"*.ap-south-1.airflow.amazonaws.com":                        Private,
+	"*.ap-south-2.airflow.amazonaws.com":                        Private,
+	"*.ap-southeast-1.airflow.amazonaws.com":                    Private,
+	"*.ap-southeast-2.airflow.amazonaws.com":                    Private,
+	"*.ap-southeast-3.airflow.amazonaws.com":                    Private,
+	"*.ap-southeast-4.airflow.amazonaws.com":                    Private,
+	"*.ap-southeast-5.airflow.amazonaws.com":                    Private,
+	"*.ap-southeast-7.airflow.amazonaws.com":                    Private,
+	"*.ca-central-1.airflow.amazonaws.com":                      Private,
+	"*.ca-west-1.airflow.amazonaws.com":                         Private,
+	"*.eu-central-1.airflow.amazonaws.com":                      Private,
+	"*.eu-central-2.airflow.amazonaws.com":                      Private,
+	"*.eu-north-1.airflow.amazonaws.com":                        Private,
+	"*.eu-south-1.airflow.amazonaws.com":                        Private,
+	"*.eu-south-2.airflow.amazonaws.com":                        Private,
+	"*.eu-west-1.airflow.amazonaws.com":                         Private,
+	"*.eu-west-2.airflow.amazonaws.com":                         Private,
+	"*.eu-west-3.airflow.amazonaws.com":                         Private,
+	"*.il-central-1.airflow.amazonaws.com":                      Private,
+	"*.me-central-1.airflow.amazonaws.com":                      Private,
+	"*.me-south-1.airflow.amazonaws.com":                        Private,
+	"*.sa-east-1.airflow.amazonaws.com":                         Private,
+	"*.us-east-1.airflow.amazonaws.com":                         Private,
+	"*.us-east-2.airflow.amazonaws.com":                         Private,
+	"*.us-west-1.airflow.amazonaws.com":                         Private,
+	"*.us-west-2.airflow.amazonaws.com":                         Private,
+	"*.rds.cn-north-1.amazonaws.com.cn":                         Private,
+	"*.rds.cn-northwest-1.amazonaws.com.cn":                     Private,
+	"*.af-south-1.rds.amazonaws.com":                            Private,
+	"*.ap-east-1.rds.amazonaws.com":                             Private,
+	"*.ap-east-2.rds.amazonaws.com":                             Private,
+	"*.ap-northeast-1.rds.amazonaws.com":                        Private,
+	"*.ap-northeast-2.rds.amazonaws.com":                        Private,
+	"*.ap-northeast-3.rds.amazonaws.com":                        Private,
+	"*.ap-south-1.rds.amazonaws.com":                            Private,
+	"*.ap-south-2.rds.amazonaws.com":                            Private,
+	"*.ap-southeast-1.rds.amazonaws.com":                        Private,
+	"*.ap-southeast-2.rds.amazonaws.com":                        Private,
+	"*.ap-southeast-3.rds.amazonaws.com":                        Private,
+	"*.ap-southeast-4.rds.amazonaws.com":                        Private,
+	"*.ap-southeast-5.rds.amazonaws.com":                        Private,
+	"*.ap-southeast-6.rds.amazonaws.com":                        Private,
+	"*.ap-southeast-7.rds.amazonaws.com":                        Private,
+	"*.ca-central-1.rds.amazonaws.com":                          Private,
+	"*.ca-west-1.rds.amazonaws.com":                             Private,
+	"*.eu-central-1.rds.amazonaws.com":                          Private,
+	"*.eu-central-2.rds.amazonaws.com":                          Private,
+	"*.eu-west-1.rds.amazonaws.com":                             Private,
+	"*.eu-west-2.rds.amazonaws.com":                             Private,
+	"*.eu-west-3.rds.amazonaws.com":                             Private,
+	"*.il-central-1.rds.amazonaws.com":                          Private,
+	"*.me-central-1.rds.amazonaws.com":                          Private,
+	"*.me-south-1.rds.amazonaws.com":                            Private,
+	"*.mx-central-1.rds.amazonaws.com":                          Private,
+	"*.sa-east-1.rds.amazonaws.com":                             Private,
+	"*.us-east-1.rds.amazonaws.com":                             Private,
+	"*.us-east-2.rds.amazonaws.com":                             Private,
+	"*.us-gov-east-1.rds.amazonaws.com":                         Private,
+	"*.us-gov-west-1.rds.amazonaws.com":                         Private,
+	"*.us-northeast-1.rds.amazonaws.com":                        Private,
+	"*.us-west-1.rds.amazonaws.com":                             Private,
+	"*.us-west-2.rds.amazonaws.com":                             Private,
+	"s3.dualstack.cn-north-1.amazonaws.com.cn":                  Private,
+	"s3-accesspoint.dualstack.cn-north-1.amazonaws.com.cn":      Private,
+	"s3-website.dualstack.cn-north-1.amazonaws.com.cn":          Private,
+	"s3.cn-north-1.amazonaws.com.cn":                            Private,
+	"s3-accesspoint.cn-north-1.amazonaws.com.cn":                Private,
+	"s3-deprecated.cn-north-1.amazonaws.com.cn":                 Private,
+	"s3-object-lambda.cn-north-1.amazonaws.com.cn":              Private,
+	"s3-website.cn-north-1.amazonaws.com.cn":                    Private,
+	"s3.dualstack.cn-northwest-1.amazonaws.com.cn":              Private,
+	"s3-accesspoint.dualstack.cn-northwest-1.amazonaws.com.cn":  Private,
+	"s3.cn-northwest-1.amazonaws.com.cn":                        Private,
+	"s3-accesspoint.cn-northwest-1.amazonaws.com.cn":            Private,
+	"s3-object-lambda.cn-northwest-1.amazonaws.com.cn":          Private,
+	"s3-website.cn-northwest-1.amazonaws.com.cn":                Private,
+	"s3.dualstack.af-south-1.amazonaws.com":                     Private,
+	"s3-accesspoint.dualstack.af-south-1.amazonaws.com":         Private,
+	"s3-website.dualstack.af-south-1.amazonaws.com":             Private,
+	"s3.af-south-1.amazonaws.com":                               Private,
+	"s3-accesspoint.af-south-1.amazonaws.com":                   Private,
+	"s3-object-lambda.af-south-1.amazonaws.com":                 Private,
+	"s3-website.af-south-1.amazonaws.com":                       Private,
+	"s3.dualstack.ap-east-1.amazonaws.com":                      Private,
+	"s3-accesspoint.dualstack.ap-east-1.amazonaws.com":          Private,
+	"s3.ap-east-1.amazonaws.com":                                Private,
+	"s3-accesspoint.ap-east-1.amazonaws.com":                    Private,
+	"s3-object-lambda.ap-east-1.amazonaws.com":                  Private,
+	"s3-website.ap-east-1.amazonaws.com":                        Private,
+	"s3.dualstack.ap-northeast-1.amazonaws.com":                 Private,
+	"s3-accesspoint.dualstack.ap-northeast-1.amazonaws.com":     Private,
+	"s3-website.dualstack.ap-northeast-1.amazonaws.com":         Private,
+	"s3.ap-northeast-1.amazonaws.com":                           Private,
+	"s3-accesspoint.ap-northeast-1.amazonaws.com":               Private,
+	"s3-object-lambda.ap-northeast-1.amazonaws.com":             Private,
+	"s3-website.ap-northeast-1.amazonaws.com":                   Private,
+	"s3.dualstack.ap-northeast-2.amazonaws.com":                 Private,
+	"s3-accesspoint.dualstack.ap-northeast-2.amazonaws.com":     Private,
+	"s3-website.dualstack.ap-northeast-2.amazonaws.com":         Private,
+	"s3.ap-northeast-2.amazonaws.com":                           Private,
+	"s3-accesspoint.ap-northeast-2.amazonaws.com":               Private,
+	"s3-object-lambda.ap-northeast-2.amazonaws.com":             Private,
+	"s3-website.ap-northeast-2.amazonaws.com":                   Private,
+	"s3.dualstack.ap-northeast-3.amazonaws.com":                 Private,
+	"s3-accesspoint.dualstack.ap-northeast-3.amazonaws.com":     Private,
+	"s3-website.dualstack.ap-northeast-3.amazonaws.com":         Private,
+	"s3.ap-northeast-3.amazonaws.com":                           Private,
+	"s3-accesspoint.ap-northeast-3.amazonaws.com":               Private,
+	"s3-object-lambda.ap-northeast-3.amazonaws.com":             Private,
+	"s3-website.ap-northeast-3.amazonaws.com":                   Private,
+	"s3.dualstack.ap-south-1.amazonaws.com":                     Private,
+	"s3-accesspoint.dualstack.ap-south-1.amazonaws.com":         Private,
+	"s3-website.dualstack.ap-south-1.amazonaws.com":             Private,
+	"s3.ap-south-1.amazonaws.com":                               Private,
+	"s3-accesspoint.ap-south-1.amazonaws.com":                   Private,
+	"s3-object-lambda.ap-south-1.amazonaws.com":                 Private,
+	"s3-website.ap-south-1.amazonaws.com":                       Private,
+	"s3.dualstack.ap-south-2.amazonaws.com":                     Private,
+	"s3-accesspoint.dualstack.ap-south-2.amazonaws.com":         Private,
+	"s3-website.dualstack.ap-south-2.amazonaws.com":             Private,
+	"s3.ap-south-2.amazonaws.com":                               Private,
+	"s3-accesspoint.ap-south-2.amazonaws.com":                   Private,
+	"s3-object-lambda.ap-south-2.amazonaws.com":                 Private,
+	"s3-website.ap-south-2.amazonaws.com":                       Private,
+	"s3.dualstack.ap-southeast-1.amazonaws.com":                 Private,
+	"s3-accesspoint.dualstack.ap-southeast-1.amazonaws.com":     Private,
+	"s3-website.dualstack.ap-southeast-1.amazonaws.com":         Private,
+	"s3.ap-southeast-1.amazonaws.com":                           Private,
+	"s3-accesspoint.ap-southeast-1.amazonaws.com":               Private,
+	"s3-object-lambda.ap-southeast-1.amazonaws.com":             Private,
+	"s3-website.ap-southeast-1.amazonaws.com":                   Private,
+	"s3.dualstack.ap-southeast-2.amazonaws.com":                 Private,
+	"s3-accesspoint.dualstack.ap-southeast-2.amazonaws.com":     Private,
+	"s3-website.dualstack.ap-southeast-2.amazonaws.com":         Private,
+	"s3.ap-southeast-2.amazonaws.com":                           Private,
+	"s3-accesspoint.ap-southeast-2.amazonaws.com":               Private,
+	"s3-object-lambda.ap-southeast-2.amazonaws.com":             Private,
+	"s3-website.ap-southeast-2.amazonaws.com":                   Private,
+	"s3.dualstack.ap-southeast-3.amazonaws.com":                 Private,
+	"s3-accesspoint.dualstack.ap-southeast-3.amazonaws.com":     Private,
+	"s3-website.dualstack.ap-southeast-3.amazonaws.com":         Private,
+	"s3.ap-southeast-3.amazonaws.com":                           Private,
+	"s3-accesspoint.ap-southeast-3.amazonaws.com":               Private,
+	"s3-object-lambda.ap-southeast-3.amazonaws.com":             Private,
+	"s3-website.ap-southeast-3.amazonaws.com":                   Private,
+	"s3.dualstack.ap-southeast-4.amazonaws.com":                 Private,
+	"s3-accesspoint.dualstack.ap-southeast-4.amazonaws.com":     Private,
+	"s3-website.dualstack.ap-southeast-4.amazonaws.com":         Private,
+	"s3.ap-southeast-4.amazonaws.com":                           Private,
+	"s3-accesspoint.ap-southeast-4.amazonaws.com":               Private,
+	"s3-object-lambda.ap-southeast-4.amazonaws.com":             Private,
+	"s3-website.ap-southeast-4.amazonaws.com":                   Private,
+	"s3.dualstack.ap-southeast-5.amazonaws.com":                 Private,
+	"s3-accesspoint.dualstack.ap-southeast-5.amazonaws.com":     Private,
+	"s3-website.dualstack.ap-southeast-5.amazonaws.com":         Private,
+	"s3.ap-southeast-5.amazonaws.com":                           Private,
+	"s3-accesspoint.ap-southeast-5.amazonaws.com":               Private,
+	"s3-deprecated.ap-southeast-5.amazonaws.com":                Private,
+	"s3-object-lambda.ap-southeast-5.amazonaws.com":             Private,
+	"s3-website.ap-southeast-5.amazonaws.com":                   Private,
+	"s3.dualstack.ca-central-1.amazonaws.com":                   Private,
+	"s3-accesspoint.dualstack.ca-central-1.amazonaws.com":       Private,
+	"s3-accesspoint-fips.dualstack.ca-central-1.amazonaws.com":  Private,
+	"s3-fips.dualstack.ca-central-1.amazonaws.com":              Private,
+	"s3-website.dualstack.ca-central-1.amazonaws.com":           Private,
+	"s3.ca-central-1.amazonaws.com":                             Private,
+	"s3-accesspoint.ca-central-1.amazonaws.com":                 Private,
+	"s3-accesspoint-fips.ca-central-1.amazonaws.com":            Private,
+	"s3-fips.ca-central-1.amazonaws.com":                        Private,
+	"s3-object-lambda.ca-central-1.amazonaws.com":               Private,
+	"s3-website.ca-central-1.amazonaws.com":                     Private,
+	"s3.dualstack.ca-west-1.amazonaws.com":                      Private,
+	"s3-accesspoint.dualstack.ca-west-1.amazonaws.com":          Private,
+	"s3-accesspoint-fips.dualstack.ca-west-1.amazonaws.com":     Private,
+	"s3-fips.dualstack.ca-west-1.amazonaws.com":                 Private,
+	"s3-website.dualstack.ca-west-1.amazonaws.com":              Private,
+	"s3.ca-west-1.amazonaws.com":                                Private,
+	"s3-accesspoint.ca-west-1.amazonaws.com":                    Private,
+	"s3-accesspoint-fips.ca-west-1.amazonaws.com":               Private,
+	"s3-fips.ca-west-1.amazonaws.com":                           Private,
+	"s3-object-lambda.ca-west-1.amazonaws.com":                  Private,
+	"s3-website.ca-west-1.amazonaws.com":                        Private,
+	"s3.dualstack.eu-central-1.amazonaws.com":                   Private,
+	"s3-accesspoint.dualstack.eu-central-1.amazonaws.com":       Private,
+	"s3-website.dualstack.eu-central-1.amazonaws.com":           Private,
+	"s3.eu-central-1.amazonaws.com":                             Private,
+	"s3-accesspoint.eu-central-1.amazonaws.com":                 Private,
+	"s3-object-lambda.eu-central-1.amazonaws.com":               Private,
+	"s3-website.eu-central-1.amazonaws.com":                     Private,
+	"s3.dualstack.eu-central-2.amazonaws.com":                   Private,
+	"s3-accesspoint.dualstack.eu-central-2.amazonaws.com":       Private,
+	"s3-website.dualstack.eu-central-2.amazonaws.com":           Private,
+	"s3.eu-central-2.amazonaws.com":                             Private,
+	"s3-accesspoint.eu-central-2.amazonaws.com":                 Private,
+	"s3-object-lambda.eu-central-2.amazonaws.com":               Private,
+	"s3-website.eu-central-2.amazonaws.com":                     Private,
+	"s3.dualstack.eu-north-1.amazonaws.com":                     Private,
+	"s3-accesspoint.dualstack.eu-north-1.amazonaws.com":         Private,
+	"s3.eu-north-1.amazonaws.com":                               Private,
+	"s3-accesspoint.eu-north-1.amazonaws.com":                   Private,
+	"s3-object-lambda.eu-north-1.amazonaws.com":                 Private,
+	"s3-website.eu-north-1.amazonaws.com":                       Private,
+	"s3.dualstack.eu-south-1.amazonaws.com":                     Private,
+	"s3-accesspoint.dualstack.eu-south-1.amazonaws.com":         Private,
+	"s3-website.dualstack.eu-south-1.amazonaws.com":             Private,
+	"s3.eu-south-1.amazonaws.com":                               Private,
+	"s3-accesspoint.eu-south-1.amazonaws.com":                   Private,
+	"s3-object-lambda.eu-south-1.amazonaws.com":                 Private,
+	"s3-website.eu-south-1.amazonaws.com":                       Private,
+	"s3.dualstack.eu-south-2.amazonaws.com":                     Private,
+	"s3-accesspoint.dualstack.eu-south-2.amazonaws.com":         Private,
+	"s3-website.dualstack.eu-south-2.amazonaws.com":             Private,
+	"s3.eu-south-2.amazonaws.com":                               Private,
+	"s3-accesspoint.eu-south-2.amazonaws.com":                   Private,
+	"s3-object-lambda.eu-south-2.amazonaws.com":                 Private,
+	"s3-website.eu-south-2.amazonaws.com":                       Private,
+	"s3.dualstack.eu-west-1.amazonaws.com":                      Private,
+	"s3-accesspoint.dualstack.eu-west-1.amazonaws.com":          Private,
+	"s3-website.dualstack.eu-west-1.amazonaws.com":              Private,
+	"s3.eu-west-1.amazonaws.com":                                Private,
+	"s3-accesspoint.eu-west-1.amazonaws.com":                    Private,
+	"s3-deprecated.eu-west-1.amazonaws.com":                     Private,
+	"s3-object-lambda.eu-west-1.amazonaws.com":                  Private,
+	"s3-website.eu-west-1.amazonaws.com":                        Private,
+	"s3.dualstack.eu-west-2.amazonaws.com":                      Private,
+	"s3-accesspoint.dualstack.eu-west-2.amazonaws.com":          Private,
+	"s3.eu-west-2.amazonaws.com":                                Private,
+	"s3-accesspoint.eu-west-2.amazonaws.com":                    Private,
+	"s3-object-lambda.eu-west-2.amazonaws.com":                  Private,
+	"s3-website.eu-west-2.amazonaws.com":                        Private,
+	"s3.dualstack.eu-west-3.amazonaws.com":                      Private,
+	"s3-accesspoint.dualstack.eu-west-3.amazonaws.com":          Private,
+	"s3-website.dualstack.eu-west-3.amazonaws.com":              Private,
+	"s3.eu-west-3.amazonaws.com":                                Private,
+	"s3-accesspoint.eu-west-3.amazonaws.com":                    Private,
+	"s3-object-lambda.eu-west-3.amazonaws.com":                  Private,
+	"s3-website.eu-west-3.amazonaws.com":                        Private,
+	"s3.dualstack.il-central-1.amazonaws.com":                   Private,
+	"s3-accesspoint.dualstack.il-central-1.amazonaws.com":       Private,
+	"s3-website.dualstack.il-central-1.amazonaws.com":           Private,
+	"s3.il-central-1.amazonaws.com":                             Private,
+	"s3-accesspoint.il-central-1.amazonaws.com":                 Private,
+	"s3-object-lambda.il-central-1.amazonaws.com":               Private,
+	"s3-website.il-central-1.amazonaws.com":                     Private,
+	"s3.dualstack.me-central-1.amazonaws.com":                   Private,
+	"s3-accesspoint.dualstack.me-central-1.amazonaws.com":       Private,
+	"s3-website.dualstack.me-central-1.amazonaws.com":           Private,
+	"s3.me-central-1.amazonaws.com":                             Private,
+	"s3-accesspoint.me-central-1.amazonaws.com":                 Private,
+	"s3-object-lambda.me-central-1.amazonaws.com":               Private,
+	"s3-website.me-central-1.amazonaws.com":                     Private,
+	"s3.dualstack.me-south-1.amazonaws.com":                     Private,
+	"s3-accesspoint.dualstack.me-south-1.amazonaws.com":         Private,
+	"s3.me-south-1.amazonaws.com":                               Private,
+	"s3-accesspoint.me-south-1.amazonaws.com":                   Private,
+	"s3-object-lambda.me-south-1.amazonaws.com":                 Private,
+	"s3-website.me-south-1.amazonaws.com":                       Private,
+	"s3.amazonaws.com":                                          Private,
+	"s3-1.amazonaws.com":                                        Private,
+	"s3-ap-east-1.amazonaws.com":                                Private,
+	"s3-ap-northeast-1.amazonaws.com":                           Private,
+	"s3-ap-northeast-2.amazonaws.com":                           Private,
+	"s3-ap-northeast-3.amazonaws.com":                           Private,
+	"s3-ap-south-1.amazonaws.com":                               Private,
+	"s3-ap-southeast-1.amazonaws.com":                           Private,
+	"s3-ap-southeast-2.amazonaws.com":                           Private,
+	"s3-ca-central-1.amazonaws.com":                             Private,
+	"s3-eu-central-1.amazonaws.com":                             Private,
+	"s3-eu-north-1.amazonaws.com":                               Private,
+	"s3-eu-west-1.amazonaws.com":                                Private,
+	"s3-eu-west-2.amazonaws.com":                                Private,
+	"s3-eu-west-3.amazonaws.com":                                Private,
+	"s3-external-1.amazonaws.com":                               Private,
+	"s3-fips-us-gov-east-1.amazonaws.com":                       Private,
+	"s3-fips-us-gov-west-1.amazonaws.com":                       Private,
+	"mrap.accesspoint.s3-global.amazonaws.com":                  Private,
+	"s3-me-south-1.amazonaws.com":                               Private,
+	"s3-sa-east-1.amazonaws.com":                                Private,
+	"s3-us-east-2.amazonaws.com":                                Private,
+	"s3-us-gov-east-1.amazonaws.com":                            Private,
+	"s3-us-gov-west-1.amazonaws.com":                            Private,
+	"s3-us-west-1.amazonaws.com":                                Private,
+	"s3-us-west-2.amazonaws.com":                                Private,
+	"s3-website-ap-northeast-1.amazonaws.com":                   Private,
+	"s3-website-ap-southeast-1.amazonaws.com":                   Private,
+	"s3-website-ap-southeast-2.amazonaws.com":                   Private,
+	"s3-website-eu-west-1.amazonaws.com":                        Private,
+	"s3-website-sa-east-1.amazonaws.com":                        Private,
+	"s3-website-us-east-1.amazonaws.com":                        Private,
+	"s3-website-us-gov-west-1.amazonaws.com":                    Private,
+	"s3-website-us-west-1.amazonaws.com":                        Private,
+	"s3-website-us-west-2.amazonaws.com":                        Private,
+	"s3.dualstack.sa-east-1.amazonaws.com":                      Private,
+	"s3-accesspoint.dualstack.sa-east-1.amazonaws.com":          Private,
+	"s3-website.dualstack.sa-east-1.amazonaws.com":              Private,
+	"s3.sa-east-1.amazonaws.com":                                Private,
+	"s3-accesspoint.sa-east-1.amazonaws.com":                    Private,
+	"s3-object-lambda.sa-east-1.amazonaws.com":                  Private,
+	"s3-website.sa-east-1.amazonaws.com":                        Private,
+	"s3.dualstack.us-east-1.amazonaws.com":                      Private,
+	"s3-accesspoint.dualstack.us-east-1.amazonaws.com":          Private,
+	"s3-accesspoint-fips.dualstack.us-east-1.amazonaws.com":     Private,
+	"s3-fips.dualstack.us-east-1.amazonaws.com":                 Private,
+	"s3-website.dualstack.us-east-1.amazonaws.com":              Private,
+	"s3.us-east-1.amazonaws.com":                                Private,
+	"s3-accesspoint.us-east-1.amazonaws.com":                    Private,
+	"s3-accesspoint-fips.us-east-1.amazonaws.com":               Private,
+	"s3-deprecated.us-east-1.amazonaws.com":                     Private,
+	"s3-fips.us-east-1.amazonaws.com":                           Private,
+	"s3-object-lambda.us-east-1.amazonaws.com":                  Private,
+	"s3-website.us-east-1.amazonaws.com":                        Private,
+	"s3.dualstack.us-east-2.amazonaws.com":                      Private,
+	"s3-accesspoint.dualstack.us-east-2.amazonaws.com":          Private,
+	"s3-accesspoint-fips.dualstack.us-east-2.amazonaws.com":     Private,
+	"s3-fips.dualstack.us-east-2.amazonaws.com":                 Private,
+	"s3-website.dualstack.us-east-2.amazonaws.com":              Private,
+	"s3.us-east-2.amazonaws.com":                                Private,
+	"s3-accesspoint.us-east-2.amazonaws.com":                    Private,
+	"s3-accesspoint-fips.us-east-2.amazonaws.com":               Private,
+	"s3-deprecated.us-east-2.amazonaws.com":                     Private,
+	"s3-fips.us-east-2.amazonaws.com":                           Private,
+	"s3-object-lambda.us-east-2.amazonaws.com":                  Private,
+	"s3-website.us-east-2.amazonaws.com":                        Private,
+	"s3.dualstack.us-gov-east-1.amazonaws.com":                  Private,
+	"s3-accesspoint.dualstack.us-gov-east-1.amazonaws.com":      Private,
+	"s3-accesspoint-fips.dualstack.us-gov-east-1.amazonaws.com": Private,
+	"s3-fips.dualstack.us-gov-east-1.amazonaws.com":             Private,
+	"s3-website.dualstack.us-gov-east-1.amazonaws.com":          Private,
+	"s3.us-gov-east-1.amazonaws.com":                            Private,
+	"s3-accesspoint.us-gov-east-1.amazonaws.com":                Private,
+	"s3-accesspoint-fips.us-gov-east-1.amazonaws.com":           Private,
+	"s3-fips.us-gov-east-1.amazonaws.com":                       Private,
+	"s3-object-lambda.us-gov-east-1.amazonaws.com":              Private,
+	"s3-website.us-gov-east-1.amazonaws.com":                    Private,
+	"s3.dualstack.us-gov-west-1.amazonaws.com":                  Private,
+	"s3-accesspoint.dualstack.us-gov-west-1.amazonaws.com":      Private,
+	"s3-accesspoint-fips.dualstack.us-gov-west-1.amazonaws.com": Private,
+	"s3-fips.dualstack.us-gov-west-1.amazonaws.com":             Private,
+	"s3-website.dualstack.us-gov-west-1.amazonaws.com":          Private,
+	"s3.us-gov-west-1.amazonaws.com":                            Private,
+	"s3-accesspoint.us-gov-west-1.amazonaws.com":                Private,
+	"s3-accesspoint-fips.us-gov-west-1.amazonaws.com":           Private,
+	"s3-fips.us-gov-west-1.amazonaws.com":                       Private,
+	"s3-object-lambda.us-gov-west-1.amazonaws.com":              Private,
+	"s3-website.us-gov-west-1.amazonaws.com":                    Private,
+	"s3.dualstack.us-west-1.amazonaws.com":                      Private,
+	"s3-accesspoint.dualstack.us-west-1.amazonaws.com":          Private,
+	"s3-accesspoint-fips.dualstack.us-west-1.amazonaws.com":     Private,
+	"s3-fips.dualstack.us-west-1.amazonaws.com":                 Private,
+	"s3-website.dualstack.us-west-1.amazonaws.com":              Private,
+	"s3.us-west-1.amazonaws.com":                                Private,
+	"s3-accesspoint.us-west-1.amazonaws.com":                    Private,
+	"s3-accesspoint-fips.us-west-1.amazonaws.com":               Private,
+	"s3-fips.us-west-1.amazonaws.com":                           Private,
+	"s3-object-lambda.us-west-1.amazonaws.com":                  Private,
+	"s3-website.us-west-1.amazonaws.com":                        Private,
+	"s3.dualstack.us-west-2.amazonaws.com":                      Private,
+	"s3-accesspoint.dualstack.us-west-2.amazonaws.com":          Private,
+	"s3-accesspoint-fips.dualstack.us-west-2.amazonaws.com":     Private,
+	"s3-fips.dualstack.us-west-2.amazonaws.com":                 Private,
+	"s3-website.dualstack.us-west-2.amazonaws.com":              Private,
+	"s3.us-west-2.amazonaws.com":                                Private,
+	"s3-accesspoint.us-west-2.amazonaws.com":                    Private,
+	"s3-accesspoint-fips.us-west-2.amazonaws.com":               Private,
+	"s3-deprecated.us-west-2.amazonaws.com":                     Private,
+	"s3-fips.us-west-2.amazonaws.com":                           Private,
+	"s3-object-lambda.us-west-2.amazonaws.com":                  Private,
+	"s3-website.us-west-2.amazonaws.com":                        Private,
+	"labeling.ap-northeast-1.sagemaker.aws":                     Private,
+	"labeling.ap-northeast-2.sagemaker.aws":                     Private,
+	"labeling.ap-south-1.sagemaker.aws":                         Private,
+	"labeling.ap-southeast-1.sagemaker.aws":                     Private,
+	"labeling.ap-southeast-2.sagemaker.aws":                     Private,
+	"labeling.ca-central-1.sagemaker.aws":                       Private,
+	"labeling.eu-central-1.sagemaker.aws":                       Private,
+	"labeling.eu-west-1.sagemaker.aws":                          Private,
+	"labeling.eu-west-2.sagemaker.aws":                          Private,
+	"labeling.us-east-1.sagemaker.aws":                          Private,
+	"labeling.us-east-2.sagemaker.aws":                          Private,
+	"labeling.us-west-2.sagemaker.aws":                          Private,
+	"notebook.af-south-1.sagemaker.aws":                         Private,
+	"notebook.ap-east-1.sagemaker.aws":                          Private,
+	"notebook.ap-northeast-1.sagemaker.aws":                     Private,
+	"notebook.ap-northeast-2.sagemaker.aws":                     Private,
+	"notebook.ap-northeast-3.sagemaker.aws":                     Private,
+	"notebook.ap-south-1.sagemaker.aws":                         Private,
+	"notebook.ap-south-2.sagemaker.aws":                         Private,
+	"notebook.ap-southeast-1.sagemaker.aws":                     Private,
+	"notebook.ap-southeast-2.sagemaker.aws":                     Private,
+	"notebook.ap-southeast-3.sagemaker.aws":                     Private,
+	"notebook.ap-southeast-4.sagemaker.aws":                     Private,
+	"notebook.ca-central-1.sagemaker.aws":                       Private,
+	"notebook-fips.ca-central-1.sagemaker.aws":                  Private,
+	"notebook.ca-west-1.sagemaker.aws":                          Private,
+	"notebook-fips.ca-west-1.sagemaker.aws":                     Private,
+	"notebook.eu-central-1.sagemaker.aws":                       Private,
+	"notebook.eu-central-2.sagemaker.aws":                       Private,
+	"notebook.eu-north-1.sagemaker.aws":                         Private,
+	"notebook.eu-south-1.sagemaker.aws":                         Private,
+	"notebook.eu-south-2.sagemaker.aws":                         Private,
+	"notebook.eu-west-1.sagemaker.aws":                          Private,
+	"notebook.eu-west-2.sagemaker.aws":                          Private,
+	"notebook.eu-west-3.sagemaker.aws":                          Private,
+	"notebook.il-central-1.sagemaker.aws":                       Private,
+	"notebook.me-central-1.sagemaker.aws":                       Private,
+	"notebook.me-south-1.sagemaker.aws":                         Private,
+	"notebook.sa-east-1.sagemaker.aws":                          Private,
+	"notebook.us-east-1.sagemaker.aws":                          Private,
+	"notebook-fips.us-east-1.sagemaker.aws":                     Private,
+	"notebook.us-east-2.sagemaker.aws":                          Private,
+	"notebook-fips.us-east-2.sagemaker.aws":                     Private,
+	"notebook.us-gov-east-1.sagemaker.aws":                      Private,
+	"notebook-fips.us-gov-east-1.sagemaker.aws":                 Private,
+	"notebook.us-gov-west-1.sagemaker.aws":                      Private,
+	"notebook-fips.us-gov-west-1.sagemaker.aws":                 Private,
+	"notebook.us-west-1.sagemaker.aws":                          Private,
+	"notebook-fips.us-west-1.sagemaker.aws":                     Private,
+	"notebook.us-west-2.sagemaker.aws":                          Private,
+	"notebook-fips.us-west-2.sagemaker.aws":                     Private,
+	"notebook.cn-north-1.sagemaker.com.cn":                      Private,
+	"notebook.cn-northwest-1.sagemaker.com.cn":                  Private,
+	"studio.af-south-1.sagemaker.aws":                           Private,
+	"studio.ap-east-1.sagemaker.aws":                            Private,
+	"studio.ap-northeast-1.sagemaker.aws":                       Private,
+	"studio.ap-northeast-2.sagemaker.aws":                       Private,
+	"studio.ap-northeast-3.sagemaker.aws":                       Private,
+	"studio.ap-south-1.sagemaker.aws":                           Private,
+	"studio.ap-southeast-1.sagemaker.aws":                       Private,
+	"studio.ap-southeast-2.sagemaker.aws":                       Private,
+	"studio.ap-southeast-3.sagemaker.aws":                       Private,
+	"studio.ca-central-1.sagemaker.aws":                         Private,
+	"studio.eu-central-1.sagemaker.aws":                         Private,
+	"studio.eu-central-2.sagemaker.aws":                         Private,
+	"studio.eu-north-1.sagemaker.aws":                           Private,
+	"studio.eu-south-1.sagemaker.aws":                           Private,
+	"studio.eu-south-2.sagemaker.aws":                           Private,
+	"studio.eu-west-1.sagemaker.aws":                            Private,
+	"studio.eu-west-2.sagemaker.aws":                            Private,
+	"studio.eu-west-3.sagemaker.aws":                            Private,
+	"studio.il-central-1.sagemaker.aws":                         Private,
+	"studio.me-central-1.sagemaker.aws":                         Private,
+	"studio.me-south-1.sagemaker.aws":                           Private,
+	"studio.sa-east-1.sagemaker.aws":                            Private,
+	"studio.us-east-1.sagemaker.aws":                            Private,
+	"studio.us-east-2.sagemaker.aws":                            Private,
+	"studio.us-gov-east-1.sagemaker.aws":                        Private,
+	"studio-fips.us-gov-east-1.sagemaker.aws":                   Private,
+	"studio.us-gov-west-1.sagemaker.aws":                        Private,
+	"studio-fips.us-gov-west-1.sagemaker.aws":                   Private,
+	"studio.us-west-1.sagemaker.aws":                            Private,
+	"studio.us-west-2.sagemaker.aws":                            Private,
+	"studio.cn-north-1.sagemaker.com.cn":                        Private,
+	"studio.cn-northwest-1.sagemaker.com.cn":                    Private,
+	"*.experiments.sagemaker.aws":                               Private,
+	"analytics-gateway.ap-northeast-1.amazonaws.com":            Private,
+	"analytics-gateway.ap-northeast-2.amazonaws.com":            Private,
+	"analytics-gateway.ap-south-1.amazonaws.com":                Private,
+	"analytics-gateway.ap-southeast-1.amazonaws.com":            Private,
+	"analytics-gateway.ap-southeast-2.amazonaws.com":            Private,
+	"analytics-gateway.eu-central-1.amazonaws.com":              Private,
+	"analytics-gateway.eu-west-1.amazonaws.com":                 Private,
+	"analytics-gateway.us-east-1.amazonaws.com":                 Private,
+	"analytics-gateway.us-east-2.amazonaws.com":                 Private,
+	"analytics-gateway.us-west-2.amazonaws.com":                 Private,
+	"amplifyapp.com":                                            Private,
+	"*.awsapprunner.com":                                        Private,
+	"webview-assets.aws-cloud9.af-south-1.amazonaws.com":        Private,
+	"vfs.cloud9.af-south-1.amazonaws.com":                       Private,
+	"webview-assets.cloud9.af-south-1.amazonaws.com":            Private,
+	"webview-assets.aws-cloud9.ap-east-1.amazonaws.com":         Private,
+	"vfs.cloud9.ap-east-1.amazonaws.com":                        Private,
+	"webview-assets.cloud9.ap-east-1.amazonaws.com":             Private,
+	"webview-assets.aws-cloud9.ap-northeast-1.amazonaws.com":    Private,
+	"vfs.cloud9.ap-northeast-1.amazonaws.com":                   Private,
+	"webview-assets.cloud9.ap-northeast-1.amazonaws.com":        Private,
+	"webview-assets.aws-cloud9.ap-northeast-2.amazonaws.com":    Private,
+	"vfs.cloud9.ap-northeast-2.amazonaws.com":                   Private,
+	"webview-assets.cloud9.ap-northeast-2.amazonaws.com":        Private,
+	"webview-assets.aws-cloud9.ap-northeast-3.amazonaws.com":    Private,
+	"vfs.cloud9.ap-northeast-3.amazonaws.com":                   Private,
+	"webview-assets.cloud9.ap-northeast-3.amazonaws.com":        Private,
+	"webview-assets.aws-cloud9.ap-south-1.amazonaws.com":        Private,
+	"vfs.cloud9.ap-south-1.amazonaws.com":                       Private,
+	"webview-assets.cloud9.ap-south-1.amazonaws.com":            Private,
+	"webview-assets.aws-cloud9.ap-southeast-1.amazonaws.com":    Private,
+	"vfs.cloud9.ap-southeast-1.amazonaws.com":                   Private,
+	"webview-assets.cloud9.ap-southeast-1.amazonaws.com":        Private,
+	"webview-assets.aws-cloud9.ap-southeast-2.amazonaws.com":    Private,
+	"vfs.cloud9.ap-southeast-2.amazonaws.com":                   Private,
+	"webview-assets.cloud9.ap-southeast-2.amazonaws.com":        Private,
+	"webview-assets.aws-cloud9.ca-central-1.amazonaws.com":      Private,
+	"vfs.cloud9.ca-central-1.amazonaws.com":                     Private,
+	"webview-assets.cloud9.ca-central-1.amazonaws.com":          Private,
+	"webview-assets.aws-cloud9.eu-central-1.amazonaws.com":      Private,
+	"vfs.cloud9.eu-central-1.amazonaws.com":                     Private,
+	"webview-assets.cloud9.eu-central-1.amazonaws.com":          Private,
+	"webview-assets.aws-cloud9.eu-north-1.amazonaws.com":        Private,
+	"vfs.cloud9.eu-north-1.amazonaws.com":                       Private,
+	"webview-assets.cloud9.eu-north-1.amazonaws.com":            Private,
+	"webview-assets.aws-cloud9.eu-south-1.amazonaws.com":        Private,
+	"vfs.cloud9.eu-south-1.amazonaws.com":                       Private,
+	"webview-assets.cloud9.eu-south-1.amazonaws.com":            Private,
+	"webview-assets.aws-cloud9.eu-west-1.amazonaws.com":         Private,
+	"vfs.cloud9.eu-west-1.amazonaws.com":                        Private,
+	"webview-assets.cloud9.eu-west-1.amazonaws.com":             Private,
+	"webview-assets.aws-cloud9.eu-west-2.amazonaws.com":         Private,
+	"vfs.cloud9.eu-west-2.amazonaws.com":                        Private,
+	"webview-assets.cloud9.eu-west-2.amazonaws.com":             Private,
+	"webview-assets.aws-cloud9.eu-west-3.amazonaws.com":         Private,
+	"vfs.cloud9.eu-west-3.amazonaws.com":                        Private,
+	"webview-assets.cloud9.eu-west-3.amazonaws.com":             Private,
+	"webview-assets.aws-cloud9.il-central-1.amazonaws.com":      Private,
+	"vfs.cloud9.il-central-1.amazonaws.com":                     Private,
+	"webview-assets.aws-cloud9.me-south-1.amazonaws.com":        Private,
+	"vfs.cloud9.me-south-1.amazonaws.com":                       Private,
+	"webview-assets.cloud9.me-south-1.amazonaws.com":            Private,
+	"webview-assets.aws-cloud9.sa-east-1.amazonaws.com":         Private,
+	"vfs.cloud9.sa-east-1.amazonaws.com":                        Private,
+	"webview-assets.cloud9.sa-east-1.amazonaws.com":             Private,
+	"webview-assets.aws-cloud9.us-east-1.amazonaws.com":         Private,
+	"vfs.cloud9.us-east-1.amazonaws.com":                        Private,
+	"webview-assets.cloud9.us-east-1.amazonaws.com":             Private,
+	"webview-assets.aws-cloud9.us-east-2.amazonaws.com":         Private,
+	"vfs.cloud9.us-east-2.amazonaws.com":                        Private,
+	"webview-assets.cloud9.us-east-2.amazonaws.com":             Private,
+	"webview-assets.aws-cloud9.us-west-1.amazonaws.com":         Private,
+	"vfs.cloud9.us-west-1.amazonaws.com":                        Private,
+	"webview-assets.cloud9.us-west-1.amazonaws.com":             Private,
+	"webview-assets.aws-cloud9.us-west-2.amazonaws.com":         Private,
+	"vfs.cloud9.us-west-2.amazonaws.com":                        Private,
+	"webview-assets.cloud9.us-west-2.amazonaws.com":             Private,
+	"awsapps.com":                                                Private,
+	"cn-north-1.eb.amazonaws.com.cn":                             Private,
+	"cn-northwest-1.eb.amazonaws.com.cn":                         Private,
+	"elasticbeanstalk.com":                                       Private,
+	"af-south-1.elasticbeanstalk.com":                            Private,
+	"ap-east-1.elasticbeanstalk.com":                             Private,
+	"ap-northeast-1.elasticbeanstalk.com":                        Private,
+	"ap-northeast-2.elasticbeanstalk.com":                        Private,
+	"ap-northeast-3.elasticbeanstalk.com":                        Private,
+	"ap-south-1.elasticbeanstalk.com":                            Private,
+	"ap-southeast-1.elasticbeanstalk.com":                        Private,
+	"ap-southeast-2.elasticbeanstalk.com":                        Private,
+	"ap-southeast-3.elasticbeanstalk.com":                        Private,
+	"ap-southeast-5.elasticbeanstalk.com":                        Private,
+	"ap-southeast-7.elasticbeanstalk.com":                        Private,
+	"ca-central-1.elasticbeanstalk.com":                          Private,
+	"eu-central-1.elasticbeanstalk.com":                          Private,
+	"eu-north-1.elasticbeanstalk.com":                            Private,
+	"eu-south-1.elasticbeanstalk.com":                            Private,
+	"eu-south-2.elasticbeanstalk.com":                            Private,
+	"eu-west-1.elasticbeanstalk.com":                             Private,
+	"eu-west-2.elasticbeanstalk.com":                             Private,
+	"eu-west-3.elasticbeanstalk.com":                             Private,
+	"il-central-1.elasticbeanstalk.com":                          Private,
+	"me-central-1.elasticbeanstalk.com":                          Private,
+	"me-south-1.elasticbeanstalk.com":                            Private,
+	"sa-east-1.elasticbeanstalk.com":                             Private,
+	"us-east-1.elasticbeanstalk.com":                             Private,
+	"us-east-2.elasticbeanstalk.com":                             Private,
+	"us-gov-east-1.elasticbeanstalk.com":                         Private,
+	"us-gov-west-1.elasticbeanstalk.com":                         Private,
+	"us-west-1.elasticbeanstalk.com":                             Private,
+	"us-west-2.elasticbeanstalk.com":                             Private,
+	"*.elb.amazonaws.com.cn":                                     Private,
+	"*.elb.amazonaws.com":                                        Private,
+	"awsglobalaccelerator.com":                                   Private,
+	"lambda-url.af-south-1.on.aws":                               Private,
+	"lambda-url.ap-east-1.on.aws":                                Private,
+	"lambda-url.ap-northeast-1.on.aws":                           Private,
+	"lambda-url.ap-northeast-2.on.aws":                           Private,
+	"lambda-url.ap-northeast-3.on.aws":                           Private,
+	"lambda-url.ap-south-1.on.aws":                               Private,
+	"lambda-url.ap-southeast-1.on.aws":                           Private,
+	"lambda-url.ap-southeast-2.on.aws":                           Private,
+	"lambda-url.ap-southeast-3.on.aws":                           Private,
+	"lambda-url.ca-central-1.on.aws":                             Private,
+	"lambda-url.eu-central-1.on.aws":                             Private,
+	"lambda-url.eu-north-1.on.aws":                               Private,
+	"lambda-url.eu-south-1.on.aws":                               Private,
+	"lambda-url.eu-west-1.on.aws":                                Private,
+	"lambda-url.eu-west-2.on.aws":                                Private,
+	"lambda-url.eu-west-3.on.aws":                                Private,
+	"lambda-url.me-south-1.on.aws":                               Private,
+	"lambda-url.sa-east-1.on.aws":                                Private,
+	"lambda-url.us-east-1.on.aws":                                Private,
+	"lambda-url.us-east-2.on.aws":                                Private,
+	"lambda-url.us-west-1.on.aws":                                Private,
+	"lambda-url.us-west-2.on.aws":                                Private,
+	"*.private.repost.aws":                                       Private,
+	"transfer-webapp.af-south-1.on.aws":                          Private,
+	"transfer-webapp.ap-east-1.on.aws":                           Private,
+	"transfer-webapp.ap-northeast-1.on.aws":                      Private,
+	"transfer-webapp.ap-northeast-2.on.aws":                      Private,
+	"transfer-webapp.ap-northeast-3.on.aws":                      Private,
+	"transfer-webapp.ap-south-1.on.aws":                          Private,
+	"transfer-webapp.ap-south-2.on.aws":                          Private,
+	"transfer-webapp.ap-southeast-1.on.aws":                      Private,
+	"transfer-webapp.ap-southeast-2.on.aws":                      Private,
+	"transfer-webapp.ap-southeast-3.on.aws":                      Private,
+	"transfer-webapp.ap-southeast-4.on.aws":                      Private,
+	"transfer-webapp.ap-southeast-5.on.aws":                      Private,
+	"transfer-webapp.ap-southeast-7.on.aws":                      Private,
+	"transfer-webapp.ca-central-1.on.aws":                        Private,
+	"transfer-webapp.ca-west-1.on.aws":                           Private,
+	"transfer-webapp.eu-central-1.on.aws":                        Private,
+	"transfer-webapp.eu-central-2.on.aws":                        Private,
+	"transfer-webapp.eu-north-1.on.aws":                          Private,
+	"transfer-webapp.eu-south-1.on.aws":                          Private,
+	"transfer-webapp.eu-south-2.on.aws":                          Private,
+	"transfer-webapp.eu-west-1.on.aws":                           Private,
+	"transfer-webapp.eu-west-2.on.aws":                           Private,
+	"transfer-webapp.eu-west-3.on.aws":                           Private,
+	"transfer-webapp.il-central-1.on.aws":                        Private,
+	"transfer-webapp.me-central-1.on.aws":                        Private,
+	"transfer-webapp.me-south-1.on.aws":                          Private,
+	"transfer-webapp.mx-central-1.on.aws":                        Private,
+	"transfer-webapp.sa-east-1.on.aws":                           Private,
+	"transfer-webapp.us-east-1.on.aws":                           Private,
+	"transfer-webapp.us-east-2.on.aws":                           Private,
+	"transfer-webapp.us-gov-east-1.on.aws":                       Private,
+	"transfer-webapp-fips.us-gov-east-1.on.aws":                  Private,
+	"transfer-webapp.us-gov-west-1.on.aws":                       Private,
+	"transfer-webapp-fips.us-gov-west-1.on.aws":                  Private,
+	"transfer-webapp.us-west-1.on.aws":                           Private,
+	"transfer-webapp.us-west-2.on.aws":                           Private,
+	"transfer-webapp.cn-north-1.on.amazonwebservices.com.cn":     Private,
+	"transfer-webapp.cn-northwest-1.on.amazonwebservices.com.cn": Private,
+	"eero.online":                        Private,
+	"eero-stage.online":                  Private,
+	"antagonist.cloud":                   Private,
+	"apigee.io":                          Private,
+	"panel.dev":                          Private,
+	"siiites.com":                        Private,
+	"int.apple":                          Private,
+	"*.cloud.int.apple":                  Private,
+	"*.r.cloud.int.apple":                Private,
+	"*.ap-north-1.r.cloud.int.apple":     Private,
+	"*.ap-south-1.r.cloud.int.apple":     Private,
+	"*.ap-south-2.r.cloud.int.apple":     Private,
+	"*.eu-central-1.r.cloud.int.apple":   Private,
+	"*.eu-north-1.r.cloud.int.apple":     Private,
+	"*.us-central-1.r.cloud.int.apple":   Private,
+	"*.us-central-2.r.cloud.int.apple":   Private,
+	"*.us-east-1.r.cloud.int.apple":      Private,
+	"*.us-east-2.r.cloud.int.apple":      Private,
+	"*.us-west-1.r.cloud.int.apple":      Private,
+	"*.us-west-2.r.cloud.int.apple":      Private,
+	"*.us-west-3.r.cloud.int.apple":      Private,
+	"appspacehosted.com":                 Private,
+	"appspaceusercontent.com":            Private,
+	"appudo.net":                         Private,
+	"appwrite.global":                    Private,
+	"appwrite.network":                   Private,
+	"*.appwrite.run":                     Private,
+	"on-aptible.com":                     Private,
+	"f5.si":                              Private,
+	"arvanedge.ir":                       Private,
+	"user.aseinet.ne.jp":                 Private,
+	"gv.vc":                              Private,
+	"d.gv.vc":                            Private,
+	"user.party.eus":                     Private,
+	"pimienta.org":                       Private,
+	"poivron.org":                        Private,
+	"potager.org":                        Private,
+	"sweetpepper.org":                    Private,
+	"myasustor.com":                      Private,
+	"cdn.prod.atlassian-dev.net":         Private,
+	"myfritz.link":                       Private,
+	"myfritz.net":                        Private,
+	"*.awdev.ca":                         Private,
+	"*.advisor.ws":                       Private,
+	"ecommerce-shop.pl":                  Private,
+	"b-data.io":                          Private,
+	"balena-devices.com":                 Private,
+	"base.ec":                            Private,
+	"official.ec":                        Private,
+	"buyshop.jp":                         Private,
+	"fashionstore.jp":                    Private,
+	"handcrafted.jp":                     Private,
+	"kawaiishop.jp":                      Private,
+	"supersale.jp":                       Private,
+	"theshop.jp":                         Private,
+	"shopselect.net":                     Private,
+	"base.shop":                          Private,
+	"beagleboard.io":                     Private,
+	"bearblog.dev":                       Private,
+	"*.beget.app":                        Private,
+	"*.begetcdn.cloud":                   Private,
+	"pages.gay":                          Private,
+	"bnr.la":                             Private,
+	"bitbucket.io":                       Private,
+	"blackbaudcdn.net":                   Private,
+	"of.je":                              Private,
+	"square.site":                        Private,
+	"bluebite.io":                        Private,
+	"boomla.net":                         Private,
+	"boutir.com":                         Private,
+	"boxfuse.io":                         Private,
+	"square7.ch":                         Private,
+	"bplaced.com":                        Private,
+	"bplaced.de":                         Private,
+	"square7.de":                         Private,
+	"bplaced.net":                        Private,
+	"square7.net":                        Private,
+	"brave.app":                          Private,
+	"*.s.brave.app":                      Private,
+	"brave.dev":                          Private,
+	"*.s.brave.dev":                      Private,
+	"brave.io":                           Private,
+	"*.s.brave.io":                       Private,
+	"shop.brendly.ba":                    Private,
+	"shop.brendly.hr":                    Private,
+	"shop.brendly.rs":                    Private,
+	"browsersafetymark.io":               Private,
+	"radio.am":                           Private,
+	"radio.fm":                           Private,
+	"cdn.bubble.io":                      Private,
+	"bubbleapps.io":                      Private,
+	"*.bwcloud-os-instance.de":           Private,
+	"uk0.bigv.io":                        Private,
+	"dh.bytemark.co.uk":                  Private,
+	"vm.bytemark.co.uk":                  Private,
+	"cafjs.com":                          Private,
+	"canva-apps.cn":                      Private,
+	"my.canvasite.cn":                    Private,
+	"canva-apps.com":                     Private,
+	"canva-hosted-embed.com":             Private,
+	"canvacode.com":                      Private,
+	"rice-labs.com":                      Private,
+	"canva.run":                          Private,
+	"my.canva.site":                      Private,
+	"drr.ac":                             Private,
+	"uwu.ai":                             Private,
+	"carrd.co":                           Private,
+	"crd.co":                             Private,
+	"ju.mp":                              Private,
+	"api.gov.uk":                         Private,
+	"cdn77-storage.com":                  Private,
+	"rsc.contentproxy9.cz":               Private,
+	"r.cdn77.net":                        Private,
+	"cdn77-ssl.net":                      Private,
+	"c.cdn77.org":                        Private,
+	"rsc.cdn77.org":                      Private,
+	"ssl.origin.cdn77-secure.org":        Private,
+	"za.bz":                              Private,
+	"br.com":                             Private,
+	"cn.com":                             Private,
+	"de.com":                             Private,
+	"eu.com":                             Private,
+	"jpn.com":                            Private,
+	"mex.com":                            Private,
+	"ru.com":                             Private,
+	"sa.com":                             Private,
+	"uk.com":                             Private,
+	"us.com":                             Private,
+	"za.com":                             Private,
+	"com.de":                             Private,
+	"gb.net":                             Private,
+	"hu.net":                             Private,
+	"jp.net":                             Private,
+	"se.net":                             Private,
+	"uk.net":                             Private,
+	"ae.org":                             Private,
+	"com.se":                             Private,
+	"cx.ua":                              Private,
+	"discourse.diy":                      Private,
+	"discourse.group":                    Private,
+	"discourse.team":                     Private,
+	"clerk.app":                          Private,
+	"clerkstage.app":                     Private,
+	"*.lcl.dev":                          Private,
+	"*.lclstage.dev":                     Private,
+	"*.stg.dev":                          Private,
+	"*.stgstage.dev":                     Private,
+	"cleverapps.cc":                      Private,
+	"*.services.clever-cloud.com":        Private,
+	"cleverapps.io":                      Private,
+	"cleverapps.tech":                    Private,
+	"clickrising.net":                    Private,
+	"cloudns.asia":                       Private,
+	"cloudns.be":                         Private,
+	"cloud-ip.biz":                       Private,
+	"cloudns.biz":                        Private,
+	"cloud-ip.cc":                        Private,
+	"cloudns.cc":                         Private,
+	"cloudns.ch":                         Private,
+	"cloudns.cl":                         Private,
+	"cloudns.club":                       Private,
+	"abrdns.com":                         Private,
+	"dnsabr.com":                         Private,
+	"ip-ddns.com":                        Private,
+	"cloudns.cx":                         Private,
+	"cloudns.eu":                         Private,
+	"cloudns.in":                         Private,
+	"cloudns.info":                       Private,
+	"ddns-ip.net":                        Private,
+	"dns-cloud.net":                      Private,
+	"dns-dynamic.net":                    Private,
+	"cloudns.nz":                         Private,
+	"cloudns.org":                        Private,
+	"ip-dynamic.org":                     Private,
+	"cloudns.ph":                         Private,
+	"cloudns.pro":                        Private,
+	"cloudns.pw":                         Private,
+	"cloudns.us":                         Private,
+	"c66.me":                             Private,
+	"cloud66.ws":                         Private,
+	"jdevcloud.com":                      Private,
+	"wpdevcloud.com":                     Private,
+	"cloudaccess.host":                   Private,
+	"freesite.host":                      Private,
+	"cloudaccess.net":                    Private,
+	"cloudbeesusercontent.io":            Private,
+	"*.cloudera.site":                    Private,
+	"cloudflare.app":                     Private,
+	"cf-ipfs.com":                        Private,
+	"cloudflare-ipfs.com":                Private,
+	"trycloudflare.com":                  Private,
+	"pages.dev":                          Private,
+	"r2.dev":                             Private,
+	"workers.dev":                        Private,
+	"cloudflare.net":                     Private,
+	"cdn.cloudflare.net":                 Private,
+	"cdn.cloudflareanycast.net":          Private,
+	"cdn.cloudflarecn.net":               Private,
+	"cdn.cloudflareglobal.net":           Private,
+	"cust.cloudscale.ch":                 Private,
+	"objects.lpg.cloudscale.ch":          Private,
+	"objects.rma.cloudscale.ch":          Private,
+	"lpg.objectstorage.ch":               Private,
+	"rma.objectstorage.ch":               Private,
+	"wnext.app":                          Private,
+	"cnpy.gdn":                           Private,
+	"*.otap.co":                          Private,
+	"co.ca":                              Private,
+	"co.com":                             Private,
+	"codeberg.page":                      Private,
+	"csb.app":                            Private,
+	"preview.csb.app":                    Private,
+	"co.nl":                              Private,
+	"co.no":                              Private,
+	"*.devinapps.com":                    Private,
+	"webhosting.be":                      Private,
+	"prvw.eu":                            Private,
+	"hosting-cluster.nl":                 Private,
+	"ctfcloud.net":                       Private,
+	"convex.app":                         Private,
+	"convex.cloud":                       Private,
+	"eu-west-1.convex.cloud":             Private,
+	"us-east-1.convex.cloud":             Private,
+	"convex.site":                        Private,
+	"eu-west-1.convex.site":              Private,
+	"us-east-1.convex.site":              Private,
+	"ac.ru":                              Private,
+	"edu.ru":                             Private,
+	"gov.ru":                             Private,
+	"int.ru":                             Private,
+	"mil.ru":                             Private,
+	"corespeed.app":                      Private,
+	"dyn.cosidns.de":                     Private,
+	"dnsupdater.de":                      Private,
+	"dynamisches-dns.de":                 Private,
+	"internet-dns.de":                    Private,
+	"l-o-g-i-n.de":                       Private,
+	"dynamic-dns.info":                   Private,
+	"feste-ip.net":                       Private,
+	"knx-server.net":                     Private,
+	"static-access.net":                  Private,
+	"craft.me":                           Private,
+	"realm.cz":                           Private,
+	"on.crisp.email":                     Private,
+	"*.cryptonomic.net":                  Private,
+	"cfolks.pl":                          Private,
+	"cyon.link":                          Private,
+	"cyon.site":                          Private,
+	"biz.dk":                             Private,
+	"co.dk":                              Private,
+	"firm.dk":                            Private,
+	"reg.dk":                             Private,
+	"store.dk":                           Private,
+	"dyndns.dappnode.io":                 Private,
+	"builtwithdark.com":                  Private,
+	"darklang.io":                        Private,
+	"demo.datadetect.com":                Private,
+	"instance.datadetect.com":            Private,
+	"edgestack.me":                       Private,
+	"dattolocal.com":                     Private,
+	"dattorelay.com":                     Private,
+	"dattoweb.com":                       Private,
+	"mydatto.com":                        Private,
+	"dattolocal.net":                     Private,
+	"mydatto.net":                        Private,
+	"ddnss.de":                           Private,
+	"dyn.ddnss.de":                       Private,
+	"dyndns.ddnss.de":                    Private,
+	"dyn-ip24.de":                        Private,
+	"dyndns1.de":                         Private,
+	"home-webserver.de":                  Private,
+	"dyn.home-webserver.de":              Private,
+	"myhome-server.de":                   Private,
+	"ddnss.org":                          Private,
+	"debian.net":                         Private,
+	"definima.io":                        Private,
+	"definima.net":                       Private,
+	"deno.dev":                           Private,
+	"deno-staging.dev":                   Private,
+	"deno.net":                           Private,
+	"sandbox.deno.net":                   Private,
+	"dedyn.io":                           Private,
+	"deta.app":                           Private,
+	"deta.dev":                           Private,
+	"deuxfleurs.eu":                      Private,
+	"deuxfleurs.page":                    Private,
+	"*.at.ply.gg":                        Private,
+	"d6.ply.gg":                          Private,
+	"joinmc.link":                        Private,
+	"playit.plus":                        Private,
+	"*.at.playit.plus":                   Private,
+	"with.playit.plus":                   Private,
+	"icp0.io":                            Private,
+	"*.raw.icp0.io":                      Private,
+	"icp1.io":                            Private,
+	"*.raw.icp1.io":                      Private,
+	"*.icp.net":                          Private,
+	"caffeine.site":                      Private,
+	"caffeine.xyz":                       Private,
+	"mybox.company":                      Private,
+	"intouch.email":                      Private,
+	"mybox.me":                           Private,
+	"mybox.page":                         Private,
+	"dfirma.pl":                          Private,
+	"dkonto.pl":                          Private,
+	"you2.pl":                            Private,
+	"ondigitalocean.app":                 Private,
+	"*.digitaloceanspaces.com":           Private,
+	"qzz.io":                             Private,
+	"us.kg":                              Private,
+	"xx.kg":                              Private,
+	"dpdns.org":                          Private,
+	"discordsays.com":                    Private,
+	"discordsez.com":                     Private,
+	"jozi.biz":                           Private,
+	"ccwu.cc":                            Private,
+	"cc.cd":                              Private,
+	"us.ci":                              Private,
+	"de5.net":                            Private,
+	"dnshome.de":                         Private,
+	"online.th":                          Private,
+	"shop.th":                            Private,
+	"co.scot":                            Private,
+	"me.scot":                            Private,
+	"org.scot":                           Private,
+	"drayddns.com":                       Private,
+	"shoparena.pl":                       Private,
+	"dreamhosters.com":                   Private,
+	"durumis.com":                        Private,
+	"duckdns.org":                        Private,
+	"dy.fi":                              Private,
+	"tunk.org":                           Private,
+	"dyndns.biz":                         Private,
+	"for-better.biz":                     Private,
+	"for-more.biz":                       Private,
+	"for-some.biz":                       Private,
+	"for-the.biz":                        Private,
+	"selfip.biz":                         Private,
+	"webhop.biz":                         Private,
+	"ftpaccess.cc":                       Private,
+	"game-server.cc":                     Private,
+	"myphotos.cc":                        Private,
+	"scrapping.cc":                       Private,
+	"blogdns.com":                        Private,
+	"cechire.com":                        Private,
+	"dnsalias.com":                       Private,
+	"dnsdojo.com":                        Private,
+	"doesntexist.com":                    Private,
+	"dontexist.com":                      Private,
+	"doomdns.com":                        Private,
+	"dyn-o-saur.com":                     Private,
+	"dynalias.com":                       Private,
+	"dyndns-at-home.com":                 Private,
+	"dyndns-at-work.com":                 Private,
+	"dyndns-blog.com":                    Private,
+	"dyndns-free.com":                    Private,
+	"dyndns-home.com":                    Private,
+	"dyndns-ip.com":                      Private,
+	"dyndns-mail.com":                    Private,
+	"dyndns-office.com":                  Private,
+	"dyndns-pics.com":                    Private,
+	"dyndns-remote.com":                  Private,
+	"dyndns-server.com":                  Private,
+	"dyndns-web.com":                     Private,
+	"dyndns-wiki.com":                    Private,
+	"dyndns-work.com":                    Private,
+	"est-a-la-maison.com":                Private,
+	"est-a-la-masion.com":                Private,
+	"est-le-patron.com":                  Private,
+	"est-mon-blogueur.com":               Private,
+	"from-ak.com":                        Private,
+	"from-al.com":                        Private,
+	"from-ar.com":                        Private,
+	"from-ca.com":                        Private,
+	"from-ct.com":                        Private,
+	"from-dc.com":                        Private,
+	"from-de.com":                        Private,
+	"from-fl.com":                        Private,
+	"from-ga.com":                        Private,
+	"from-hi.com":                        Private,
+	"from-ia.com":                        Private,
+	"from-id.com":                        Private,
+	"from-il.com":                        Private,
+	"from-in.com":                        Private,
+	"from-ks.com":                        Private,
+	"from-ky.com":                        Private,
+	"from-ma.com":                        Private,
+	"from-md.com":                        Private,
+	"from-mi.com":                        Private,
+	"from-mn.com":                        Private,
+	"from-mo.com":                        Private,
+	"from-ms.com":                        Private,
+	"from-mt.com":                        Private,
+	"from-nc.com":                        Private,
+	"from-nd.com":                        Private,
+	"from-ne.com":                        Private,
+	"from-nh.com":                        Private,
+	"from-nj.com":                        Private,
+	"from-nm.com":                        Private,
+	"from-nv.com":                        Private,
+	"from-oh.com":                        Private,
+	"from-ok.com":                        Private,
+	"from-or.com":                        Private,
+	"from-pa.com":                        Private,
+	"from-pr.com":                        Private,
+	"from-ri.com":                        Private,
+	"from-sc.com":                        Private,
+	"from-sd.com":                        Private,
+	"from-tn.com":                        Private,
+	"from-tx.com":                        Private,
+	"from-ut.com":                        Private,
+	"from-va.com":                        Private,
+	"from-vt.com":                        Private,
+	"from-wa.com":                        Private,
+	"from-wi.com":                        Private,
+	"from-wv.com":                        Private,
+	"from-wy.com":                        Private,
+	"getmyip.com":                        Private,
+	"gotdns.com":                         Private,
+	"hobby-site.com":                     Private,
+	"homelinux.com":                      Private,
+	"homeunix.com":                       Private,
+	"iamallama.com":                      Private,
+	"is-a-anarchist.com":                 Private,
+	"is-a-blogger.com":                   Private,
+	"is-a-bookkeeper.com":                Private,
+	"is-a-bulls-fan.com":                 Private,
+	"is-a-caterer.com":                   Private,
+	"is-a-chef.com":                      Private,
+	"is-a-conservative.com":              Private,
+	"is-a-cpa.com":                       Private,
+	"is-a-cubicle-slave.com":             Private,
+	"is-a-democrat.com":                  Private,
+	"is-a-designer.com":                  Private,
+	"is-a-doctor.com":                    Private,
+	"is-a-financialadvisor.com":          Private,
+	"is-a-geek.com":                      Private,
+	"is-a-green.com":                     Private,
+	"is-a-guru.com":                      Private,
+	"is-a-hard-worker.com":               Private,
+	"is-a-hunter.com":                    Private,
+	"is-a-landscaper.com":                Private,
+	"is-a-lawyer.com":                    Private,
+	"is-a-liberal.com":                   Private,
+	"is-a-libertarian.com":               Private,
+	"is-a-llama.com":                     Private,
+	"is-a-musician.com":                  Private,
+	"is-a-nascarfan.com":                 Private,
+	"is-a-nurse.com":                     Private,
+	"is-a-painter.com":                   Private,
+	"is-a-personaltrainer.com":           Private,
+	"is-a-photographer.com":              Private,
+	"is-a-player.com":                    Private,
+	"is-a-republican.com":                Private,
+	"is-a-rockstar.com":                  Private,
+	"is-a-socialist.com":                 Private,
+	"is-a-student.com":                   Private,
+	"is-a-teacher.com":                   Private,
+	"is-a-techie.com":                    Private,
+	"is-a-therapist.com":                 Private,
+	"is-an-accountant.com":               Private,
+	"is-an-actor.com":                    Private,
+	"is-an-actress.com":                  Private,
+	"is-an-anarchist.com":                Private,
+	"is-an-artist.com":                   Private,
+	"is-an-engineer.com":                 Private,
+	"is-an-entertainer.com":              Private,
+	"is-certified.com":                   Private,
+	"is-gone.com":                        Private,
+	"is-into-anime.com":                  Private,
+	"is-into-cars.com":                   Private,
+	"is-into-cartoons.com":               Private,
+	"is-into-games.com":                  Private,
+	"is-leet.com":                        Private,
+	"is-not-certified.com":               Private,
+	"is-slick.com":                       Private,
+	"is-uberleet.com":                    Private,
+	"is-with-theband.com":                Private,
+	"isa-geek.com":                       Private,
+	"isa-hockeynut.com":                  Private,
+	"issmarterthanyou.com":               Private,
+	"likes-pie.com":                      Private,
+	"likescandy.com":                     Private,
+	"neat-url.com":                       Private,
+	"saves-the-whales.com":               Private,
+	"selfip.com":                         Private,
+	"sells-for-less.com":                 Private,
+	"sells-for-u.com":                    Private,
+	"servebbs.com":                       Private,
+	"simple-url.com":                     Private,
+	"space-to-rent.com":                  Private,
+	"teaches-yoga.com":                   Private,
+	"writesthisblog.com":                 Private,
+	"ath.cx":                             Private,
+	"fuettertdasnetz.de":                 Private,
+	"isteingeek.de":                      Private,
+	"istmein.de":                         Private,
+	"lebtimnetz.de":                      Private,
+	"leitungsen.de":                      Private,
+	"traeumtgerade.de":                   Private,
+	"barrel-of-knowledge.info":           Private,
+	"barrell-of-knowledge.info":          Private,
+	"dyndns.info":                        Private,
+	"for-our.info":                       Private,
+	"groks-the.info":                     Private,
+	"groks-this.info":                    Private,
+	"here-for-more.info":                 Private,
+	"knowsitall.info":                    Private,
+	"selfip.info":                        Private,
+	"webhop.info":                        Private,
+	"forgot.her.name":                    Private,
+	"forgot.his.name":                    Private,
+	"at-band-camp.net":                   Private,
+	"blogdns.net":                        Private,
+	"broke-it.net":                       Private,
+	"buyshouses.net":                     Private,
+	"dnsalias.net":                       Private,
+	"dnsdojo.net":                        Private,
+	"does-it.net":                        Private,
+	"dontexist.net":                      Private,
+	"dynalias.net":                       Private,
+	"dynathome.net":                      Private,
+	"endofinternet.net":                  Private,
+	"from-az.net":                        Private,
+	"from-co.net":                        Private,
+	"from-la.net":                        Private,
+	"from-ny.net":                        Private,
+	"gets-it.net":                        Private,
+	"ham-radio-op.net":                   Private,
+	"homeftp.net":                        Private,
+	"homeip.net":                         Private,
+	"homelinux.net":                      Private,
+	"homeunix.net":                       Private,
+	"in-the-band.net":                    Private,
+	"is-a-chef.net":                      Private,
+	"is-a-geek.net":                      Private,
+	"isa-geek.net":                       Private,
+	"kicks-ass.net":                      Private,
+	"office-on-the.net":                  Private,
+	"podzone.net":                        Private,
+	"scrapper-site.net":                  Private,
+	"selfip.net":                         Private,
+	"sells-it.net":                       Private,
+	"servebbs.net":                       Private,
+	"serveftp.net":                       Private,
+	"thruhere.net":                       Private,
+	"webhop.net":                         Private,
+	"merseine.nu":                        Private,
+	"mine.nu":                            Private,
+	"shacknet.nu":                        Private,
+	"blogdns.org":                        Private,
+	"blogsite.org":                       Private,
+	"boldlygoingnowhere.org":             Private,
+	"dnsalias.org":                       Private,
+	"dnsdojo.org":                        Private,
+	"doesntexist.org":                    Private,
+	"dontexist.org":                      Private,
+	"doomdns.org":                        Private,
+	"dvrdns.org":                         Private,
+	"dynalias.org":                       Private,
+	"dyndns.org":                         Private,
+	"go.dyndns.org":                      Private,
+	"home.dyndns.org":                    Private,
+	"endofinternet.org":                  Private,
+	"endoftheinternet.org":               Private,
+	"from-me.org":                        Private,
+	"game-host.org":                      Private,
+	"gotdns.org":                         Private,
+	"hobby-site.org":                     Private,
+	"homedns.org":                        Private,
+	"homeftp.org":                        Private,
+	"homelinux.org":                      Private,
+	"homeunix.org":                       Private,
+	"is-a-bruinsfan.org":                 Private,
+	"is-a-candidate.org":                 Private,
+	"is-a-celticsfan.org":                Private,
+	"is-a-chef.org":                      Private,
+	"is-a-geek.org":                      Private,
+	"is-a-knight.org":                    Private,
+	"is-a-linux-user.org":                Private,
+	"is-a-patsfan.org":                   Private,
+	"is-a-soxfan.org":                    Private,
+	"is-found.org":                       Private,
+	"is-lost.org":                        Private,
+	"is-saved.org":                       Private,
+	"is-very-bad.org":                    Private,
+	"is-very-evil.org":                   Private,
+	"is-very-good.org":                   Private,
+	"is-very-nice.org":                   Private,
+	"is-very-sweet.org":                  Private,
+	"isa-geek.org":                       Private,
+	"kicks-ass.org":                      Private,
+	"misconfused.org":                    Private,
+	"podzone.org":                        Private,
+	"readmyblog.org":                     Private,
+	"selfip.org":                         Private,
+	"sellsyourhome.org":                  Private,
+	"servebbs.org":                       Private,
+	"serveftp.org":                       Private,
+	"servegame.org":                      Private,
+	"stuff-4-sale.org":                   Private,
+	"webhop.org":                         Private,
+	"better-than.tv":                     Private,
+	"dyndns.tv":                          Private,
+	"on-the-web.tv":                      Private,
+	"worse-than.tv":                      Private,
+	"is-by.us":                           Private,
+	"land-4-sale.us":                     Private,
+	"stuff-4-sale.us":                    Private,
+	"dyndns.ws":                          Private,
+	"mypets.ws":                          Private,
+	"1cooldns.com":                       Private,
+	"bumbleshrimp.com":                   Private,
+	"ddnsfree.com":                       Private,
+	"ddnsgeek.com":                       Private,
+	"ddnsguru.com":                       Private,
+	"dynuddns.com":                       Private,
+	"dynuhosting.com":                    Private,
+	"giize.com":                          Private,
+	"gleeze.com":                         Private,
+	"kozow.com":                          Private,
+	"loseyourip.com":                     Private,
+	"ooguy.com":                          Private,
+	"pivohosting.com":                    Private,
+	"theworkpc.com":                      Private,
+	"wiredbladehosting.com":              Private,
+	"casacam.net":                        Private,
+	"dynu.net":                           Private,
+	"dynuddns.net":                       Private,
+	"mysynology.net":                     Private,
+	"opik.net":                           Private,
+	"spryt.net":                          Private,
+	"accesscam.org":                      Private,
+	"camdvr.org":                         Private,
+	"freeddns.org":                       Private,
+	"mywire.org":                         Private,
+	"roxa.org":                           Private,
+	"webredirect.org":                    Private,
+	"myddns.rocks":                       Private,
+	"dynv6.net":                          Private,
+	"e4.cz":                              Private,
+	"easypanel.app":                      Private,
+	"easypanel.host":                     Private,
+	"*.ewp.live":                         Private,
+	"twmail.cc":                          Private,
+	"twmail.net":                         Private,
+	"twmail.org":                         Private,
+	"mymailer.com.tw":                    Private,
+	"url.tw":                             Private,
+	"at.emf.camp":                        Private,
+	"rt.ht":                              Private,
+	"elementor.cloud":                    Private,
+	"elementor.cool":                     Private,
+	"emergent.cloud":                     Private,
+	"preview.emergentagent.com":          Private,
+	"emergent.host":                      Private,
+	"mytuleap.com":                       Private,
+	"tuleap-partners.com":                Private,
+	"encr.app":                           Private,
+	"frontend.encr.app":                  Private,
+	"encoreapi.com":                      Private,
+	"lp.dev":                             Private,
+	"api.lp.dev":                         Private,
+	"objects.lp.dev":                     Private,
+	"eu.encoway.cloud":                   Private,
+	"eu.org":                             Private,
+	"al.eu.org":                          Private,
+	"asso.eu.org":                        Private,
+	"at.eu.org":                          Private,
+	"au.eu.org":                          Private,
+	"be.eu.org":                          Private,
+	"bg.eu.org":                          Private,
+	"ca.eu.org":                          Private,
+	"cd.eu.org":                          Private,
+	"ch.eu.org":                          Private,
+	"cn.eu.org":                          Private,
+	"cy.eu.org":                          Private,
+	"cz.eu.org":                          Private,
+	"de.eu.org":                          Private,
+	"dk.eu.org":                          Private,
+	"edu.eu.org":                         Private,
+	"ee.eu.org":                          Private,
+	"es.eu.org":                          Private,
+	"fi.eu.org":                          Private,
+	"fr.eu.org":                          Private,
+	"gr.eu.org":                          Private,
+	"hr.eu.org":                          Private,
+	"hu.eu.org":                          Private,
+	"ie.eu.org":                          Private,
+	"il.eu.org":                          Private,
+	"in.eu.org":                          Private,
+	"int.eu.org":                         Private,
+	"is.eu.org":                          Private,
+	"it.eu.org":                          Private,
+	"jp.eu.org":                          Private,
+	"kr.eu.org":                          Private,
+	"lt.eu.org":                          Private,
+	"lu.eu.org":                          Private,
+	"lv.eu.org":                          Private,
+	"me.eu.org":                          Private,
+	"mk.eu.org":                          Private,
+	"mt.eu.org":                          Private,
+	"my.eu.org":                          Private,
+	"net.eu.org":                         Private,
+	"ng.eu.org":                          Private,
+	"nl.eu.org":                          Private,
+	"no.eu.org":                          Private,
+	"nz.eu.org":                          Private,
+	"pl.eu.org":                          Private,
+	"pt.eu.org":                          Private,
+	"ro.eu.org":                          Private,
+	"ru.eu.org":                          Private,
+	"se.eu.org":                          Private,
+	"si.eu.org":                          Private,
+	"sk.eu.org":                          Private,
+	"tr.eu.org":                          Private,
+	"uk.eu.org":                          Private,
+	"us.eu.org":                          Private,
+	"eurodir.ru":                         Private,
+	"eu-1.evennode.com":                  Private,
+	"eu-2.evennode.com":                  Private,
+	"eu-3.evennode.com":                  Private,
+	"eu-4.evennode.com":                  Private,
+	"us-1.evennode.com":                  Private,
+	"us-2.evennode.com":                  Private,
+	"us-3.evennode.com":                  Private,
+	"us-4.evennode.com":                  Private,
+	"relay.evervault.app":                Private,
+	"relay.evervault.dev":                Private,
+	"expo.app":                           Private,
+	"staging.expo.app":                   Private,
+	"onfabrica.com":                      Private,
+	"ru.net":                             Private,
+	"adygeya.ru":                         Private,
+	"bashkiria.ru":                       Private,
+	"bir.ru":                             Private,
+	"cbg.ru":                             Private,
+	"com.ru":                             Private,
+	"dagestan.ru":                        Private,
+	"grozny.ru":                          Private,
+	"kalmykia.ru":                        Private,
+	"kustanai.ru":                        Private,
+	"marine.ru":                          Private,
+	"mordovia.ru":                        Private,
+	"msk.ru":                             Private,
+	"mytis.ru":                           Private,
+	"nalchik.ru":                         Private,
+	"nov.ru":                             Private,
+	"pyatigorsk.ru":                      Private,
+	"spb.ru":                             Private,
+	"vladikavkaz.ru":                     Private,
+	"vladimir.ru":                        Private,
+	"abkhazia.su":                        Private,
+	"adygeya.su":                         Private,
+	"aktyubinsk.su":                      Private,
+	"arkhangelsk.su":                     Private,
+	"armenia.su":                         Private,
+	"ashgabad.su":                        Private,
+	"azerbaijan.su":                      Private,
+	"balashov.su":                        Private,
+	"bashkiria.su":                       Private,
+	"bryansk.su":                         Private,
+	"bukhara.su":                         Private,
+	"chimkent.su":                        Private,
+	"dagestan.su":                        Private,
+	"east-kazakhstan.su":                 Private,
+	"exnet.su":                           Private,
+	"georgia.su":                         Private,
+	"grozny.su":                          Private,
+	"ivanovo.su":                         Private,
+	"jambyl.su":                          Private,
+	"kalmykia.su":                        Private,
+	"kaluga.su":                          Private,
+	"karacol.su":                         Private,
+	"karaganda.su":                       Private,
+	"karelia.su":                         Private,
+	"khakassia.su":                       Private,
+	"krasnodar.su":                       Private,
+	"kurgan.su":                          Private,
+	"kustanai.su":                        Private,
+	"lenug.su":                           Private,
+	"mangyshlak.su":                      Private,
+	"mordovia.su":                        Private,
+	"msk.su":                             Private,
+	"murmansk.su":                        Private,
+	"nalchik.su":                         Private,
+	"navoi.su":                           Private,
+	"north-kazakhstan.su":                Private,
+	"nov.su":                             Private,
+	"obninsk.su":                         Private,
+	"penza.su":                           Private,
+	"pokrovsk.su":                        Private,
+	"sochi.su":                           Private,
+	"spb.su":                             Private,
+	"tashkent.su":                        Private,
+	"termez.su":                          Private,
+	"togliatti.su":                       Private,
+	"troitsk.su":                         Private,
+	"tselinograd.su":                     Private,
+	"tula.su":                            Private,
+	"tuva.su":                            Private,
+	"vladikavkaz.su":                     Private,
+	"vladimir.su":                        Private,
+	"vologda.su":                         Private,
+	"channelsdvr.net":                    Private,
+	"u.channelsdvr.net":                  Private,
+	"edgecompute.app":                    Private,
+	"fastly-edge.com":                    Private,
+	"fastly-terrarium.com":               Private,
+	"freetls.fastly.net":                 Private,
+	"map.fastly.net":                     Private,
+	"a.prod.fastly.net":                  Private,
+	"global.prod.fastly.net":             Private,
+	"a.ssl.fastly.net":                   Private,
+	"b.ssl.fastly.net":                   Private,
+	"global.ssl.fastly.net":              Private,
+	"fastlylb.net":                       Private,
+	"map.fastlylb.net":                   Private,
+	"*.user.fm":                          Private,
+	"fastvps-server.com":                 Private,
+	"fastvps.host":                       Private,
+	"myfast.host":                        Private,
+	"fastvps.site":                       Private,
+	"myfast.space":                       Private,
+	"conn.uk":                            Private,
+	"copro.uk":                           Private,
+	"hosp.uk":                            Private,
+	"fedorainfracloud.org":               Private,
+	"fedorapeople.org":                   Private,
+	"cloud.fedoraproject.org":            Private,
+	"app.os.fedoraproject.org":           Private,
+	"app.os.stg.fedoraproject.org":       Private,
+	"mydobiss.com":                       Private,
+	"fh-muenster.io":                     Private,
+	"figma.site":                         Private,
+	"figma-gov.site":                     Private,
+	"preview.site":                       Private,
+	"filegear.me":                        Private,
+	"firebaseapp.com":                    Private,
+	"fldrv.com":                          Private,
+	"on-fleek.app":                       Private,
+	"flutterflow.app":                    Private,
+	"sprites.app":                        Private,
+	"fly.dev":                            Private,
+	"e2b.app":                            Private,
+	"framer.ai":                          Private,
+	"framer.app":                         Private,
+	"framercanvas.com":                   Private,
+	"framer.media":                       Private,
+	"framer.photos":                      Private,
+	"framer.website":                     Private,
+	"framer.wiki":                        Private,
+	"*.0e.vc":                            Private,
+	"freebox-os.com":                     Private,
+	"freeboxos.com":                      Private,
+	"fbx-os.fr":                          Private,
+	"fbxos.fr":                           Private,
+	"freebox-os.fr":                      Private,
+	"freeboxos.fr":                       Private,
+	"freedesktop.org":                    Private,
+	"freemyip.com":                       Private,
+	"*.frusky.de":                        Private,
+	"wien.funkfeuer.at":                  Private,
+	"daemon.asia":                        Private,
+	"dix.asia":                           Private,
+	"mydns.bz":                           Private,
+	"0am.jp":                             Private,
+	"0g0.jp":                             Private,
+	"0j0.jp":                             Private,
+	"0t0.jp":                             Private,
+	"mydns.jp":                           Private,
+	"pgw.jp":                             Private,
+	"wjg.jp":                             Private,
+	"keyword-on.net":                     Private,
+	"live-on.net":                        Private,
+	"server-on.net":                      Private,
+	"mydns.tw":                           Private,
+	"mydns.vc":                           Private,
+	"*.futurecms.at":                     Private,
+	"*.ex.futurecms.at":                  Private,
+	"*.in.futurecms.at":                  Private,
+	"futurehosting.at":                   Private,
+	"futuremailing.at":                   Private,
+	"*.ex.ortsinfo.at":                   Private,
+	"*.kunden.ortsinfo.at":               Private,
+	"*.statics.cloud":                    Private,
+	"gadget.app":                         Private,
+	"gadget.host":                        Private,
+	"aliases121.com":                     Private,
+	"campaign.gov.uk":                    Private,
+	"service.gov.uk":                     Private,
+	"independent-commission.uk":          Private,
+	"independent-inquest.uk":             Private,
+	"independent-inquiry.uk":             Private,
+	"independent-panel.uk":               Private,
+	"independent-review.uk":              Private,
+	"public-inquiry.uk":                  Private,
+	"royal-commission.uk":                Private,
+	"gehirn.ne.jp":                       Private,
+	"usercontent.jp":                     Private,
+	"gentapps.com":                       Private,
+	"gentlentapis.com":                   Private,
+	"cdn-edges.net":                      Private,
+	"gsj.bz":                             Private,
+	"gitbook.io":                         Private,
+	"github.app":                         Private,
+	"githubusercontent.com":              Private,
+	"githubpreview.dev":                  Private,
+	"github.io":                          Private,
+	"gitlab.io":                          Private,
+	"gitapp.si":                          Private,
+	"gitpage.si":                         Private,
+	"nog.community":                      Private,
+	"co.ro":                              Private,
+	"shop.ro":                            Private,
+	"lolipop.io":                         Private,
+	"angry.jp":                           Private,
+	"babyblue.jp":                        Private,
+	"babymilk.jp":                        Private,
+	"backdrop.jp":                        Private,
+	"bambina.jp":                         Private,
+	"bitter.jp":                          Private,
+	"blush.jp":                           Private,
+	"boo.jp":                             Private,
+	"boy.jp":                             Private,
+	"boyfriend.jp":                       Private,
+	"but.jp":                             Private,
+	"candypop.jp":                        Private,
+	"capoo.jp":                           Private,
+	"catfood.jp":                         Private,
+	"cheap.jp":                           Private,
+	"chicappa.jp":                        Private,
+	"chillout.jp":                        Private,
+	"chips.jp":                           Private,
+	"chowder.jp":                         Private,
+	"chu.jp":                             Private,
+	"ciao.jp":                            Private,
+	"cocotte.jp":                         Private,
+	"coolblog.jp":                        Private,
+	"cranky.jp":                          Private,
+	"cutegirl.jp":                        Private,
+	"daa.jp":                             Private,
+	"deca.jp":                            Private,
+	"deci.jp":                            Private,
+	"digick.jp":                          Private,
+	"egoism.jp":                          Private,
+	"fakefur.jp":                         Private,
+	"fem.jp":                             Private,
+	"flier.jp":                           Private,
+	"floppy.jp":                          Private,
+	"fool.jp":                            Private,
+	"frenchkiss.jp":                      Private,
+	"girlfriend.jp":                      Private,
+	"girly.jp":                           Private,
+	"gloomy.jp":                          Private,
+	"gonna.jp":                           Private,
+	"greater.jp":                         Private,
+	"hacca.jp":                           Private,
+	"heavy.jp":                           Private,
+	"her.jp":                             Private,
+	"hiho.jp":                            Private,
+	"hippy.jp":                           Private,
+	"holy.jp":                            Private,
+	"hungry.jp":                          Private,
+	"icurus.jp":                          Private,
+	"itigo.jp":                           Private,
+	"jellybean.jp":                       Private,
+	"kikirara.jp":                        Private,
+	"kill.jp":                            Private,
+	"kilo.jp":                            Private,
+	"kuron.jp":                           Private,
+	"littlestar.jp":                      Private,
+	"lolipopmc.jp":                       Private,
+	"lolitapunk.jp":                      Private,
+	"lomo.jp":                            Private,
+	"lovepop.jp":                         Private,
+	"lovesick.jp":                        Private,
+	"main.jp":                            Private,
+	"mods.jp":                            Private,
+	"mond.jp":                            Private,
+	"mongolian.jp":                       Private,
+	"moo.jp":                             Private,
+	"namaste.jp":                         Private,
+	"nikita.jp":                          Private,
+	"nobushi.jp":                         Private,
+	"noor.jp":                            Private,
+	"oops.jp":                            Private,
+	"parallel.jp":                        Private,
+	"parasite.jp":                        Private,
+	"pecori.jp":                          Private,
+	"peewee.jp":                          Private,
+	"penne.jp":                           Private,
+	"pepper.jp":                          Private,
+	"perma.jp":                           Private,
+	"pigboat.jp":                         Private,
+	"pinoko.jp":                          Private,
+	"punyu.jp":                           Private,
+	"pupu.jp":                            Private,
+	"pussycat.jp":                        Private,
+	"pya.jp":                             Private,
+	"raindrop.jp":                        Private,
+	"readymade.jp":                       Private,
+	"sadist.jp":                          Private,
+	"schoolbus.jp":                       Private,
+	"secret.jp":                          Private,
+	"staba.jp":                           Private,
+	"stripper.jp":                        Private,
+	"sub.jp":                             Private,
+	"sunnyday.jp":                        Private,
+	"thick.jp":                           Private,
+	"tonkotsu.jp":                        Private,
+	"under.jp":                           Private,
+	"upper.jp":                           Private,
+	"velvet.jp":                          Private,
+	"verse.jp":                           Private,
+	"versus.jp":                          Private,
+	"vivian.jp":                          Private,
+	"watson.jp":                          Private,
+	"weblike.jp":                         Private,
+	"whitesnow.jp":                       Private,
+	"zombie.jp":                          Private,
+	"heteml.net":                         Private,
+	"graphic.design":                     Private,
+	"goip.de":                            Private,
+	"*.hosted.app":                       Private,
+	"*.run.app":                          Private,
+	"*.mtls.run.app":                     Private,
+	"web.app":                            Private,
+	"*.0emm.com":                         Private,
+	"appspot.com":                        Private,
+	"*.r.appspot.com":                    Private,
+	"blogspot.com":                       Private,
+	"codespot.com":                       Private,
+	"googleapis.com":                     Private,
+	"googlecode.com":                     Private,
+	"pagespeedmobilizer.com":             Private,
+	"withgoogle.com":                     Private,
+	"withyoutube.com":                    Private,
+	"*.gateway.dev":                      Private,
+	"cloud.goog":                         Private,
+	"translate.goog":                     Private,
+	"*.usercontent.goog":                 Private,
+	"cloudfunctions.net":                 Private,
+	"goupile.fr":                         Private,
+	"pymnt.uk":                           Private,
+	"cloudapps.digital":                  Private,
+	"london.cloudapps.digital":           Private,
+	"gov.nl":                             Private,
+	"grafana-dev.net":                    Private,
+	"grayjayleagues.com":                 Private,
+	"grebedoc.dev":                       Private,
+	"xn--gnstigbestellen-zvb.de":         Private,
+	"xn--gnstigliefern-wob.de":           Private,
+	"gv.uy":                              Private,
+	"hackclub.app":                       Private,
+	"xn--hkkinen-5wa.fi":                 Private,
+	"hashbang.sh":                        Private,
+	"hasura.app":                         Private,
+	"hasura-app.io":                      Private,
+	"hatenablog.com":                     Private,
+	"hatenadiary.com":                    Private,
+	"hateblo.jp":                         Private,
+	"hatenablog.jp":                      Private,
+	"hatenadiary.jp":                     Private,
+	"hatenadiary.org":                    Private,
+	"pages.it.hs-heilbronn.de":           Private,
+	"pages-research.it.hs-heilbronn.de":  Private,
+	"heiyu.space":                        Private,
+	"helioho.st":                         Private,
+	"heliohost.us":                       Private,
+	"hepforge.org":                       Private,
+	"onhercules.app":                     Private,
+	"hercules-app.com":                   Private,
+	"hercules-dev.com":                   Private,
+	"herokuapp.com":                      Private,
+	"heyflow.page":                       Private,
+	"heyflow.site":                       Private,
+	"ravendb.cloud":                      Private,
+	"ravendb.community":                  Private,
+	"development.run":                    Private,
+	"ravendb.run":                        Private,
+	"hidns.co":                           Private,
+	"hidns.vip":                          Private,
+	"homesklep.pl":                       Private,
+	"*.kin.one":                          Private,
+	"*.id.pub":                           Private,
+	"*.kin.pub":                          Private,
+	"hoplix.shop":                        Private,
+	"orx.biz":                            Private,
+	"biz.ng":                             Private,
+	"co.biz.ng":                          Private,
+	"dl.biz.ng":                          Private,
+	"go.biz.ng":                          Private,
+	"lg.biz.ng":                          Private,
+	"on.biz.ng":                          Private,
+	"col.ng":                             Private,
+	"firm.ng":                            Private,
+	"gen.ng":                             Private,
+	"ltd.ng":                             Private,
+	"ngo.ng":                             Private,
+	"plc.ng":                             Private,
+	"hostyhosting.io":                    Private,
+	"hf.space":                           Private,
+	"static.hf.space":                    Private,
+	"hypernode.io":                       Private,
+	"iobb.net":                           Private,
+	"co.cz":                              Private,
+	"*.moonscale.io":                     Private,
+	"moonscale.net":                      Private,
+	"gr.com":                             Private,
+	"iki.fi":                             Private,
+	"ibxos.it":                           Private,
+	"iliadboxos.it":                      Private,
+	"imagine.diy":                        Private,
+	"imagine-proxy.work":                 Private,
+	"smushcdn.com":                       Private,
+	"wphostedmail.com":                   Private,
+	"wpmucdn.com":                        Private,
+	"tempurl.host":                       Private,
+	"wpmudev.host":                       Private,
+	"dyn-berlin.de":                      Private,
+	"in-berlin.de":                       Private,
+	"in-brb.de":                          Private,
+	"in-butter.de":                       Private,
+	"in-dsl.de":                          Private,
+	"in-vpn.de":                          Private,
+	"in-dsl.net":                         Private,
+	"in-vpn.net":                         Private,
+	"in-dsl.org":                         Private,
+	"in-vpn.org":                         Private,
+	"oninferno.net":                      Private,
+	"biz.at":                             Private,
+	"info.at":                            Private,
+	"info.cx":                            Private,
+	"ac.leg.br":                          Private,
+	"al.leg.br":                          Private,
+	"am.leg.br":                          Private,
+	"ap.leg.br":                          Private,
+	"ba.leg.br":                          Private,
+	"ce.leg.br":                          Private,
+	"df.leg.br":                          Private,
+	"es.leg.br":                          Private,
+	"go.leg.br":                          Private,
+	"ma.leg.br":                          Private,
+	"mg.leg.br":                          Private,
+	"ms.leg.br":                          Private,
+	"mt.leg.br":                          Private,
+	"pa.leg.br":                          Private,
+	"pb.leg.br":                          Private,
+	"pe.leg.br":                          Private,
+	"pi.leg.br":                          Private,
+	"pr.leg.br":                          Private,
+	"rj.leg.br":                          Private,
+	"rn.leg.br":                          Private,
+	"ro.leg.br":                          Private,
+	"rr.leg.br":                          Private,
+	"rs.leg.br":                          Private,
+	"sc.leg.br":                          Private,
+	"se.leg.br":                          Private,
+	"sp.leg.br":                          Private,
+	"to.leg.br":                          Private,
+	"pixolino.com":                       Private,
+	"na4u.ru":                            Private,
+	"botdash.app":                        Private,
+	"botdash.dev":                        Private,
+	"botdash.gg":                         Private,
+	"botdash.net":                        Private,
+	"botda.sh":                           Private,
+	"botdash.xyz":                        Private,
+	"apps-1and1.com":                     Private,
+	"live-website.com":                   Private,
+	"webspace-host.com":                  Private,
+	"apps-1and1.net":                     Private,
+	"websitebuilder.online":              Private,
+	"app-ionos.space":                    Private,
+	"iopsys.se":                          Private,
+	"*.inbrowser.dev":                    Private,
+	"*.dweb.link":                        Private,
+	"*.inbrowser.link":                   Private,
+	"ipifony.net":                        Private,
+	"ir.md":                              Private,
+	"is-a-good.dev":                      Private,
+	"iservschule.de":                     Private,
+	"mein-iserv.de":                      Private,
+	"schuldock.de":                       Private,
+	"schulplattform.de":                  Private,
+	"schulserver.de":                     Private,
+	"test-iserv.de":                      Private,
+	"iserv.dev":                          Private,
+	"iserv.host":                         Private,
+	"ispmanager.name":                    Private,
+	"mel.cloudlets.com.au":               Private,
+	"cloud.interhostsolutions.be":        Private,
+	"alp1.ae.flow.ch":                    Private,
+	"appengine.flow.ch":                  Private,
+	"es-1.axarnet.cloud":                 Private,
+	"diadem.cloud":                       Private,
+	"vip.jelastic.cloud":                 Private,
+	"jele.cloud":                         Private,
+	"it1.eur.aruba.jenv-aruba.cloud":     Private,
+	"it1.jenv-aruba.cloud":               Private,
+	"keliweb.cloud":                      Private,
+	"cs.keliweb.cloud":                   Private,
+	"oxa.cloud":                          Private,
+	"tn.oxa.cloud":                       Private,
+	"uk.oxa.cloud":                       Private,
+	"primetel.cloud":                     Private,
+	"uk.primetel.cloud":                  Private,
+	"ca.reclaim.cloud":                   Private,
+	"uk.reclaim.cloud":                   Private,
+	"us.reclaim.cloud":                   Private,
+	"ch.trendhosting.cloud":              Private,
+	"de.trendhosting.cloud":              Private,
+	"jele.club":                          Private,
+	"dopaas.com":                         Private,
+	"paas.hosted-by-previder.com":        Private,
+	"rag-cloud.hosteur.com":              Private,
+	"rag-cloud-ch.hosteur.com":           Private,
+	"jcloud.ik-server.com":               Private,
+	"jcloud-ver-jpc.ik-server.com":       Private,
+	"demo.jelastic.com":                  Private,
+	"paas.massivegrid.com":               Private,
+	"jed.wafaicloud.com":                 Private,
+	"ryd.wafaicloud.com":                 Private,
+	"j.scaleforce.com.cy":                Private,
+	"jelastic.dogado.eu":                 Private,
+	"fi.cloudplatform.fi":                Private,
+	"demo.datacenter.fi":                 Private,
+	"paas.datacenter.fi":                 Private,
+	"jele.host":                          Private,
+	"mircloud.host":                      Private,
+	"paas.beebyte.io":                    Private,
+	"sekd1.beebyteapp.io":                Private,
+	"jele.io":                            Private,
+	"jc.neen.it":                         Private,
+	"jcloud.kz":                          Private,
+	"cloudjiffy.net":                     Private,
+	"fra1-de.cloudjiffy.net":             Private,
+	"west1-us.cloudjiffy.net":            Private,
+	"jls-sto1.elastx.net":                Private,
+	"jls-sto2.elastx.net":                Private,
+	"jls-sto3.elastx.net":                Private,
+	"fr-1.paas.massivegrid.net":          Private,
+	"lon-1.paas.massivegrid.net":         Private,
+	"lon-2.paas.massivegrid.net":         Private,
+	"ny-1.paas.massivegrid.net":          Private,
+	"ny-2.paas.massivegrid.net":          Private,
+	"sg-1.paas.massivegrid.net":          Private,
+	"jelastic.saveincloud.net":           Private,
+	"nordeste-idc.saveincloud.net":       Private,
+	"j.scaleforce.net":                   Private,
+	"sdscloud.pl":                        Private,
+	"unicloud.pl":                        Private,
+	"mircloud.ru":                        Private,
+	"enscaled.sg":                        Private,
+	"jele.site":                          Private,
+	"jelastic.team":                      Private,
+	"orangecloud.tn":                     Private,
+	"j.layershift.co.uk":                 Private,
+	"phx.enscaled.us":                    Private,
+	"mircloud.us":                        Private,
+	"myjino.ru":                          Private,
+	"*.hosting.myjino.ru":                Private,
+	"*.landing.myjino.ru":                Private,
+	"*.spectrum.myjino.ru":               Private,
+	"*.vps.myjino.ru":                    Private,
+	"jote.cloud":                         Private,
+	"jotelulu.cloud":                     Private,
+	"eu1-plenit.com":                     Private,
+	"la1-plenit.com":                     Private,
+	"us1-plenit.com":                     Private,
+	"webadorsite.com":                    Private,
+	"jouwweb.site":                       Private,
+	"*.triton.zone":                      Private,
+	"js.org":                             Private,
+	"kaas.gg":                            Private,
+	"khplay.nl":                          Private,
+	"kapsi.fi":                           Private,
+	"kdns.fr":                            Private,
+	"ezproxy.kuleuven.be":                Private,
+	"kuleuven.cloud":                     Private,
+	"keenetic.io":                        Private,
+	"keenetic.link":                      Private,
+	"keenetic.name":                      Private,
+	"keenetic.pro":                       Private,
+	"ae.kg":                              Private,
+	"keymachine.de":                      Private,
+	"kiloapps.ai":                        Private,
+	"kiloapps.io":                        Private,
+	"kinghost.net":                       Private,
+	"uni5.net":                           Private,
+	"knightpoint.systems":                Private,
+	"koobin.events":                      Private,
+	"webthings.io":                       Private,
+	"krellian.net":                       Private,
+	"oya.to":                             Private,
+	"co.de":                              Private,
+	"shiptoday.app":                      Private,
+	"shiptoday.build":                    Private,
+	"laravel.cloud":                      Private,
+	"on-forge.com":                       Private,
+	"on-vapor.com":                       Private,
+	"git-repos.de":                       Private,
+	"lcube-server.de":                    Private,
+	"svn-repos.de":                       Private,
+	"leadpages.co":                       Private,
+	"lpages.co":                          Private,
+	"lpusercontent.com":                  Private,
+	"leapcell.app":                       Private,
+	"leapcell.dev":                       Private,
+	"leapcell.online":                    Private,
+	"liara.run":                          Private,
+	"iran.liara.run":                     Private,
+	"libp2p.direct":                      Private,
+	"runcontainers.dev":                  Private,
+	"co.business":                        Private,
+	"co.education":                       Private,
+	"co.events":                          Private,
+	"co.financial":                       Private,
+	"co.network":                         Private,
+	"co.place":                           Private,
+	"co.technology":                      Private,
+	"linkyard-cloud.ch":                  Private,
+	"linkyard.cloud":                     Private,
+	"members.linode.com":                 Private,
+	"*.nodebalancer.linode.com":          Private,
+	"*.linodeobjects.com":                Private,
+	"ip.linodeusercontent.com":           Private,
+	"we.bs":                              Private,
+	"filegear-sg.me":                     Private,
+	"ggff.net":                           Private,
+	"*.user.localcert.dev":               Private,
+	"localtonet.com":                     Private,
+	"*.localto.net":                      Private,
+	"lodz.pl":                            Private,
+	"pabianice.pl":                       Private,
+	"plock.pl":                           Private,
+	"sieradz.pl":                         Private,
+	"skierniewice.pl":                    Private,
+	"zgierz.pl":                          Private,
+	"loginline.app":                      Private,
+	"loginline.dev":                      Private,
+	"loginline.io":                       Private,
+	"loginline.services":                 Private,
+	"loginline.site":                     Private,
+	"lohmus.me":                          Private,
+	"lovable.app":                        Private,
+	"lovableproject.com":                 Private,
+	"lovable.run":                        Private,
+	"lovable.sh":                         Private,
+	"krasnik.pl":                         Private,
+	"leczna.pl":                          Private,
+	"lubartow.pl":                        Private,
+	"lublin.pl":                          Private,
+	"poniatowa.pl":                       Private,
+	"swidnik.pl":                         Private,
+	"glug.org.uk":                        Private,
+	"lug.org.uk":                         Private,
+	"lugs.org.uk":                        Private,
+	"barsy.bg":                           Private,
+	"barsy.club":                         Private,
+	"barsycenter.com":                    Private,
+	"barsyonline.com":                    Private,
+	"barsy.de":                           Private,
+	"barsy.dev":                          Private,
+	"barsy.eu":                           Private,
+	"barsy.gr":                           Private,
+	"barsy.in":                           Private,
+	"barsy.info":                         Private,
+	"barsy.io":                           Private,
+	"barsy.me":                           Private,
+	"barsy.menu":                         Private,
+	"barsyonline.menu":                   Private,
+	"barsy.mobi":                         Private,
+	"barsy.net":                          Private,
+	"barsy.online":                       Private,
+	"barsy.org":                          Private,
+	"barsy.pro":                          Private,
+	"barsy.pub":                          Private,
+	"barsy.ro":                           Private,
+	"barsy.rs":                           Private,
+	"barsy.shop":                         Private,
+	"barsyonline.shop":                   Private,
+	"barsy.site":                         Private,
+	"barsy.store":                        Private,
+	"barsy.support":                      Private,
+	"barsy.uk":                           Private,
+	"barsy.co.uk":                        Private,
+	"barsyonline.co.uk":                  Private,
+	"*.lutrausercontent.com":             Private,
+	"luyani.app":                         Private,
+	"luyani.net":                         Private,
+	"*.magentosite.cloud":                Private,
+	"magicpatterns.app":                  Private,
+	"magicpatternsapp.com":               Private,
+	"hb.cldmail.ru":                      Private,
+	"matlab.cloud":                       Private,
+	"modelscape.com":                     Private,
+	"mwcloudnonprod.com":                 Private,
+	"polyspace.com":                      Private,
+	"mayfirst.info":                      Private,
+	"mayfirst.org":                       Private,
+	"mazeplay.com":                       Private,
+	"mcdir.me":                           Private,
+	"mcdir.ru":                           Private,
+	"vps.mcdir.ru":                       Private,
+	"mcpre.ru":                           Private,
+	"mediatech.by":                       Private,
+	"mediatech.dev":                      Private,
+	"hra.health":                         Private,
+	"medusajs.app":                       Private,
+	"miniserver.com":                     Private,
+	"memset.net":                         Private,
+	"messerli.app":                       Private,
+	"atmeta.com":                         Private,
+	"apps.fbsbx.com":                     Private,
+	"*.cloud.metacentrum.cz":             Private,
+	"custom.metacentrum.cz":              Private,
+	"flt.cloud.muni.cz":                  Private,
+	"usr.cloud.muni.cz":                  Private,
+	"meteorapp.com":                      Private,
+	"eu.meteorapp.com":                   Private,
+	"co.pl":                              Private,
+	"*.azurecontainer.io":                Private,
+	"azure-api.net":                      Private,
+	"azure-mobile.net":                   Private,
+	"azureedge.net":                      Private,
+	"azurefd.net":                        Private,
+	"azurestaticapps.net":                Private,
+	"1.azurestaticapps.net":              Private,
+	"2.azurestaticapps.net":              Private,
+	"3.azurestaticapps.net":              Private,
+	"4.azurestaticapps.net":              Private,
+	"5.azurestaticapps.net":              Private,
+	"6.azurestaticapps.net":              Private,
+	"7.azurestaticapps.net":              Private,
+	"centralus.azurestaticapps.net":      Private,
+	"eastasia.azurestaticapps.net":       Private,
+	"eastus2.azurestaticapps.net":        Private,
+	"westeurope.azurestaticapps.net":     Private,
+	"westus2.azurestaticapps.net":        Private,
+	"azurewebsites.net":                  Private,
+	"cloudapp.net":                       Private,
+	"trafficmanager.net":                 Private,
+	"blob.core.usgovcloudapi.net":        Private,
+	"file.core.usgovcloudapi.net":        Private,
+	"web.core.usgovcloudapi.net":         Private,
+	"servicebus.usgovcloudapi.net":       Private,
+	"usgovcloudapp.net":                  Private,
+	"usgovtrafficmanager.net":            Private,
+	"blob.core.windows.net":              Private,
+	"file.core.windows.net":              Private,
+	"web.core.windows.net":               Private,
+	"servicebus.windows.net":             Private,
+	"azure-api.us":                       Private,
+	"azurewebsites.us":                   Private,
+	"routingthecloud.com":                Private,
+	"sn.mynetname.net":                   Private,
+	"routingthecloud.net":                Private,
+	"routingthecloud.org":                Private,
+	"same-app.com":                       Private,
+	"same-preview.com":                   Private,
+	"csx.cc":                             Private,
+	"miren.app":                          Private,
+	"miren.systems":                      Private,
+	"mydbserver.com":                     Private,
+	"webspaceconfig.de":                  Private,
+	"mittwald.info":                      Private,
+	"mittwaldserver.info":                Private,
+	"typo3server.info":                   Private,
+	"project.space":                      Private,
+	"mocha.app":                          Private,
+	"mochausercontent.com":               Private,
+	"mocha-sandbox.dev":                  Private,
+	"modx.dev":                           Private,
+	"bmoattachments.org":                 Private,
+	"net.ru":                             Private,
+	"org.ru":                             Private,
+	"pp.ru":                              Private,
+	"my.be":                              Private,
+	"hostedpi.com":                       Private,
+	"caracal.mythic-beasts.com":          Private,
+	"customer.mythic-beasts.com":         Private,
+	"fentiger.mythic-beasts.com":         Private,
+	"lynx.mythic-beasts.com":             Private,
+	"ocelot.mythic-beasts.com":           Private,
+	"oncilla.mythic-beasts.com":          Private,
+	"onza.mythic-beasts.com":             Private,
+	"sphinx.mythic-beasts.com":           Private,
+	"vs.mythic-beasts.com":               Private,
+	"x.mythic-beasts.com":                Private,
+	"yali.mythic-beasts.com":             Private,
+	"cust.retrosnub.co.uk":               Private,
+	"ui.nabu.casa":                       Private,
+	"needle.run":                         Private,
+	"co.site":                            Private,
+	"cloud.nospamproxy.com":              Private,
+	"o365.cloud.nospamproxy.com":         Private,
+	"netlib.re":                          Private,
+	"netlify.app":                        Private,
+	"4u.com":                             Private,
+	"nfshost.com":                        Private,
+	"ipfs.nftstorage.link":               Private,
+	"ngo.us":                             Private,
+	"ngrok.app":                          Private,
+	"ngrok-free.app":                     Private,
+	"ngrok.dev":                          Private,
+	"ngrok-free.dev":                     Private,
+	"ngrok.io":                           Private,
+	"ap.ngrok.io":                        Private,
+	"au.ngrok.io":                        Private,
+	"eu.ngrok.io":                        Private,
+	"in.ngrok.io":                        Private,
+	"jp.ngrok.io":                        Private,
+	"sa.ngrok.io":                        Private,
+	"us.ngrok.io":                        Private,
+	"ngrok.pizza":                        Private,
+	"ngrok.pro":                          Private,
+	"torun.pl":                           Private,
+	"nh-serv.co.uk":                      Private,
+	"nimsite.uk":                         Private,
+	"mmafan.biz":                         Private,
+	"myftp.biz":                          Private,
+	"no-ip.biz":                          Private,
+	"no-ip.ca":                           Private,
+	"fantasyleague.cc":                   Private,
+	"gotdns.ch":                          Private,
+	"3utilities.com":                     Private,
+	"blogsyte.com":                       Private,
+	"ciscofreak.com":                     Private,
+	"damnserver.com":                     Private,
+	"ddnsking.com":                       Private,
+	"ditchyourip.com":                    Private,
+	"dnsiskinky.com":                     Private,
+	"dynns.com":                          Private,
+	"geekgalaxy.com":                     Private,
+	"health-carereform.com":              Private,
+	"homesecuritymac.com":                Private,
+	"homesecuritypc.com":                 Private,
+	"myactivedirectory.com":              Private,
+	"mysecuritycamera.com":               Private,
+	"myvnc.com":                          Private,
+	"net-freaks.com":                     Private,
+	"onthewifi.com":                      Private,
+	"point2this.com":                     Private,
+	"quicksytes.com":                     Private,
+	"securitytactics.com":                Private,
+	"servebeer.com":                      Private,
+	"servecounterstrike.com":             Private,
+	"serveexchange.com":                  Private,
+	"serveftp.com":                       Private,
+	"servegame.com":                      Private,
+	"servehalflife.com":                  Private,
+	"servehttp.com":                      Private,
+	"servehumour.com":                    Private,
+	"serveirc.com":                       Private,
+	"servemp3.com":                       Private,
+	"servep2p.com":                       Private,
+	"servepics.com":                      Private,
+	"servequake.com":                     Private,
+	"servesarcasm.com":                   Private,
+	"stufftoread.com":                    Private,
+	"unusualperson.com":                  Private,
+	"workisboring.com":                   Private,
+	"dvrcam.info":                        Private,
+	"ilovecollege.info":                  Private,
+	"no-ip.info":                         Private,
+	"brasilia.me":                        Private,
+	"ddns.me":                            Private,
+	"dnsfor.me":                          Private,
+	"hopto.me":                           Private,
+	"loginto.me":                         Private,
+	"noip.me":                            Private,
+	"webhop.me":                          Private,
+	"bounceme.net":                       Private,
+	"ddns.net":                           Private,
+	"eating-organic.net":                 Private,
+	"mydissent.net":                      Private,
+	"myeffect.net":                       Private,
+	"mymediapc.net":                      Private,
+	"mypsx.net":                          Private,
+	"mysecuritycamera.net":               Private,
+	"nhlfan.net":                         Private,
+	"no-ip.net":                          Private,
+	"pgafan.net":                         Private,
+	"privatizehealthinsurance.net":       Private,
+	"redirectme.net":                     Private,
+	"serveblog.net":                      Private,
+	"serveminecraft.net":                 Private,
+	"sytes.net":                          Private,
+	"cable-modem.org":                    Private,
+	"collegefan.org":                     Private,
+	"couchpotatofries.org":               Private,
+	"hopto.org":                          Private,
+	"mlbfan.org":                         Private,
+	"myftp.org":                          Private,
+	"mysecuritycamera.org":               Private,
+	"nflfan.org":                         Private,
+	"no-ip.org":                          Private,
+	"read-books.org":                     Private,
+	"ufcfan.org":                         Private,
+	"zapto.org":                          Private,
+	"no-ip.co.uk":                        Private,
+	"golffan.us":                         Private,
+	"noip.us":                            Private,
+	"pointto.us":                         Private,
+	"stage.nodeart.io":                   Private,
+	"*.developer.app":                    Private,
+	"noop.app":                           Private,
+	"*.northflank.app":                   Private,
+	"*.build.run":                        Private,
+	"*.code.run":                         Private,
+	"*.database.run":                     Private,
+	"*.migration.run":                    Private,
+	"noticeable.news":                    Private,
+	"notion.site":                        Private,
+	"dnsking.ch":                         Private,
+	"mypi.co":                            Private,
+	"myiphost.com":                       Private,
+	"forumz.info":                        Private,
+	"soundcast.me":                       Private,
+	"tcp4.me":                            Private,
+	"dnsup.net":                          Private,
+	"hicam.net":                          Private,
+	"now-dns.net":                        Private,
+	"ownip.net":                          Private,
+	"vpndns.net":                         Private,
+	"dynserv.org":                        Private,
+	"now-dns.org":                        Private,
+	"x443.pw":                            Private,
+	"ntdll.top":                          Private,
+	"freeddns.us":                        Private,
+	"nsupdate.info":                      Private,
+	"nerdpol.ovh":                        Private,
+	"prvcy.page":                         Private,
+	"observablehq.cloud":                 Private,
+	"static.observableusercontent.com":   Private,
+	"omg.lol":                            Private,
+	"cloudycluster.net":                  Private,
+	"omniwe.site":                        Private,
+	"123webseite.at":                     Private,
+	"123website.be":                      Private,
+	"simplesite.com.br":                  Private,
+	"123website.ch":                      Private,
+	"simplesite.com":                     Private,
+	"123webseite.de":                     Private,
+	"123hjemmeside.dk":                   Private,
+	"123miweb.es":                        Private,
+	"123kotisivu.fi":                     Private,
+	"123siteweb.fr":                      Private,
+	"simplesite.gr":                      Private,
+	"123homepage.it":                     Private,
+	"123website.lu":                      Private,
+	"123website.nl":                      Private,
+	"123hjemmeside.no":                   Private,
+	"service.one":                        Private,
+	"website.one":                        Private,
+	"simplesite.pl":                      Private,
+	"123paginaweb.pt":                    Private,
+	"123minsida.se":                      Private,
+	"onid.ca":                            Private,
+	"is-a-fullstack.dev":                 Private,
+	"is-cool.dev":                        Private,
+	"is-not-a.dev":                       Private,
+	"localplayer.dev":                    Private,
+	"is-local.org":                       Private,
+	"opensocial.site":                    Private,
+	"*.oaiusercontent.com":               Private,
+	"opencraft.hosting":                  Private,
+	"16-b.it":                            Private,
+	"32-b.it":                            Private,
+	"64-b.it":                            Private,
+	"orsites.com":                        Private,
+	"operaunite.com":                     Private,
+	"*.customer-oci.com":                 Private,
+	"*.oci.customer-oci.com":             Private,
+	"*.ocp.customer-oci.com":             Private,
+	"*.ocs.customer-oci.com":             Private,
+	"*.oraclecloudapps.com":              Private,
+	"*.oraclegovcloudapps.com":           Private,
+	"*.oraclegovcloudapps.uk":            Private,
+	"tech.orange":                        Private,
+	"can.re":                             Private,
+	"authgear-staging.com":               Private,
+	"authgearapps.com":                   Private,
+	"outsystemscloud.com":                Private,
+	"*.hosting.ovh.net":                  Private,
+	"*.webpaas.ovh.net":                  Private,
+	"ownprovider.com":                    Private,
+	"own.pm":                             Private,
+	"*.owo.codes":                        Private,
+	"ox.rs":                              Private,
+	"oy.lc":                              Private,
+	"pgfog.com":                          Private,
+	"pagexl.com":                         Private,
+	"gotpantheon.com":                    Private,
+	"pantheonsite.io":                    Private,
+	"*.paywhirl.com":                     Private,
+	"*.xmit.co":                          Private,
+	"xmit.dev":                           Private,
+	"madethis.site":                      Private,
+	"srv.us":                             Private,
+	"gh.srv.us":                          Private,
+	"gl.srv.us":                          Private,
+	"mypep.link":                         Private,
+	"perspecta.cloud":                    Private,
+	"forgeblocks.com":                    Private,
+	"id.forgerock.io":                    Private,
+	"support.site":                       Private,
+	"on-web.fr":                          Private,
+	"*.upsun.app":                        Private,
+	"upsunapp.com":                       Private,
+	"ent.platform.sh":                    Private,
+	"eu.platform.sh":                     Private,
+	"us.platform.sh":                     Private,
+	"*.platformsh.site":                  Private,
+	"*.tst.site":                         Private,
+	"pley.games":                         Private,
+	"onporter.run":                       Private,
+	"co.bn":                              Private,
+	"postman-echo.com":                   Private,
+	"pstmn.io":                           Private,
+	"mock.pstmn.io":                      Private,
+	"httpbin.org":                        Private,
+	"prequalifyme.today":                 Private,
+	"xen.prgmr.com":                      Private,
+	"priv.at":                            Private,
+	"c01.kr":                             Private,
+	"eliv-api.kr":                        Private,
+	"eliv-cdn.kr":                        Private,
+	"eliv-dns.kr":                        Private,
+	"mmv.kr":                             Private,
+	"vki.kr":                             Private,
+	"dev.project-study.com":              Private,
+	"protonet.io":                        Private,
+	"platter-app.dev":                    Private,
+	"e.id":                               Private,
+	"chirurgiens-dentistes-en-france.fr": Private,
+	"byen.site":                          Private,
+	"nyc.mn":                             Private,
+	"*.cn.st":                            Private,
+	"pubtls.org":                         Private,
+	"pythonanywhere.com":                 Private,
+	"eu.pythonanywhere.com":              Private,
+	"qa2.com":                            Private,
+	"qcx.io":                             Private,
+	"*.sys.qcx.io":                       Private,
+	"myqnapcloud.cn":                     Private,
+	"alpha-myqnapcloud.com":              Private,
+	"dev-myqnapcloud.com":                Private,
+	"mycloudnas.com":                     Private,
+	"mynascloud.com":                     Private,
+	"myqnapcloud.com":                    Private,
+	"qoto.io":                            Private,
+	"qualifioapp.com":                    Private,
+	"ladesk.com":                         Private,
+	"*.qualyhqpartner.com":               Private,
+	"*.qualyhqportal.com":                Private,
+	"qbuser.com":                         Private,
+	"*.quipelements.com":                 Private,
+	"vapor.cloud":                        Private,
+	"vaporcloud.io":                      Private,
+	"rackmaze.com":                       Private,
+	"rackmaze.net":                       Private,
+	"cloudsite.builders":                 Private,
+	"myradweb.net":                       Private,
+	"servername.us":                      Private,
+	"web.in":                             Private,
+	"in.net":                             Private,
+	"myrdbx.io":                          Private,
+	"site.rb-hosting.io":                 Private,
+	"up.railway.app":                     Private,
+	"*.on-rancher.cloud":                 Private,
+	"*.on-k3s.io":                        Private,
+	"*.on-rio.io":                        Private,
+	"ravpage.co.il":                      Private,
+	"readthedocs-hosted.com":             Private,
+	"readthedocs.io":                     Private,
+	"rhcloud.com":                        Private,
+	"instances.spawn.cc":                 Private,
+	"*.clusters.rdpa.co":                 Private,
+	"*.srvrless.rdpa.co":                 Private,
+	"onrender.com":                       Private,
+	"app.render.com":                     Private,
+	"replit.app":                         Private,
+	"id.replit.app":                      Private,
+	"firewalledreplit.co":                Private,
+	"id.firewalledreplit.co":             Private,
+	"repl.co":                            Private,
+	"id.repl.co":                         Private,
+	"replit.dev":                         Private,
+	"archer.replit.dev":                  Private,
+	"bones.replit.dev":                   Private,
+	"canary.replit.dev":                  Private,
+	"global.replit.dev":                  Private,
+	"hacker.replit.dev":                  Private,
+	"id.replit.dev":                      Private,
+	"janeway.replit.dev":                 Private,
+	"kim.replit.dev":                     Private,
+	"kira.replit.dev":                    Private,
+	"kirk.replit.dev":                    Private,
+	"odo.replit.dev":                     Private,
+	"paris.replit.dev":                   Private,
+	"picard.replit.dev":                  Private,
+	"pike.replit.dev":                    Private,
+	"prerelease.replit.dev":              Private,
+	"reed.replit.dev":                    Private,
+	"riker.replit.dev":                   Private,
+	"sisko.replit.dev":                   Private,
+	"spock.replit.dev":                   Private,
+	"staging.replit.dev":                 Private,
+	"sulu.replit.dev":                    Private,
+	"tarpit.replit.dev":                  Private,
+	"teams.replit.dev":                   Private,
+	"tucker.replit.dev":                  Private,
+	"wesley.replit.dev":                  Private,
+	"worf.replit.dev":                    Private,
+	"repl.run":                           Private,
+	"resindevice.io":                     Private,
+	"devices.resinstaging.io":            Private,
+	"hzc.io":                             Private,
+	"adimo.co.uk":                        Private,
+	"itcouldbewor.se":                    Private,
+	"aus.basketball":                     Private,
+	"nz.basketball":                      Private,
+	"subsc-pay.com":                      Private,
+	"subsc-pay.net":                      Private,
+	"git-pages.rit.edu":                  Private,
+	"rocky.page":                         Private,
+	"rub.de":                             Private,
+	"ruhr-uni-bochum.de":                 Private,
+	"io.noc.ruhr-uni-bochum.de":          Private,
+	"xn--90amc.xn--p1acf":                Private,
+	"xn--j1aef.xn--p1acf":                Private,
+	"xn--j1ael8b.xn--p1acf":              Private,
+	"xn--h1ahn.xn--p1acf":                Private,
+	"xn--j1adp.xn--p1acf":                Private,
+	"xn--c1avg.xn--p1acf":                Private,
+	"xn--80aaa0cvac.xn--p1acf":           Private,
+	"xn--h1aliz.xn--p1acf":               Private,
+	"xn--90a1af.xn--p1acf":               Private,
+	"xn--41a.xn--p1acf":                  Private,
+	"ras.ru":                             Private,
+	"nyat.app":                           Private,
+	"180r.com":                           Private,
+	"dojin.com":                          Private,
+	"sakuratan.com":                      Private,
+	"sakuraweb.com":                      Private,
+	"x0.com":                             Private,
+	"2-d.jp":                             Private,
+	"bona.jp":                            Private,
+	"crap.jp":                            Private,
+	"daynight.jp":                        Private,
+	"eek.jp":                             Private,
+	"flop.jp":                            Private,
+	"halfmoon.jp":                        Private,
+	"jeez.jp":                            Private,
+	"matrix.jp":                          Private,
+	"mimoza.jp":                          Private,
+	"ivory.ne.jp":                        Private,
+	"mail-box.ne.jp":                     Private,
+	"mints.ne.jp":                        Private,
+	"mokuren.ne.jp":                      Private,
+	"opal.ne.jp":                         Private,
+	"sakura.ne.jp":                       Private,
+	"sumomo.ne.jp":                       Private,
+	"topaz.ne.jp":                        Private,
+	"netgamers.jp":                       Private,
+	"nyanta.jp":                          Private,
+	"o0o0.jp":                            Private,
+	"rdy.jp":                             Private,
+	"rgr.jp":                             Private,
+	"rulez.jp":                           Private,
+	"s3.isk01.sakurastorage.jp":          Private,
+	"s3.isk02.sakurastorage.jp":          Private,
+	"saloon.jp":                          Private,
+	"sblo.jp":                            Private,
+	"skr.jp":                             Private,
+	"tank.jp":                            Private,
+	"uh-oh.jp":                           Private,
+	"undo.jp":                            Private,
+	"rs.webaccel.jp":                     Private,
+	"user.webaccel.jp":                   Private,
+	"websozai.jp":                        Private,
+	"xii.jp":                             Private,
+	"squares.net":                        Private,
+	"jpn.org":                            Private,
+	"kirara.st":                          Private,
+	"x0.to":                              Private,
+	"from.tv":                            Private,
+	"sakura.tv":                          Private,
+	"*.builder.code.com":                 Private,
+	"*.dev-builder.code.com":             Private,
+	"*.stg-builder.code.com":             Private,
+	"*.001.test.code-builder-stg.platform.salesforce.com": Private,
+	"*.aa.crm.dev":                     Private,
+	"*.ab.crm.dev":                     Private,
+	"*.ac.crm.dev":                     Private,
+	"*.ad.crm.dev":                     Private,
+	"*.ae.crm.dev":                     Private,
+	"*.af.crm.dev":                     Private,
+	"*.ci.crm.dev":                     Private,
+	"*.d.crm.dev":                      Private,
+	"*.pa.crm.dev":                     Private,
+	"*.pb.crm.dev":                     Private,
+	"*.pc.crm.dev":                     Private,
+	"*.pd.crm.dev":                     Private,
+	"*.pe.crm.dev":                     Private,
+	"*.pf.crm.dev":                     Private,
+	"*.w.crm.dev":                      Private,
+	"*.wa.crm.dev":                     Private,
+	"*.wb.crm.dev":                     Private,
+	"*.wc.crm.dev":                     Private,
+	"*.wd.crm.dev":                     Private,
+	"*.we.crm.dev":                     Private,
+	"*.wf.crm.dev":                     Private,
+	"sandcats.io":                      Private,
+	"sav.case":                         Private,
+	"logoip.com":                       Private,
+	"logoip.de":                        Private,
+	"fr-par-1.baremetal.scw.cloud":     Private,
+	"fr-par-2.baremetal.scw.cloud":     Private,
+	"nl-ams-1.baremetal.scw.cloud":     Private,
+	"cockpit.fr-par.scw.cloud":         Private,
+	"ddl.fr-par.scw.cloud":             Private,
+	"dtwh.fr-par.scw.cloud":            Private,
+	"fnc.fr-par.scw.cloud":             Private,
+	"functions.fnc.fr-par.scw.cloud":   Private,
+	"ifr.fr-par.scw.cloud":             Private,
+	"k8s.fr-par.scw.cloud":             Private,
+	"nodes.k8s.fr-par.scw.cloud":       Private,
+	"kafk.fr-par.scw.cloud":            Private,
+	"mgdb.fr-par.scw.cloud":            Private,
+	"rdb.fr-par.scw.cloud":             Private,
+	"s3.fr-par.scw.cloud":              Private,
+	"s3-website.fr-par.scw.cloud":      Private,
+	"scbl.fr-par.scw.cloud":            Private,
+	"whm.fr-par.scw.cloud":             Private,
+	"priv.instances.scw.cloud":         Private,
+	"pub.instances.scw.cloud":          Private,
+	"k8s.scw.cloud":                    Private,
+	"cockpit.nl-ams.scw.cloud":         Private,
+	"ddl.nl-ams.scw.cloud":             Private,
+	"dtwh.nl-ams.scw.cloud":            Private,
+	"ifr.nl-ams.scw.cloud":             Private,
+	"k8s.nl-ams.scw.cloud":             Private,
+	"nodes.k8s.nl-ams.scw.cloud":       Private,
+	"kafk.nl-ams.scw.cloud":            Private,
+	"mgdb.nl-ams.scw.cloud":            Private,
+	"rdb.nl-ams.scw.cloud":             Private,
+	"s3.nl-ams.scw.cloud":              Private,
+	"s3-website.nl-ams.scw.cloud":      Private,
+	"scbl.nl-ams.scw.cloud":            Private,
+	"whm.nl-ams.scw.cloud":             Private,
+	"cockpit.pl-waw.scw.cloud":         Private,
+	"ddl.pl-waw.scw.cloud":             Private,
+	"dtwh.pl-waw.scw.cloud":            Private,
+	"ifr.pl-waw.scw.cloud":             Private,
+	"k8s.pl-waw.scw.cloud":             Private,
+	"nodes.k8s.pl-waw.scw.cloud":       Private,
+	"kafk.pl-waw.scw.cloud":            Private,
+	"mgdb.pl-waw.scw.cloud":            Private,
+	"rdb.pl-waw.scw.cloud":             Private,
+	"s3.pl-waw.scw.cloud":              Private,
+	"s3-website.pl-waw.scw.cloud":      Private,
+	"scbl.pl-waw.scw.cloud":            Private,
+	"scalebook.scw.cloud":              Private,
+	"smartlabeling.scw.cloud":          Private,
+	"dedibox.fr":                       Private,
+	"schokokeks.net":                   Private,
+	"gov.scot":                         Private,
+	"service.gov.scot":                 Private,
+	"scrysec.com":                      Private,
+	"client.scrypted.io":               Private,
+	"firewall-gateway.com":             Private,
+	"firewall-gateway.de":              Private,
+	"my-gateway.de":                    Private,
+	"my-router.de":                     Private,
+	"spdns.de":                         Private,
+	"spdns.eu":                         Private,
+	"firewall-gateway.net":             Private,
+	"my-firewall.org":                  Private,
+	"myfirewall.org":                   Private,
+	"spdns.org":                        Private,
+	"seidat.net":                       Private,
+	"sellfy.store":                     Private,
+	"minisite.ms":                      Private,
+	"senseering.net":                   Private,
+	"servebolt.cloud":                  Private,
+	"biz.ua":                           Private,
+	"co.ua":                            Private,
+	"pp.ua":                            Private,
+	"as.sh.cn":                         Private,
+	"sheezy.games":                     Private,
+	"myshopblocks.com":                 Private,
+	"myshopify.com":                    Private,
+	"shopitsite.com":                   Private,
+	"shopware.shop":                    Private,
+	"shopware.store":                   Private,
+	"mo-siemens.io":                    Private,
+	"1kapp.com":                        Private,
+	"appchizi.com":                     Private,
+	"applinzi.com":                     Private,
+	"sinaapp.com":                      Private,
+	"vipsinaapp.com":                   Private,
+	"siteleaf.net":                     Private,
+	"small-web.org":                    Private,
+	"aeroport.fr":                      Private,
+	"avocat.fr":                        Private,
+	"chambagri.fr":                     Private,
+	"chirurgiens-dentistes.fr":         Private,
+	"experts-comptables.fr":            Private,
+	"medecin.fr":                       Private,
+	"notaires.fr":                      Private,
+	"pharmacien.fr":                    Private,
+	"port.fr":                          Private,
+	"veterinaire.fr":                   Private,
+	"vp4.me":                           Private,
+	"*.snowflake.app":                  Private,
+	"*.privatelink.snowflake.app":      Private,
+	"streamlit.app":                    Private,
+	"streamlitapp.com":                 Private,
+	"try-snowplow.com":                 Private,
+	"mafelo.net":                       Private,
+	"sol.site":                         Private,
+	"playstation-cloud.com":            Private,
+	"srht.site":                        Private,
+	"apps.lair.io":                     Private,
+	"*.stolos.io":                      Private,
+	"4.at":                             Private,
+	"my.at":                            Private,
+	"my.de":                            Private,
+	"*.nxa.eu":                         Private,
+	"nx.gw":                            Private,
+	"spawnbase.app":                    Private,
+	"customer.speedpartner.de":         Private,
+	"myspreadshop.at":                  Private,
+	"myspreadshop.com.au":              Private,
+	"myspreadshop.be":                  Private,
+	"myspreadshop.ca":                  Private,
+	"myspreadshop.ch":                  Private,
+	"myspreadshop.com":                 Private,
+	"myspreadshop.de":                  Private,
+	"myspreadshop.dk":                  Private,
+	"myspreadshop.es":                  Private,
+	"myspreadshop.fi":                  Private,
+	"myspreadshop.fr":                  Private,
+	"myspreadshop.ie":                  Private,
+	"myspreadshop.it":                  Private,
+	"myspreadshop.net":                 Private,
+	"myspreadshop.nl":                  Private,
+	"myspreadshop.no":                  Private,
+	"myspreadshop.pl":                  Private,
+	"myspreadshop.se":                  Private,
+	"myspreadshop.co.uk":               Private,
+	"w-corp-staticblitz.com":           Private,
+	"w-credentialless-staticblitz.com": Private,
+	"w-staticblitz.com":                Private,
+	"bolt.host":                        Private,
+	"stackhero-network.com":            Private,
+	"runs.onstackit.cloud":             Private,
+	"stackit.gg":                       Private,
+	"stackit.rocks":                    Private,
+	"stackit.run":                      Private,
+	"stackit.zone":                     Private,
+	"indevs.in":                        Private,
+	"musician.io":                      Private,
+	"novecore.site":                    Private,
+	"api.stdlib.com":                   Private,
+	"statichost.page":                  Private,
+	"feedback.ac":                      Private,
+	"forms.ac":                         Private,
+	"assessments.cx":                   Private,
+	"calculators.cx":                   Private,
+	"funnels.cx":                       Private,
+	"paynow.cx":                        Private,
+	"quizzes.cx":                       Private,
+	"researched.cx":                    Private,
+	"tests.cx":                         Private,
+	"surveys.so":                       Private,
+	"ipfs.storacha.link":               Private,
+	"ipfs.w3s.link":                    Private,
+	"storebase.store":                  Private,
+	"storj.farm":                       Private,
+	"strapiapp.com":                    Private,
+	"media.strapiapp.com":              Private,
+	"vps-host.net":                     Private,
+	"atl.jelastic.vps-host.net":        Private,
+	"njs.jelastic.vps-host.net":        Private,
+	"ric.jelastic.vps-host.net":        Private,
+	"streak-link.com":                  Private,
+	"streaklinks.com":                  Private,
+	"streakusercontent.com":            Private,
+	"soc.srcf.net":                     Private,
+	"user.srcf.net":                    Private,
+	"utwente.io":                       Private,
+	"temp-dns.com":                     Private,
+	"supabase.co":                      Private,
+	"realtime.supabase.co":             Private,
+	"storage.supabase.co":              Private,
+	"supabase.in":                      Private,
+	"supabase.net":                     Private,
+	"syncloud.it":                      Private,
+	"dscloud.biz":                      Private,
+	"direct.quickconnect.cn":           Private,
+	"dsmynas.com":                      Private,
+	"familyds.com":                     Private,
+	"diskstation.me":                   Private,
+	"dscloud.me":                       Private,
+	"i234.me":                          Private,
+	"myds.me":                          Private,
+	"synology.me":                      Private,
+	"dscloud.mobi":                     Private,
+	"dsmynas.net":                      Private,
+	"familyds.net":                     Private,
+	"dsmynas.org":                      Private,
+	"familyds.org":                     Private,
+	"direct.quickconnect.to":           Private,
+	"vpnplus.to":                       Private,
+	"mytabit.com":                      Private,
+	"mytabit.co.il":                    Private,
+	"tabitorder.co.il":                 Private,
+	"taifun-dns.de":                    Private,
+	"erp.dev":                          Private,
+	"web.erp.dev":                      Private,
+	"ts.net":                           Private,
+	"*.c.ts.net":                       Private,
+	"gda.pl":                           Private,
+	"gdansk.pl":                        Private,
+	"gdynia.pl":                        Private,
+	"med.pl":                           Private,
+	"sopot.pl":                         Private,
+	"taveusercontent.com":              Private,
+	"p.tawk.email":                     Private,
+	"p.tawkto.email":                   Private,
+	"tche.br":                          Private,
+	"site.tb-hosting.com":              Private,
+	"directwp.eu":                      Private,
+	"ec.cc":                            Private,
+	"eu.cc":                            Private,
+	"gu.cc":                            Private,
+	"uk.cc":                            Private,
+	"us.cc":                            Private,
+	"edugit.io":                        Private,
+	"s3.teckids.org":                   Private,
+	"telebit.app":                      Private,
+	"telebit.io":                       Private,
+	"*.telebit.xyz":                    Private,
+	"teleport.sh":                      Private,
+	"*.firenet.ch":                     Private,
+	"*.svc.firenet.ch":                 Private,
+	"reservd.com":                      Private,
+	"thingdustdata.com":                Private,
+	"cust.dev.thingdust.io":            Private,
+	"reservd.dev.thingdust.io":         Private,
+	"cust.disrec.thingdust.io":         Private,
+	"reservd.disrec.thingdust.io":      Private,
+	"cust.prod.thingdust.io":           Private,
+	"cust.testing.thingdust.io":        Private,
+	"reservd.testing.thingdust.io":     Private,
+	"tickets.io":                       Private,
+	"arvo.network":                     Private,
+	"azimuth.network":                  Private,
+	"tlon.network":                     Private,
+	"torproject.net":                   Private,
+	"pages.torproject.net":             Private,
+	"townnews-staging.com":             Private,
+	"12hp.at":                          Private,
+	"2ix.at":                           Private,
+	"4lima.at":                         Private,
+	"lima-city.at":                     Private,
+	"12hp.ch":                          Private,
+	"2ix.ch":                           Private,
+	"4lima.ch":                         Private,
+	"lima-city.ch":                     Private,
+	"trafficplex.cloud":                Private,
+	"de.cool":                          Private,
+	"12hp.de":                          Private,
+	"2ix.de":                           Private,
+	"4lima.de":                         Private,
+	"lima-city.de":                     Private,
+	"1337.pictures":                    Private,
+	"clan.rip":                         Private,
+	"lima-city.rocks":                  Private,
+	"webspace.rocks":                   Private,
+	"lima.zone":                        Private,
+	"*.transurl.be":                    Private,
+	"*.transurl.eu":                    Private,
+	"site.transip.me":                  Private,
+	"*.transurl.nl":                    Private,
+	"tunnelmole.net":                   Private,
+	"tuxfamily.org":                    Private,
+	"typedream.app":                    Private,
+	"pro.typeform.com":                 Private,
+	"uber.space":                       Private,
+	"hk.com":                           Private,
+	"inc.hk":                           Private,
+	"ltd.hk":                           Private,
+	"hk.org":                           Private,
+	"it.com":                           Private,
+	"umso.co":                          Private,
+	"unison-services.cloud":            Private,
+	"virtual-user.de":                  Private,
+	"virtualuser.de":                   Private,
+	"obj.ag":                           Private,
+	"name.pm":                          Private,
+	"sch.tf":                           Private,
+	"biz.wf":                           Private,
+	"sch.wf":                           Private,
+	"org.yt":                           Private,
+	"rs.ba":                            Private,
+	"bielsko.pl":                       Private,
+	"urown.cloud":                      Private,
+	"dnsupdate.info":                   Private,
+	"us.org":                           Private,
+	"v.ua":                             Private,
+	"val.run":                          Private,
+	"web.val.run":                      Private,
+	"vercel.app":                       Private,
+	"v0.build":                         Private,
+	"vercel.dev":                       Private,
+	"vusercontent.net":                 Private,
+	"vercel.run":                       Private,
+	"now.sh":                           Private,
+	"2038.io":                          Private,
+	"v-info.info":                      Private,
+	"vistablog.ir":                     Private,
+	"deus-canvas.com":                  Private,
+	"voorloper.cloud":                  Private,
+	"*.vultrobjects.com":               Private,
+	"wafflecell.com":                   Private,
+	"wal.app":                          Private,
+	"wasmer.app":                       Private,
+	"webflow.io":                       Private,
+	"webflowtest.io":                   Private,
+	"*.webhare.dev":                    Private,
+	"bookonline.app":                   Private,
+	"hotelwithflight.com":              Private,
+	"reserve-online.com":               Private,
+	"reserve-online.net":               Private,
+	"cprapid.com":                      Private,
+	"pleskns.com":                      Private,
+	"wp2.host":                         Private,
+	"pdns.page":                        Private,
+	"plesk.page":                       Private,
+	"cpanel.site":                      Private,
+	"wpsquared.site":                   Private,
+	"*.wadl.top":                       Private,
+	"remotewd.com":                     Private,
+	"box.ca":                           Private,
+	"pages.wiardweb.com":               Private,
+	"toolforge.org":                    Private,
+	"wmcloud.org":                      Private,
+	"beta.wmcloud.org":                 Private,
+	"wmflabs.org":                      Private,
+	"vps.hrsn.au":                      Private,
+	"hrsn.dev":                         Private,
+	"is-a.dev":                         Private,
+	"localcert.net":                    Private,
+	"windsurf.app":                     Private,
+	"windsurf.build":                   Private,
+	"drive-platform.com":               Private,
+	"drive-platform.io":                Private,
+	"panel.gg":                         Private,
+	"daemon.panel.gg":                  Private,
+	"base44.app":                       Private,
+	"base44-sandbox.com":               Private,
+	"wixsite.com":                      Private,
+	"wixstudio.com":                    Private,
+	"editorx.io":                       Private,
+	"wixstudio.io":                     Private,
+	"wix.run":                          Private,
+	"messwithdns.com":                  Private,
+	"woltlab-demo.com":                 Private,
+	"myforum.community":                Private,
+	"community-pro.de":                 Private,
+	"diskussionsbereich.de":            Private,
+	"community-pro.net":                Private,
+	"meinforum.net":                    Private,
+	"affinitylottery.org.uk":           Private,
+	"raffleentry.org.uk":               Private,
+	"weeklylottery.org.uk":             Private,
+	"wpenginepowered.com":              Private,
+	"js.wpenginepowered.com":           Private,
+	"*.xenonconnect.de":                Private,
+	"half.host":                        Private,
+	"xnbay.com":                        Private,
+	"u2.xnbay.com":                     Private,
+	"u2-local.xnbay.com":               Private,
+	"cistron.nl":                       Private,
+	"demon.nl":                         Private,
+	"xs4all.space":                     Private,
+	"xtooldevice.com":                  Private,
+	"yandexcloud.net":                  Private,
+	"storage.yandexcloud.net":          Private,
+	"website.yandexcloud.net":          Private,
+	"sourcecraft.site":                 Private,
+	"official.academy":                 Private,
+	"yolasite.com":                     Private,
+	"ynh.fr":                           Private,
+	"nohost.me":                        Private,
+	"noho.st":                          Private,
+	"za.net":                           Private,
+	"za.org":                           Private,
+	"zap.cloud":                        Private,
+	"zeabur.app":                       Private,
+	"*.zerops.app":                     Private,
+	"bss.design":                       Private,
+	"basicserver.io":                   Private,
+	"virtualserver.io":                 Private,
+	"enterprisecloud.nu":               Private,
+	"zone.id":                          Private,
+	"nett.to":                          Private,
+	"zabc.net":                         Private,
+}
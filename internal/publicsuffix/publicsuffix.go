@@ -0,0 +1,75 @@
+package publicsuffix
+
+import (
+	"errors"
+	"strings"
+)
+
+// Section is the section of the Public Suffix List a rule belongs to.
+type Section int
+
+const (
+	// ICANN rules are public suffixes delegated by the domain name registries (i.e. co.uk).
+	ICANN Section = iota
+	// Private rules are suffixes operated by private organisations which allow subdomains
+	// to be registered by their customers (i.e. github.io).
+	Private
+)
+
+// ErrPublicSuffix is returned when a domain is itself a public suffix, and so has no
+// registrable domain.
+var ErrPublicSuffix = errors.New("domain is a public suffix")
+
+// PublicSuffix returns the public suffix of a lowercase A-label domain (i.e. co.uk for
+// www.example.co.uk), and the number of labels it contains.
+//
+// Rules in the private section of the list are only considered when includePrivate is set.
+// Domains matching no rule use the implicit "*" rule, so their public suffix is the TLD.
+//
+// See: https://github.com/publicsuffix/list/wiki/Format#formal-algorithm
+func PublicSuffix(domain string, includePrivate bool) (string, int) {
+	labels := strings.Split(domain, ".")
+
+	matches := func(rule string) bool {
+		section, ok := List[rule]
+
+		return ok && (includePrivate || section == ICANN)
+	}
+
+	// Exception rules take priority over every other rule, and make the suffix the rule
+	// without its leftmost label.
+	for i := range labels {
+		if matches("!" + strings.Join(labels[i:], ".")) {
+			return strings.Join(labels[i+1:], "."), len(labels) - i - 1
+		}
+	}
+
+	// Otherwise, the rule matching the most labels is used.
+	for i := range labels {
+		if matches(strings.Join(labels[i:], ".")) {
+			return strings.Join(labels[i:], "."), len(labels) - i
+		}
+
+		if i+1 < len(labels) && matches("*."+strings.Join(labels[i+1:], ".")) {
+			return strings.Join(labels[i:], "."), len(labels) - i
+		}
+	}
+
+	return labels[len(labels)-1], 1
+}
+
+// RegistrableDomain returns the registrable domain of a lowercase A-label domain - the public
+// suffix, plus one label (i.e. example.co.uk for www.mail.example.co.uk).
+//
+// ErrPublicSuffix is returned if the domain is itself a public suffix.
+func RegistrableDomain(domain string, includePrivate bool) (string, error) {
+	_, suffixLabels := PublicSuffix(domain, includePrivate)
+
+	labels := strings.Split(domain, ".")
+
+	if len(labels) <= suffixLabels {
+		return "", ErrPublicSuffix
+	}
+
+	return strings.Join(labels[len(labels)-suffixLabels-1:], "."), nil
+}
@@ -0,0 +1,101 @@
+package publicsuffix
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindingRegistrableDomains(t *testing.T) {
+	// A selection of the test vectors published alongside the Public Suffix List.
+	//
+	// See: https://github.com/publicsuffix/list/blob/main/tests/test_psl.txt
+	tests := map[string]string{
+		"com":                        "",
+		"example.com":                "example.com",
+		"a.b.example.com":            "example.com",
+		"example":                    "",
+		"example.example":            "example.example",
+		"a.b.example.example":        "example.example",
+		"biz":                        "",
+		"a.b.domain.biz":             "domain.biz",
+		"uk.com":                     "",
+		"a.b.example.uk.com":         "example.uk.com",
+		"test.ac":                    "test.ac",
+		"mm":                         "",
+		"c.mm":                       "",
+		"a.b.c.mm":                   "b.c.mm",
+		"jp":                         "",
+		"www.test.jp":                "test.jp",
+		"ac.jp":                      "",
+		"www.test.ac.jp":             "test.ac.jp",
+		"ide.kyoto.jp":               "",
+		"a.b.ide.kyoto.jp":           "b.ide.kyoto.jp",
+		"c.kobe.jp":                  "",
+		"a.b.c.kobe.jp":              "b.c.kobe.jp",
+		"city.kobe.jp":               "city.kobe.jp",
+		"www.city.kobe.jp":           "city.kobe.jp",
+		"ck":                         "",
+		"test.ck":                    "",
+		"b.test.ck":                  "b.test.ck",
+		"www.ck":                     "www.ck",
+		"www.www.ck":                 "www.ck",
+		"us":                         "",
+		"www.test.us":                "test.us",
+		"k12.ak.us":                  "",
+		"www.test.k12.ak.us":         "test.k12.ak.us",
+		"xn--85x722f.com.cn":         "xn--85x722f.com.cn",
+		"www.xn--85x722f.xn--fiqs8s": "xn--85x722f.xn--fiqs8s",
+		"shishi.xn--fiqs8s":          "shishi.xn--fiqs8s",
+		"xn--fiqs8s":                 "",
+	}
+
+	for domain, expected := range tests {
+		registrable, err := RegistrableDomain(domain, true)
+
+		if expected == "" {
+			assert.ErrorIs(t, err, ErrPublicSuffix, "Domain %q", domain)
+			continue
+		}
+
+		assert.NoError(t, err, "Domain %q", domain)
+		assert.Equal(t, expected, registrable, "Domain %q", domain)
+	}
+}
+
+func TestPrivateRulesAreOnlyUsedWhenIncluded(t *testing.T) {
+	t.Run("Included", func(t *testing.T) {
+		registrable, err := RegistrableDomain("www.example.uk.com", true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "example.uk.com", registrable)
+
+		suffix, labels := PublicSuffix("user.github.io", true)
+
+		assert.Equal(t, "github.io", suffix)
+		assert.Equal(t, 2, labels)
+	})
+
+	t.Run("Excluded", func(t *testing.T) {
+		registrable, err := RegistrableDomain("www.example.uk.com", false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "uk.com", registrable)
+
+		suffix, labels := PublicSuffix("user.github.io", false)
+
+		assert.Equal(t, "io", suffix)
+		assert.Equal(t, 1, labels)
+	})
+}
+
+func TestGeneratedListHasRulesFromBothSections(t *testing.T) {
+	assert.Equal(t, ICANN, List["co.uk"])
+	assert.Equal(t, Private, List["github.io"])
+
+	for rule := range List {
+		assert.Equal(t, rule, strings.ToLower(rule), "Generated rule %q is not lowercase", rule)
+		assert.NotContains(t, rule, "..", "Generated rule %q has an empty label", rule)
+	}
+}
@@ -0,0 +1,20 @@
+package query
+
+import "fmt"
+
+// InvalidIdentifierError is returned when an identifier cannot be used for a query, because
+// it is malformed.
+type InvalidIdentifierError struct {
+	// Kind describes the kind of identifier which was expected (i.e. "IPv4 address").
+	Kind string
+
+	// Identifier is the identifier as provided.
+	Identifier string
+
+	// Reason describes why the identifier is invalid.
+	Reason string
+}
+
+func (err *InvalidIdentifierError) Error() string {
+	return fmt.Sprintf("invalid %s %q: %s", err.Kind, err.Identifier, err.Reason)
+}
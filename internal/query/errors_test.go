@@ -0,0 +1,13 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvalidIdentifierErrorMessage(t *testing.T) {
+	err := &InvalidIdentifierError{Kind: "IPv4 address", Identifier: "256.0.0.1", Reason: "octet out of range"}
+
+	assert.Equal(t, `invalid IPv4 address "256.0.0.1": octet out of range`, err.Error())
+}
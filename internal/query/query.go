@@ -4,7 +4,7 @@ import (
 	"log"
 )
 
-// RdapQuery represents the type of RDAP query to make to an RDAP server (domain, IPv4, or IPv6)
+// RdapQuery represents the type of RDAP query to make to an RDAP server (domain, IPv4, IPv6, ASN or entity)
 type RdapQuery int
 
 const (
@@ -16,6 +16,8 @@ const (
 	IPv6Query
 	// AsnQuery is a lookup on an ASN - e.g. 37888
 	AsnQuery
+	// EntityQuery is a lookup on an entity handle - e.g. ZG39-ARIN
+	EntityQuery
 )
 
 func (q RdapQuery) String() string {
@@ -28,6 +30,8 @@ func (q RdapQuery) String() string {
 		return "ip"
 	case AsnQuery:
 		return "autnum"
+	case EntityQuery:
+		return "entity"
 	default:
 		log.Panic("unknown RdapQuery type")
 		return ""
@@ -23,4 +23,8 @@ func TestQueriesMapToCorrectRDAPPaths(t *testing.T) {
 	t.Run("ASN", func(t *testing.T) {
 		assert.Equal(t, "autnum", AsnQuery.String())
 	})
+
+	t.Run("Entity", func(t *testing.T) {
+		assert.Equal(t, "entity", EntityQuery.String())
+	})
 }
@@ -0,0 +1,139 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit describes the rate at which requests may be made to a single host, using a token
+// bucket.
+type Limit struct {
+	// Rate is the number of requests per second which may be made on average. A zero or
+	// negative rate applies no limit.
+	Rate float64
+
+	// Burst is the maximum number of requests which may be made at once, before requests
+	// are limited to the rate.
+	Burst int
+}
+
+// unlimited reports whether the limit applies no restriction on requests.
+func (limit Limit) unlimited() bool {
+	return limit.Rate <= 0 || math.IsInf(limit.Rate, 1)
+}
+
+// bucket is a token bucket which refills at a fixed rate, up to its burst size.
+type bucket struct {
+	limit  Limit
+	tokens float64
+	last   time.Time
+}
+
+// reserve takes a token from the bucket, returning how long the caller must wait before the
+// token becomes available.
+//
+// The token count is allowed to go negative, so that concurrent callers queue behind each
+// other rather than all waking at the same moment.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	burst := float64(max(b.limit.Burst, 1))
+
+	b.tokens = min(burst, b.tokens+now.Sub(b.last).Seconds()*b.limit.Rate)
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.limit.Rate * float64(time.Second))
+}
+
+// Limiter applies a token bucket rate limit per host. It is safe for concurrent use.
+type Limiter struct {
+	mu           sync.Mutex
+	defaultLimit Limit
+	limits       map[string]Limit
+	buckets      map[string]*bucket
+}
+
+// New creates a new Limiter which applies the default limit to any host without a specific
+// limit configured.
+func New(defaultLimit Limit) *Limiter {
+	return &Limiter{
+		defaultLimit: defaultLimit,
+		limits:       make(map[string]Limit),
+		buckets:      make(map[string]*bucket),
+	}
+}
+
+// SetLimit sets the limit applied to requests to the given host.
+func (limiter *Limiter) SetLimit(host string, limit Limit) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.limits[host] = limit
+	delete(limiter.buckets, host)
+}
+
+// SetDefaultLimit sets the limit applied to requests to hosts without a specific limit.
+func (limiter *Limiter) SetDefaultLimit(limit Limit) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.defaultLimit = limit
+
+	for host := range limiter.buckets {
+		if _, ok := limiter.limits[host]; !ok {
+			delete(limiter.buckets, host)
+		}
+	}
+}
+
+// Wait blocks until a request may be made to the given host, or the context is done.
+func (limiter *Limiter) Wait(ctx context.Context, host string) error {
+	limiter.mu.Lock()
+
+	limit, ok := limiter.limits[host]
+
+	if !ok {
+		limit = limiter.defaultLimit
+	}
+
+	if limit.unlimited() {
+		limiter.mu.Unlock()
+		return ctx.Err()
+	}
+
+	b, ok := limiter.buckets[host]
+
+	if !ok {
+		b = &bucket{limit: limit, tokens: float64(max(limit.Burst, 1)), last: time.Now()}
+		limiter.buckets[host] = b
+	}
+
+	wait := b.reserve(time.Now())
+
+	limiter.mu.Unlock()
+
+	if wait == 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		// Return the unused token, so that the cancelled caller does not delay those
+		// queued behind it.
+		limiter.mu.Lock()
+		b.tokens++
+		limiter.mu.Unlock()
+
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBurstIsAllowedImmediately(t *testing.T) {
+	limiter := New(Limit{Rate: 1, Burst: 3})
+
+	start := time.Now()
+
+	for range 3 {
+		assert.NoError(t, limiter.Wait(context.Background(), "rdap.example.com"))
+	}
+
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestRequestsBeyondBurstAreDelayed(t *testing.T) {
+	limiter := New(Limit{Rate: 20, Burst: 1})
+
+	start := time.Now()
+
+	for range 3 {
+		assert.NoError(t, limiter.Wait(context.Background(), "rdap.example.com"))
+	}
+
+	// The first request uses the burst, and the next two each wait 50ms.
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+}
+
+func TestLimitsAreAppliedPerHost(t *testing.T) {
+	limiter := New(Limit{Rate: 1, Burst: 1})
+	limiter.SetLimit("rdap.fast.example", Limit{Rate: 1000, Burst: 10})
+
+	start := time.Now()
+
+	assert.NoError(t, limiter.Wait(context.Background(), "rdap.slow.example"))
+
+	for range 10 {
+		assert.NoError(t, limiter.Wait(context.Background(), "rdap.fast.example"))
+	}
+
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestZeroRateIsUnlimited(t *testing.T) {
+	limiter := New(Limit{})
+
+	start := time.Now()
+
+	for range 100 {
+		assert.NoError(t, limiter.Wait(context.Background(), "rdap.example.com"))
+	}
+
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestWaitRespectsContext(t *testing.T) {
+	limiter := New(Limit{Rate: 0.1, Burst: 1})
+
+	assert.NoError(t, limiter.Wait(context.Background(), "rdap.example.com"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, limiter.Wait(ctx, "rdap.example.com"), context.DeadlineExceeded)
+}
+
+func TestLimiterIsSharedAcrossGoroutines(t *testing.T) {
+	limiter := New(Limit{Rate: 50, Burst: 1})
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, limiter.Wait(context.Background(), "rdap.example.com"))
+		}()
+	}
+	wg.Wait()
+
+	// One request uses the burst, and the remaining four are spaced 20ms apart.
+	assert.GreaterOrEqual(t, time.Since(start), 70*time.Millisecond)
+}
@@ -1,15 +1,127 @@
 package asn
 
-import "fmt"
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// interval is a range of ASNs in the compiled bootstrap table, and the RDAP servers
+// responsible for it.
+type interval struct {
+	start, end uint32
+	servers    []string
+}
+
+// table is the bootstrap data compiled into sorted, non-overlapping intervals, so that
+// lookups can binary search for the interval containing an ASN.
+var table = compile(Bootstrap)
+
+// compile flattens the bootstrap ranges into sorted, non-overlapping intervals.
+//
+// Should any bootstrap ranges overlap, each ASN is assigned to the narrowest range which
+// contains it (with ties broken by the lowest starting ASN), so that lookups are
+// deterministic.
+func compile(bootstrap map[[2]uint32][]string) []interval {
+	ranges := make([][2]uint32, 0, len(bootstrap))
+	for asnRange := range bootstrap {
+		ranges = append(ranges, asnRange)
+	}
+
+	// Split the ASN space at every boundary of every range, producing segments which are
+	// each either entirely inside, or entirely outside, every range.
+	boundaries := make([]uint64, 0, 2*len(ranges))
+	for _, asnRange := range ranges {
+		boundaries = append(boundaries, uint64(asnRange[0]), uint64(asnRange[1])+1)
+	}
+	slices.Sort(boundaries)
+	boundaries = slices.Compact(boundaries)
+
+	owners := make([]*[2]uint32, len(boundaries))
+
+	// Assign each segment to the narrowest range containing it, by visiting the ranges from
+	// narrowest to widest and only claiming segments which haven't been claimed already.
+	slices.SortFunc(ranges, func(a, b [2]uint32) int {
+		if c := cmp.Compare(a[1]-a[0], b[1]-b[0]); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a[0], b[0])
+	})
+
+	for i := range ranges {
+		first, _ := slices.BinarySearch(boundaries, uint64(ranges[i][0]))
+
+		for segment := first; boundaries[segment] <= uint64(ranges[i][1]); segment++ {
+			if owners[segment] == nil {
+				owners[segment] = &ranges[i]
+			}
+		}
+	}
+
+	compiled := make([]interval, 0, len(ranges))
+	for segment, owner := range owners {
+		if owner == nil {
+			continue
+		}
+
+		start, end := uint32(boundaries[segment]), uint32(boundaries[segment+1]-1)
+
+		// Merge consecutive segments belonging to the same range back together.
+		if last := len(compiled) - 1; last >= 0 && segment > 0 && owners[segment-1] == owner {
+			compiled[last].end = end
+			continue
+		}
+
+		compiled = append(compiled, interval{start: start, end: end, servers: bootstrap[*owner]})
+	}
+
+	return compiled
+}
+
+// Overlaps returns each pair of ranges in the bootstrap data which overlap.
+func Overlaps(bootstrap map[[2]uint32][]string) [][2][2]uint32 {
+	ranges := make([][2]uint32, 0, len(bootstrap))
+	for asnRange := range bootstrap {
+		ranges = append(ranges, asnRange)
+	}
+
+	slices.SortFunc(ranges, func(a, b [2]uint32) int {
+		if c := cmp.Compare(a[0], b[0]); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a[1], b[1])
+	})
+
+	var overlaps [][2][2]uint32
+
+	for i := range ranges {
+		for j := i + 1; j < len(ranges) && ranges[j][0] <= ranges[i][1]; j++ {
+			overlaps = append(overlaps, [2][2]uint32{ranges[i], ranges[j]})
+		}
+	}
+
+	return overlaps
+}
 
 // GetServers returns the RDAP servers for a given ASN from the IANA bootstrap data.
 //
 // See: https://data.iana.org/rdap/
 func GetServers(asn uint32) ([]string, error) {
-	for asnRange, servers := range Bootstrap {
-		if asn >= asnRange[0] && asn <= asnRange[1] {
-			return servers, nil
-		}
+	index, found := slices.BinarySearchFunc(table, asn, func(candidate interval, asn uint32) int {
+		return cmp.Compare(candidate.start, asn)
+	})
+
+	// The search finds the position of the first interval starting after the ASN (unless
+	// one starts exactly at it), so the interval which may contain the ASN is the one
+	// before.
+	if !found {
+		index--
+	}
+
+	if index >= 0 && table[index].start <= asn && asn <= table[index].end {
+		return table[index].servers, nil
 	}
 
 	return nil, fmt.Errorf("no RDAP servers found for ASN: %d", asn)
@@ -19,3 +19,85 @@ func TestResolvingInvalidASNToServersReturnsAnError(t *testing.T) {
 
 	assert.NotNil(t, err)
 }
+
+func TestResolvingASNAtRangeBoundariesToServers(t *testing.T) {
+	original := table
+	defer func() { table = original }()
+
+	table = compile(map[[2]uint32][]string{
+		{100, 199}: {"https://rdap.a.example/"},
+		{200, 200}: {"https://rdap.b.example/"},
+		{300, 399}: {"https://rdap.c.example/"},
+	})
+
+	for asn, expected := range map[uint32]string{
+		100: "https://rdap.a.example/",
+		199: "https://rdap.a.example/",
+		200: "https://rdap.b.example/",
+		300: "https://rdap.c.example/",
+		399: "https://rdap.c.example/",
+	} {
+		servers, err := GetServers(asn)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{expected}, servers, "ASN %d", asn)
+	}
+
+	for _, asn := range []uint32{0, 99, 201, 299, 400, 4294967295} {
+		_, err := GetServers(asn)
+
+		assert.NotNil(t, err, "ASN %d", asn)
+	}
+}
+
+func TestResolvingASNInOverlappingRangesPrefersNarrowestRange(t *testing.T) {
+	original := table
+	defer func() { table = original }()
+
+	bootstrap := map[[2]uint32][]string{
+		{0, 4294967295}: {"https://rdap.everything.example/"},
+		{100, 199}:      {"https://rdap.wide.example/"},
+		{150, 159}:      {"https://rdap.narrow.example/"},
+		{155, 164}:      {"https://rdap.shifted.example/"},
+	}
+
+	// Map iteration order is random, so compiling the same bootstrap data repeatedly
+	// exercises many orders.
+	for range 20 {
+		table = compile(bootstrap)
+
+		for asn, expected := range map[uint32]string{
+			0:          "https://rdap.everything.example/",
+			100:        "https://rdap.wide.example/",
+			150:        "https://rdap.narrow.example/",
+			157:        "https://rdap.narrow.example/",
+			160:        "https://rdap.shifted.example/",
+			165:        "https://rdap.wide.example/",
+			200:        "https://rdap.everything.example/",
+			4294967295: "https://rdap.everything.example/",
+		} {
+			servers, err := GetServers(asn)
+
+			assert.Nil(t, err)
+			assert.Equal(t, []string{expected}, servers, "ASN %d", asn)
+		}
+	}
+}
+
+func TestDetectingOverlappingRanges(t *testing.T) {
+	overlaps := Overlaps(map[[2]uint32][]string{
+		{100, 199}: {"https://rdap.a.example/"},
+		{150, 249}: {"https://rdap.b.example/"},
+		{250, 299}: {"https://rdap.c.example/"},
+	})
+
+	assert.Equal(t, [][2][2]uint32{{{100, 199}, {150, 249}}}, overlaps)
+}
+
+func BenchmarkResolvingASNToServers(b *testing.B) {
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_, _ = GetServers(24575)
+	}
+}
@@ -25,3 +25,7 @@ func TestGeneratedBootstrapASNRangeIsValid(t *testing.T) {
 		assert.NotEmpty(t, servers, "Generated bootstrap has an empty server list for ASN %d", asn)
 	}
 }
+
+func TestGeneratedBootstrapHasNoOverlappingRanges(t *testing.T) {
+	assert.Empty(t, Overlaps(Bootstrap), "Generated bootstrap has overlapping ASN ranges")
+}
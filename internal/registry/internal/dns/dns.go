@@ -2,15 +2,32 @@ package dns
 
 import (
 	"fmt"
+	"strings"
 )
 
-// GetServers returns the RDAP servers for a given TLD from the IANA bootstrap data.
+// GetServers returns the RDAP servers for a given domain from the IANA bootstrap data.
 //
-// See: https://data.iana.org/rdap/
-func GetServers(tld string) ([]string, error) {
-	if servers, ok := Bootstrap[tld]; ok {
-		return servers, nil
+// Bootstrap entries may contain more than one label, so the entry matching the longest
+// suffix of the domain is used - i.e. a domain under an entry for "example.com" is served
+// by that entry, rather than the one for "com".
+//
+// See Section 4: https://datatracker.ietf.org/doc/rfc9224/
+func GetServers(domain string) ([]string, error) {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+
+	for suffix := domain; suffix != ""; {
+		if servers, ok := Bootstrap[suffix]; ok {
+			return servers, nil
+		}
+
+		_, parent, found := strings.Cut(suffix, ".")
+
+		if !found {
+			break
+		}
+
+		suffix = parent
 	}
 
-	return nil, fmt.Errorf("no RDAP servers found for TLD: %s", tld)
+	return nil, fmt.Errorf("no RDAP servers found for domain: %s", domain)
 }
@@ -21,3 +21,37 @@ func TestResolvingInvalidTldToServersReturnsAnError(t *testing.T) {
 
 	assert.NotNil(t, err)
 }
+
+func TestResolvingDomainToServersUsesTheLongestMatchingSuffix(t *testing.T) {
+	original := Bootstrap
+	defer func() { Bootstrap = original }()
+
+	Bootstrap = map[string][]string{
+		"com":             {"https://rdap.example/com/"},
+		"example.com":     {"https://rdap.example/example.com/"},
+		"sub.example.com": {"https://rdap.example/sub.example.com/"},
+	}
+
+	tests := map[string]string{
+		"com":                   "https://rdap.example/com/",
+		"other.com":             "https://rdap.example/com/",
+		"example.com":           "https://rdap.example/example.com/",
+		"www.example.com":       "https://rdap.example/example.com/",
+		"WWW.EXAMPLE.COM.":      "https://rdap.example/example.com/",
+		"a.b.sub.example.com":   "https://rdap.example/sub.example.com/",
+		"notsub.example.com":    "https://rdap.example/example.com/",
+		"sub.example.com.other": "",
+	}
+
+	for domain, expected := range tests {
+		servers, err := GetServers(domain)
+
+		if expected == "" {
+			assert.Error(t, err, "Domain %q", domain)
+			continue
+		}
+
+		assert.NoError(t, err, "Domain %q", domain)
+		assert.Equal(t, []string{expected}, servers, "Domain %q", domain)
+	}
+}
@@ -0,0 +1,28 @@
+package entity
+
+// DO NOT EDIT!
+//
+// This file is generated by internal/cmd/entity/main.go
+
+// Bootstrap is the RDAP object tag bootstrap data sourced from IANA, mapping each
+// service provider tag to its RDAP servers.
+//
+// Source (version: 1.0, publication date: 2022-12-29T04:00:02Z): https://data.iana.org/rdap/object-tags.json
+var Bootstrap = map[string][]string{
+	"ARIN": {
+		"https://rdap.arin.net/registry/",
+		"http://rdap.arin.net/registry/",
+	},
+	"LACNIC": {
+		"https://rdap.lacnic.net/rdap/",
+	},
+	"APNIC": {
+		"https://rdap.apnic.net/",
+	},
+	"RIPE": {
+		"https://rdap.db.ripe.net/",
+	},
+	"FRNIC": {
+		"https://rdap.nic.fr/",
+	},
+}
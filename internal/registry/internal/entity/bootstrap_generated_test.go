@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratedBootstrapHasAllServersEndingWithTailingSlash(t *testing.T) {
+	for tag, servers := range Bootstrap {
+		assert.Equal(t, strings.ToUpper(tag), tag, "Generated bootstrap tag %q is not uppercase", tag)
+		assert.NotEmpty(t, servers, "Generated bootstrap has an empty server list for one of the tags")
+
+		for _, server := range servers {
+			assert.Equal(t, "/", string(server[len(server)-1]), "Generated bootstrap server %q does not end with a trailing slash", server)
+		}
+	}
+}
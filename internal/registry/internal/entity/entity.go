@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GetServers returns the RDAP servers for a given entity handle from the IANA object tag
+// bootstrap data.
+//
+// Handles are tagged with the service provider which issued them, following the last
+// hyphen in the handle - i.e. the tag of ZG39-ARIN is ARIN. Tags are case-insensitive.
+//
+// See Section 2: https://datatracker.ietf.org/doc/rfc8521/
+func GetServers(handle string) ([]string, error) {
+	index := strings.LastIndex(handle, "-")
+
+	if index <= 0 || index == len(handle)-1 {
+		return nil, fmt.Errorf("entity handle does not contain a service provider tag: %s", handle)
+	}
+
+	tag := strings.ToUpper(handle[index+1:])
+
+	if servers, ok := Bootstrap[tag]; ok {
+		return servers, nil
+	}
+
+	return nil, fmt.Errorf("no RDAP servers found for object tag: %s", tag)
+}
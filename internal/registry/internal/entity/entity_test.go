@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolvingEntityHandleToServers(t *testing.T) {
+	for _, handle := range []string{"ZG39-ARIN", "zg39-arin", "ABC-123-ARIN"} {
+		servers, err := GetServers(handle)
+
+		assert.NoError(t, err, "Handle %q", handle)
+		assert.Equal(t, []string{"https://rdap.arin.net/registry/", "http://rdap.arin.net/registry/"}, servers, "Handle %q", handle)
+	}
+}
+
+func TestResolvingEntityHandleWithoutKnownTagReturnsAnError(t *testing.T) {
+	for _, handle := range []string{"GOGL", "-ARIN", "ZG39-", "ZG39-NOTATAG", ""} {
+		_, err := GetServers(handle)
+
+		assert.Error(t, err, "Handle %q", handle)
+	}
+}
@@ -0,0 +1,124 @@
+package iptrie
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+)
+
+// node is a single bit position in the trie. A node holds a value when a prefix ending at
+// that bit position has been inserted.
+type node[T any] struct {
+	children [2]*node[T]
+	value    T
+	set      bool
+}
+
+// Trie is a binary prefix trie supporting longest prefix matching of IP addresses and
+// prefixes of a single address family.
+//
+// Lookups walk at most one node per bit of the address (32 for IPv4, 128 for IPv6), so
+// their cost is independent of the number of prefixes stored.
+type Trie[T any] struct {
+	root node[T]
+}
+
+// Insert stores the value against the prefix, replacing any value previously stored against
+// the same prefix.
+func (trie *Trie[T]) Insert(prefix netip.Prefix, value T) {
+	prefix = prefix.Masked()
+	bytes := prefix.Addr().AsSlice()
+
+	current := &trie.root
+	for i := range prefix.Bits() {
+		bit := bitAt(bytes, i)
+
+		if current.children[bit] == nil {
+			current.children[bit] = &node[T]{}
+		}
+
+		current = current.children[bit]
+	}
+
+	current.value = value
+	current.set = true
+}
+
+// Lookup returns the value stored against the longest prefix which contains the entire
+// given prefix. An address can be looked up by passing a single address prefix (i.e. a /32
+// or /128).
+func (trie *Trie[T]) Lookup(prefix netip.Prefix) (T, bool) {
+	prefix = prefix.Masked()
+	bytes := prefix.Addr().AsSlice()
+
+	var value T
+	found := false
+
+	current := &trie.root
+	for i := 0; current != nil; i++ {
+		if current.set {
+			value, found = current.value, true
+		}
+
+		if i >= prefix.Bits() {
+			break
+		}
+
+		current = current.children[bitAt(bytes, i)]
+	}
+
+	return value, found
+}
+
+// bitAt returns the bit at the given position of the address, where 0 is the most
+// significant bit.
+func bitAt(bytes []byte, position int) int {
+	return int(bytes[position/8]>>(7-position%8)) & 1
+}
+
+// Parse strictly parses an IP address (i.e. 8.8.8.8) or prefix (i.e. 8.8.8.0/24) of the
+// given address family for lookup, treating an address as a single address prefix.
+func Parse(ip string, ipv4 bool) (netip.Prefix, error) {
+	family := "IPv6"
+	if ipv4 {
+		family = "IPv4"
+	}
+
+	if strings.Contains(ip, "/") {
+		prefix, err := netip.ParsePrefix(ip)
+
+		if err != nil {
+			return netip.Prefix{}, &query.InvalidIdentifierError{Kind: family + " prefix", Identifier: ip, Reason: reason(err)}
+		}
+
+		if prefix.Addr().Is4() != ipv4 {
+			return netip.Prefix{}, &query.InvalidIdentifierError{Kind: family + " prefix", Identifier: ip, Reason: "not an " + family + " prefix"}
+		}
+
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(ip)
+
+	if err != nil {
+		return netip.Prefix{}, &query.InvalidIdentifierError{Kind: family + " address", Identifier: ip, Reason: reason(err)}
+	}
+
+	if addr.Is4() != ipv4 {
+		return netip.Prefix{}, &query.InvalidIdentifierError{Kind: family + " address", Identifier: ip, Reason: "not an " + family + " address"}
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// reason extracts the cause of a netip parsing error, without the input repeated.
+func reason(err error) string {
+	message := err.Error()
+
+	if index := strings.LastIndex(message, "): "); index != -1 {
+		return message[index+len("): "):]
+	}
+
+	return message
+}
@@ -0,0 +1,94 @@
+package iptrie
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupReturnsLongestPrefixMatch(t *testing.T) {
+	var trie Trie[string]
+
+	trie.Insert(netip.MustParsePrefix("2001::/16"), "wide")
+	trie.Insert(netip.MustParsePrefix("2001:db8::/32"), "narrow")
+	trie.Insert(netip.MustParsePrefix("2001:db8:1::/48"), "narrowest")
+
+	tests := map[string]string{
+		"2001:db8:1::1/128":   "narrowest",
+		"2001:db8:1::/48":     "narrowest",
+		"2001:db8:2::1/128":   "narrow",
+		"2001:db8::/32":       "narrow",
+		"2001:db8::/31":       "wide",
+		"2001:ffff::1/128":    "wide",
+		"2001:db8:1:2::/64":   "narrowest",
+		"2001:db8:1::1234/96": "narrowest",
+	}
+
+	for prefix, expected := range tests {
+		value, found := trie.Lookup(netip.MustParsePrefix(prefix))
+
+		assert.True(t, found, "Prefix %s", prefix)
+		assert.Equal(t, expected, value, "Prefix %s", prefix)
+	}
+}
+
+func TestLookupIsIndependentOfInsertionOrder(t *testing.T) {
+	var forwards, backwards Trie[string]
+
+	prefixes := []string{"10.0.0.0/8", "10.1.0.0/16", "10.1.2.0/24"}
+
+	for _, prefix := range prefixes {
+		forwards.Insert(netip.MustParsePrefix(prefix), prefix)
+	}
+
+	for i := len(prefixes) - 1; i >= 0; i-- {
+		backwards.Insert(netip.MustParsePrefix(prefixes[i]), prefixes[i])
+	}
+
+	for _, addr := range []string{"10.1.2.3/32", "10.1.3.3/32", "10.2.0.0/32"} {
+		a, _ := forwards.Lookup(netip.MustParsePrefix(addr))
+		b, _ := backwards.Lookup(netip.MustParsePrefix(addr))
+
+		assert.Equal(t, a, b)
+	}
+}
+
+func TestLookupWithoutMatch(t *testing.T) {
+	var trie Trie[string]
+
+	trie.Insert(netip.MustParsePrefix("10.0.0.0/8"), "ten")
+
+	_, found := trie.Lookup(netip.MustParsePrefix("11.0.0.1/32"))
+
+	assert.False(t, found)
+}
+
+func TestParsingAddressesAndPrefixes(t *testing.T) {
+	prefix, err := Parse("2001:db8::1", false)
+	assert.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("2001:db8::1/128"), prefix)
+
+	prefix, err = Parse("2001:db8::1/32", false)
+	assert.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("2001:db8::/32"), prefix)
+
+	prefix, err = Parse("8.8.8.8", true)
+	assert.NoError(t, err)
+	assert.Equal(t, netip.MustParsePrefix("8.8.8.8/32"), prefix)
+
+	var invalidErr *query.InvalidIdentifierError
+
+	_, err = Parse("8.8.8.8", false)
+	assert.ErrorAs(t, err, &invalidErr)
+	assert.Equal(t, "not an IPv6 address", invalidErr.Reason)
+
+	_, err = Parse("2001:db8::/129", false)
+	assert.ErrorAs(t, err, &invalidErr)
+	assert.Equal(t, "IPv6 prefix", invalidErr.Kind)
+
+	_, err = Parse("08.8.8.8", true)
+	assert.ErrorAs(t, err, &invalidErr)
+	assert.Equal(t, "IPv4 field has octet with leading zero", invalidErr.Reason)
+}
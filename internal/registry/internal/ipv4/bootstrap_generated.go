@@ -4,788 +4,788 @@ package ipv4
 //
 // This file is generated by internal/cmd/ipv4/main.go
 
-// Bootstrap is the IPv4 RDAP bootstrap data sourced from IANA.
+// Bootstrap is the IPv4 RDAP bootstrap data sourced from IANA, keyed by IPv4 prefix.
 //
 // Source (version: 1.0, publication date: 2019-06-07T19:00:02Z): https://data.iana.org/rdap/ipv4.json
-var Bootstrap = map[uint8][]string{
-	41: {
+var Bootstrap = map[string][]string{
+	"41.0.0.0/8": {
 		"https://rdap.afrinic.net/rdap/",
 		"http://rdap.afrinic.net/rdap/",
 	},
-	102: {
+	"102.0.0.0/8": {
 		"https://rdap.afrinic.net/rdap/",
 		"http://rdap.afrinic.net/rdap/",
 	},
-	105: {
+	"105.0.0.0/8": {
 		"https://rdap.afrinic.net/rdap/",
 		"http://rdap.afrinic.net/rdap/",
 	},
-	154: {
+	"154.0.0.0/8": {
 		"https://rdap.afrinic.net/rdap/",
 		"http://rdap.afrinic.net/rdap/",
 	},
-	196: {
+	"196.0.0.0/8": {
 		"https://rdap.afrinic.net/rdap/",
 		"http://rdap.afrinic.net/rdap/",
 	},
-	197: {
+	"197.0.0.0/8": {
 		"https://rdap.afrinic.net/rdap/",
 		"http://rdap.afrinic.net/rdap/",
 	},
-	1: {
+	"1.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	14: {
+	"14.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	27: {
+	"27.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	36: {
+	"36.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	39: {
+	"39.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	42: {
+	"42.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	43: {
+	"43.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	49: {
+	"49.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	58: {
+	"58.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	59: {
+	"59.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	60: {
+	"60.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	61: {
+	"61.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	101: {
+	"101.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	103: {
+	"103.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	106: {
+	"106.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	110: {
+	"110.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	111: {
+	"111.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	112: {
+	"112.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	113: {
+	"113.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	114: {
+	"114.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	115: {
+	"115.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	116: {
+	"116.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	117: {
+	"117.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	118: {
+	"118.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	119: {
+	"119.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	120: {
+	"120.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	121: {
+	"121.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	122: {
+	"122.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	123: {
+	"123.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	124: {
+	"124.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	125: {
+	"125.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	126: {
+	"126.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	133: {
+	"133.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	150: {
+	"150.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	153: {
+	"153.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	163: {
+	"163.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	171: {
+	"171.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	175: {
+	"175.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	180: {
+	"180.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	182: {
+	"182.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	183: {
+	"183.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	202: {
+	"202.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	203: {
+	"203.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	210: {
+	"210.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	211: {
+	"211.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	218: {
+	"218.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	219: {
+	"219.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	220: {
+	"220.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	221: {
+	"221.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	222: {
+	"222.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	223: {
+	"223.0.0.0/8": {
 		"https://rdap.apnic.net/",
 	},
-	3: {
+	"3.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	4: {
+	"4.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	6: {
+	"6.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	7: {
+	"7.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	8: {
+	"8.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	9: {
+	"9.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	11: {
+	"11.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	12: {
+	"12.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	13: {
+	"13.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	15: {
+	"15.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	16: {
+	"16.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	17: {
+	"17.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	18: {
+	"18.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	19: {
+	"19.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	20: {
+	"20.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	21: {
+	"21.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	22: {
+	"22.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	23: {
+	"23.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	24: {
+	"24.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	26: {
+	"26.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	28: {
+	"28.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	29: {
+	"29.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	30: {
+	"30.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	32: {
+	"32.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	33: {
+	"33.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	34: {
+	"34.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	35: {
+	"35.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	38: {
+	"38.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	40: {
+	"40.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	44: {
+	"44.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	45: {
+	"45.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	47: {
+	"47.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	48: {
+	"48.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	50: {
+	"50.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	52: {
+	"52.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	54: {
+	"54.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	55: {
+	"55.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	56: {
+	"56.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	63: {
+	"63.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	64: {
+	"64.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	65: {
+	"65.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	66: {
+	"66.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	67: {
+	"67.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	68: {
+	"68.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	69: {
+	"69.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	70: {
+	"70.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	71: {
+	"71.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	72: {
+	"72.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	73: {
+	"73.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	74: {
+	"74.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	75: {
+	"75.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	76: {
+	"76.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	96: {
+	"96.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	97: {
+	"97.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	98: {
+	"98.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	99: {
+	"99.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	100: {
+	"100.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	104: {
+	"104.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	107: {
+	"107.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	108: {
+	"108.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	128: {
+	"128.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	129: {
+	"129.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	130: {
+	"130.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	131: {
+	"131.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	132: {
+	"132.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	134: {
+	"134.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	135: {
+	"135.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	136: {
+	"136.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	137: {
+	"137.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	138: {
+	"138.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	139: {
+	"139.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	140: {
+	"140.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	142: {
+	"142.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	143: {
+	"143.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	144: {
+	"144.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	146: {
+	"146.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	147: {
+	"147.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	148: {
+	"148.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	149: {
+	"149.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	152: {
+	"152.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	155: {
+	"155.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	156: {
+	"156.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	157: {
+	"157.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	158: {
+	"158.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	159: {
+	"159.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	160: {
+	"160.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	161: {
+	"161.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	162: {
+	"162.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	164: {
+	"164.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	165: {
+	"165.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	166: {
+	"166.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	167: {
+	"167.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	168: {
+	"168.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	169: {
+	"169.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	170: {
+	"170.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	172: {
+	"172.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	173: {
+	"173.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	174: {
+	"174.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	184: {
+	"184.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	192: {
+	"192.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	198: {
+	"198.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	199: {
+	"199.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	204: {
+	"204.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	205: {
+	"205.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	206: {
+	"206.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	207: {
+	"207.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	208: {
+	"208.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	209: {
+	"209.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	214: {
+	"214.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	215: {
+	"215.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	216: {
+	"216.0.0.0/8": {
 		"https://rdap.arin.net/registry/",
 		"http://rdap.arin.net/registry/",
 	},
-	2: {
+	"2.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	5: {
+	"5.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	25: {
+	"25.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	31: {
+	"31.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	37: {
+	"37.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	46: {
+	"46.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	51: {
+	"51.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	53: {
+	"53.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	57: {
+	"57.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	62: {
+	"62.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	77: {
+	"77.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	78: {
+	"78.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	79: {
+	"79.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	80: {
+	"80.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	81: {
+	"81.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	82: {
+	"82.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	83: {
+	"83.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	84: {
+	"84.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	85: {
+	"85.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	86: {
+	"86.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	87: {
+	"87.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	88: {
+	"88.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	89: {
+	"89.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	90: {
+	"90.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	91: {
+	"91.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	92: {
+	"92.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	93: {
+	"93.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	94: {
+	"94.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	95: {
+	"95.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	109: {
+	"109.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	141: {
+	"141.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	145: {
+	"145.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	151: {
+	"151.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	176: {
+	"176.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	178: {
+	"178.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	185: {
+	"185.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	188: {
+	"188.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	193: {
+	"193.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	194: {
+	"194.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	195: {
+	"195.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	212: {
+	"212.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	213: {
+	"213.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	217: {
+	"217.0.0.0/8": {
 		"https://rdap.db.ripe.net/",
 	},
-	177: {
+	"177.0.0.0/8": {
 		"https://rdap.lacnic.net/rdap/",
 	},
-	179: {
+	"179.0.0.0/8": {
 		"https://rdap.lacnic.net/rdap/",
 	},
-	181: {
+	"181.0.0.0/8": {
 		"https://rdap.lacnic.net/rdap/",
 	},
-	186: {
+	"186.0.0.0/8": {
 		"https://rdap.lacnic.net/rdap/",
 	},
-	187: {
+	"187.0.0.0/8": {
 		"https://rdap.lacnic.net/rdap/",
 	},
-	189: {
+	"189.0.0.0/8": {
 		"https://rdap.lacnic.net/rdap/",
 	},
-	190: {
+	"190.0.0.0/8": {
 		"https://rdap.lacnic.net/rdap/",
 	},
-	191: {
+	"191.0.0.0/8": {
 		"https://rdap.lacnic.net/rdap/",
 	},
-	200: {
+	"200.0.0.0/8": {
 		"https://rdap.lacnic.net/rdap/",
 	},
-	201: {
+	"201.0.0.0/8": {
 		"https://rdap.lacnic.net/rdap/",
 	},
 }
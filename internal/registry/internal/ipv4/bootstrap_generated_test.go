@@ -1,6 +1,7 @@
 package ipv4
 
 import (
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,3 +16,13 @@ func TestGeneratedBootstrapHasAllServersEndingWithTailingSlash(t *testing.T) {
 		}
 	}
 }
+
+func TestGeneratedBootstrapHasAllKeysAsIPv4Prefixes(t *testing.T) {
+	for cidr := range Bootstrap {
+		prefix, err := netip.ParsePrefix(cidr)
+
+		assert.NoError(t, err, "Generated bootstrap key %q is not a valid prefix", cidr)
+		assert.True(t, prefix.Addr().Is4(), "Generated bootstrap key %q is not an IPv4 prefix", cidr)
+		assert.Equal(t, prefix.Masked(), prefix, "Generated bootstrap key %q has host bits set", cidr)
+	}
+}
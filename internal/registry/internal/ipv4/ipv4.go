@@ -2,29 +2,43 @@ package ipv4
 
 import (
 	"fmt"
-	"strconv"
-	"strings"
+	"net/netip"
+
+	"github.com/ryanmab/rdap-go/internal/registry/internal/iptrie"
 )
 
-// GetServers returns the RDAP servers for a given IPv4 from the IANA bootstrap data.
+// trie is the bootstrap data compiled into a prefix trie, so that lookups find the longest
+// matching prefix regardless of the order of the bootstrap data.
+var trie = compile(Bootstrap)
+
+// compile parses the bootstrap prefixes once, inserting them into a prefix trie.
+func compile(bootstrap map[string][]string) *iptrie.Trie[[]string] {
+	compiled := &iptrie.Trie[[]string]{}
+
+	for cidr, servers := range bootstrap {
+		compiled.Insert(netip.MustParsePrefix(cidr), servers)
+	}
+
+	return compiled
+}
+
+// GetServers returns the RDAP servers for a given IPv4 address (i.e. 8.8.8.8), or IPv4
+// prefix (i.e. 8.8.8.0/24), from the IANA bootstrap data.
+//
+// The servers for the longest bootstrap prefix containing the address, or the entire
+// prefix, are returned.
 //
 // See: https://data.iana.org/rdap/
 func GetServers(ip string) ([]string, error) {
-	firstOctet := strings.Split(ip, ".")[0]
-
-	firstOctetAsInt, err := strconv.ParseInt(firstOctet, 10, 8)
+	prefix, err := iptrie.Parse(ip, true)
 
 	if err != nil {
-		return nil, fmt.Errorf("expected first octet (%s) of IPv4 to be an integer: %s", firstOctet, ip)
-	}
-
-	if firstOctetAsInt < 0 || firstOctetAsInt > 255 {
-		return nil, fmt.Errorf("out of range first octet (%s) of IPv4: %s", firstOctet, ip)
+		return nil, err
 	}
 
-	if servers, ok := Bootstrap[uint8(firstOctetAsInt)]; ok {
+	if servers, ok := trie.Lookup(prefix); ok {
 		return servers, nil
 	}
 
-	return nil, fmt.Errorf("no RDAP servers found for first octet (%s) of IPv4: %s", firstOctet, ip)
+	return nil, fmt.Errorf("no RDAP servers found for IPv4: %s", ip)
 }
@@ -3,6 +3,7 @@ package ipv4
 import (
 	"testing"
 
+	"github.com/ryanmab/rdap-go/internal/query"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,6 +16,57 @@ func TestResolvingIpV4ToServers(t *testing.T) {
 	assert.Equal(t, []string{"https://rdap.arin.net/registry/", "http://rdap.arin.net/registry/"}, servers)
 }
 
+func TestResolvingIpV4WithFirstOctetAbove127ToServers(t *testing.T) {
+	servers, err := GetServers("200.1.1.1")
+
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"https://rdap.lacnic.net/rdap/"}, servers)
+}
+
+func TestResolvingIpV4PrefixToServers(t *testing.T) {
+	t.Run("Prefix within a bootstrap range", func(t *testing.T) {
+		servers, err := GetServers("8.8.8.0/24")
+
+		assert.Nil(t, err)
+
+		assert.Equal(t, []string{"https://rdap.arin.net/registry/", "http://rdap.arin.net/registry/"}, servers)
+	})
+
+	t.Run("Prefix wider than any bootstrap range", func(t *testing.T) {
+		_, err := GetServers("8.0.0.0/7")
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestResolvingIpV4UsesLongestPrefixMatch(t *testing.T) {
+	original := trie
+	defer func() { trie = original }()
+
+	trie = compile(map[string][]string{
+		"10.0.0.0/8":  {"https://rdap.wide.example/"},
+		"10.1.0.0/16": {"https://rdap.narrow.example/"},
+		"10.1.2.0/24": {"https://rdap.narrowest.example/"},
+	})
+
+	servers, err := GetServers("10.1.2.3")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"https://rdap.narrowest.example/"}, servers)
+
+	servers, err = GetServers("10.1.3.3")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"https://rdap.narrow.example/"}, servers)
+
+	servers, err = GetServers("10.2.0.1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"https://rdap.wide.example/"}, servers)
+
+	servers, err = GetServers("10.1.0.0/15")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"https://rdap.wide.example/"}, servers)
+}
+
 func TestResolvingInvalidIpV4ToServersReturnsAnError(t *testing.T) {
 	t.Run("greater than 255 octet", func(t *testing.T) {
 		ipv4 := "256.256.256.256"
@@ -30,11 +82,55 @@ func TestResolvingInvalidIpV4ToServersReturnsAnError(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 
+	t.Run("leading zeros", func(t *testing.T) {
+		_, err := GetServers("008.8.8.8")
+
+		var invalidErr *query.InvalidIdentifierError
+
+		assert.ErrorAs(t, err, &invalidErr)
+		assert.Equal(t, "IPv4 field has octet with leading zero", invalidErr.Reason)
+	})
+
+	t.Run("too few octets", func(t *testing.T) {
+		_, err := GetServers("8.8.8")
+
+		var invalidErr *query.InvalidIdentifierError
+
+		assert.ErrorAs(t, err, &invalidErr)
+	})
+
+	t.Run("IPv6 address", func(t *testing.T) {
+		_, err := GetServers("2001:db8::1")
+
+		var invalidErr *query.InvalidIdentifierError
+
+		assert.ErrorAs(t, err, &invalidErr)
+		assert.Equal(t, "not an IPv4 address", invalidErr.Reason)
+	})
+
+	t.Run("invalid prefix length", func(t *testing.T) {
+		_, err := GetServers("8.8.8.0/33")
+
+		var invalidErr *query.InvalidIdentifierError
+
+		assert.ErrorAs(t, err, &invalidErr)
+		assert.Equal(t, "IPv4 prefix", invalidErr.Kind)
+	})
 }
 
 func TestResolvingNonIpV4ToServersReturnsAnError(t *testing.T) {
 	ipv4 := "clearly-not-an-ipv4"
 	_, err := GetServers(ipv4)
 
-	assert.NotNil(t, err)
+	var invalidErr *query.InvalidIdentifierError
+
+	assert.ErrorAs(t, err, &invalidErr)
+}
+
+func BenchmarkResolvingIpV4ToServers(b *testing.B) {
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_, _ = GetServers("8.8.8.8")
+	}
 }
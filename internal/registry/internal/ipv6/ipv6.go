@@ -2,38 +2,43 @@ package ipv6
 
 import (
 	"fmt"
-	"net"
-	"strings"
+	"net/netip"
+
+	"github.com/ryanmab/rdap-go/internal/registry/internal/iptrie"
 )
 
-// GetServers returns the RDAP servers for a given IPv6 from the IANA bootstrap data.
+// trie is the bootstrap data compiled into a prefix trie, so that lookups find the longest
+// matching prefix regardless of the order of the bootstrap data.
+var trie = compile(Bootstrap)
+
+// compile parses the bootstrap prefixes once, inserting them into a prefix trie.
+func compile(bootstrap map[string][]string) *iptrie.Trie[[]string] {
+	compiled := &iptrie.Trie[[]string]{}
+
+	for cidr, servers := range bootstrap {
+		compiled.Insert(netip.MustParsePrefix(cidr), servers)
+	}
+
+	return compiled
+}
+
+// GetServers returns the RDAP servers for a given IPv6 address (i.e. 2001:db8::1), or IPv6
+// prefix (i.e. 2001:db8::/32), from the IANA bootstrap data.
+//
+// The servers for the longest bootstrap prefix containing the address, or the entire
+// prefix, are returned.
 //
 // See: https://data.iana.org/rdap/
 func GetServers(ip string) ([]string, error) {
-	for _, cidr := range BootstrapAccessOrder {
-		_, cidrNet, err := net.ParseCIDR(cidr)
-		if err != nil {
-			return nil, fmt.Errorf("invalid CIDR notation: %s", cidr)
-		}
-
-		if !strings.Contains(ip, "/") {
-			ip = fmt.Sprintf("%s/128", ip)
-		}
-
-		_, ipNet, err := net.ParseCIDR(ip)
-
-		if err != nil {
-			return nil, fmt.Errorf("invalid IP address: %s", ip)
-		}
-
-		if cidrNet.Contains(ipNet.IP) {
-			// The bootstrap entries are ordered from most specific to least specific
-			// (i.e. highest subnet mask to lowest subnet mask, and highest number of hextets
-			// specified to lowest number of hextets specified). Therefore, we can return the
-			// first match we find, as this will be the most specific matching range.
-			return Bootstrap[cidr], nil
-		}
+	prefix, err := iptrie.Parse(ip, false)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if servers, ok := trie.Lookup(prefix); ok {
+		return servers, nil
 	}
 
-	return nil, fmt.Errorf("no RDAP servers found for IPv4: %s", ip)
+	return nil, fmt.Errorf("no RDAP servers found for IPv6: %s", ip)
 }
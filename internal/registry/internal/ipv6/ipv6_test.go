@@ -3,6 +3,7 @@ package ipv6
 import (
 	"testing"
 
+	"github.com/ryanmab/rdap-go/internal/query"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,7 +22,9 @@ func TestResolvingInvalidIpV6ReturnsAnError(t *testing.T) {
 
 	_, err := GetServers(ip)
 
-	assert.NotNil(t, err)
+	var invalidErr *query.InvalidIdentifierError
+
+	assert.ErrorAs(t, err, &invalidErr)
 }
 
 func TestResolvingIpV6ReturnsServersFromMostSpecificRange(t *testing.T) {
@@ -34,3 +37,50 @@ func TestResolvingIpV6ReturnsServersFromMostSpecificRange(t *testing.T) {
 	assert.Equal(t, []string{"https://rdap.db.ripe.net/"}, servers)
 
 }
+
+func TestResolvingIpV6PrefixToServers(t *testing.T) {
+	servers, err := GetServers("2001:4c00::/32")
+
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"https://rdap.db.ripe.net/"}, servers)
+}
+
+func TestResolvingIpV6IsIndependentOfBootstrapOrder(t *testing.T) {
+	original := trie
+	defer func() { trie = original }()
+
+	// Map iteration order is random, so compiling the same bootstrap data repeatedly
+	// exercises many insertion orders.
+	for range 20 {
+		trie = compile(map[string][]string{
+			"2001::/16":       {"https://rdap.wide.example/"},
+			"2001:db8::/32":   {"https://rdap.narrow.example/"},
+			"2001:db8:1::/48": {"https://rdap.narrowest.example/"},
+		})
+
+		servers, err := GetServers("2001:db8:1::1")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"https://rdap.narrowest.example/"}, servers)
+
+		servers, err = GetServers("2001:db8:2::1")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"https://rdap.narrow.example/"}, servers)
+	}
+}
+
+func BenchmarkResolvingIPv6AddressToServers(b *testing.B) {
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_, _ = GetServers("2001:4860:4860::8888")
+	}
+}
+
+func BenchmarkResolvingIPv6AddressWithoutMatchToServers(b *testing.B) {
+	b.ReportAllocs()
+
+	for b.Loop() {
+		_, _ = GetServers("fd00::1")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ryanmab/rdap-go/internal/query"
 	"github.com/ryanmab/rdap-go/internal/registry/internal/asn"
 	"github.com/ryanmab/rdap-go/internal/registry/internal/dns"
+	"github.com/ryanmab/rdap-go/internal/registry/internal/entity"
 	"github.com/ryanmab/rdap-go/internal/registry/internal/ipv4"
 	"github.com/ryanmab/rdap-go/internal/registry/internal/ipv6"
 )
@@ -28,6 +29,8 @@ func GetServers(queryType query.RdapQuery, identifier string) ([]string, error)
 		}
 
 		return asn.GetServers(uint32(identifierAsInt))
+	case query.EntityQuery:
+		return entity.GetServers(identifier)
 	}
 
 	return nil, fmt.Errorf("unknown query type: %s", queryType)
@@ -51,3 +51,12 @@ func TestResolvingASNToServers(t *testing.T) {
 		servers,
 	)
 }
+
+func TestResolvingEntityHandleToServers(t *testing.T) {
+	handle := "ZG39-ARIN"
+	servers, err := GetServers(query.EntityQuery, handle)
+
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"https://rdap.arin.net/registry/", "http://rdap.arin.net/registry/"}, servers)
+}
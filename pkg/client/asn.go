@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/ryanmab/rdap-go/pkg/client/response/asn"
+)
+
+// ParseASN parses an autonomous system number in any of the common notations - asplain (i.e.
+// 15169 or 65546), asdot and asdot+ (i.e. 1.10 or 0.15169) - with or without an "AS" prefix.
+//
+// An InvalidIdentifierError describing the problem is returned if the ASN is malformed, or
+// does not fit in 32 bits.
+//
+// See: https://datatracker.ietf.org/doc/rfc5396/
+func ParseASN(autnum string) (uint32, error) {
+	input := autnum
+	invalid := func(reason string) error {
+		return &InvalidIdentifierError{Kind: "ASN", Identifier: input, Reason: reason}
+	}
+
+	autnum = strings.TrimSpace(autnum)
+
+	if len(autnum) >= 2 && strings.EqualFold(autnum[:2], "AS") {
+		autnum = strings.TrimSpace(autnum[2:])
+	}
+
+	if autnum == "" {
+		return 0, invalid("no number was given")
+	}
+
+	high, low, dotted := strings.Cut(autnum, ".")
+
+	if !dotted {
+		value, err := parseASNPart(autnum, 32)
+
+		if err != nil {
+			return 0, invalid(err.Error())
+		}
+
+		return uint32(value), nil
+	}
+
+	if strings.Contains(low, ".") {
+		return 0, invalid("asdot notation must contain exactly one dot")
+	}
+
+	highValue, err := parseASNPart(high, 16)
+
+	if err != nil {
+		return 0, invalid("high order part " + err.Error())
+	}
+
+	lowValue, err := parseASNPart(low, 16)
+
+	if err != nil {
+		return 0, invalid("low order part " + err.Error())
+	}
+
+	return uint32(highValue<<16 | lowValue), nil
+}
+
+// parseASNPart parses a decimal number of at most the given number of bits, which is either
+// a whole asplain ASN, or one half of an asdot ASN.
+func parseASNPart(part string, bits int) (uint64, error) {
+	if part == "" {
+		return 0, errors.New("is empty")
+	}
+
+	for _, r := range part {
+		if r < '0' || r > '9' {
+			return 0, errors.New("must only contain the digits 0-9")
+		}
+	}
+
+	value, err := strconv.ParseUint(part, 10, bits)
+
+	if err != nil {
+		return 0, errors.New("exceeds the maximum of " + strconv.FormatUint(1<<bits-1, 10))
+	}
+
+	return value, nil
+}
+
+// LookupASNString looks up an Autnum given in any of the notations accepted by ParseASN (i.e.
+// AS15169 or 1.10), using RDAP and retrieves its registration data.
+func (client *Client) LookupASNString(autnum string) (*asn.Response, error) {
+	return client.LookupASNStringContext(context.Background(), autnum)
+}
+
+// LookupASNStringContext is like LookupASNString, but uses the provided context for the
+// lifetime of the lookup.
+func (client *Client) LookupASNStringContext(ctx context.Context, autnum string) (*asn.Response, error) {
+	value, err := ParseASN(autnum)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return client.LookupASNContext(ctx, value)
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/asn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingASNs(t *testing.T) {
+	tests := map[string]uint32{
+		"15169":         15169,
+		" 15169 ":       15169,
+		"AS15169":       15169,
+		"as15169":       15169,
+		"As 15169":      15169,
+		"0":             0,
+		"4294967295":    4294967295,
+		"1.10":          65546,
+		"AS1.10":        65546,
+		"0.15169":       15169,
+		"65535.65535":   4294967295,
+		"AS65535.65535": 4294967295,
+	}
+
+	for input, expected := range tests {
+		autnum, err := ParseASN(input)
+
+		assert.NoError(t, err, "ASN %q", input)
+		assert.Equal(t, expected, autnum, "ASN %q", input)
+	}
+}
+
+func TestParsingInvalidASNsReturnsAnError(t *testing.T) {
+	tests := map[string]string{
+		"":            `invalid ASN "": no number was given`,
+		"AS":          `invalid ASN "AS": no number was given`,
+		"ASN15169":    `invalid ASN "ASN15169": must only contain the digits 0-9`,
+		"-1":          `invalid ASN "-1": must only contain the digits 0-9`,
+		"+15169":      `invalid ASN "+15169": must only contain the digits 0-9`,
+		"4294967296":  `invalid ASN "4294967296": exceeds the maximum of 4294967295`,
+		"1.":          `invalid ASN "1.": low order part is empty`,
+		".10":         `invalid ASN ".10": high order part is empty`,
+		"1.2.3":       `invalid ASN "1.2.3": asdot notation must contain exactly one dot`,
+		"65536.0":     `invalid ASN "65536.0": high order part exceeds the maximum of 65535`,
+		"1.65536":     `invalid ASN "1.65536": low order part exceeds the maximum of 65535`,
+		"1.1x":        `invalid ASN "1.1x": low order part must only contain the digits 0-9`,
+		"example.com": `invalid ASN "example.com": high order part must only contain the digits 0-9`,
+	}
+
+	for input, expected := range tests {
+		_, err := ParseASN(input)
+
+		var invalidErr *InvalidIdentifierError
+
+		assert.ErrorAs(t, err, &invalidErr, "ASN %q", input)
+		assert.EqualError(t, err, expected, "ASN %q", input)
+	}
+}
+
+func TestLookingUpASNString(t *testing.T) {
+	client := New()
+
+	// Seed the cache, so that the lookups can be performed without making network requests.
+	client.cache.Set(query.AsnQuery, "15169", asn.Response{Name: "GOOGLE"})
+	client.cache.Set(query.AsnQuery, "131082", asn.Response{Name: "APNIC-AS"})
+
+	for input, expected := range map[string]string{"AS15169": "GOOGLE", "0.15169": "GOOGLE", "as2.10": "APNIC-AS"} {
+		response, err := client.LookupASNString(input)
+
+		assert.NoError(t, err, "ASN %q", input)
+		assert.Equal(t, expected, response.Name, "ASN %q", input)
+	}
+
+	_, err := client.LookupASNString("AS-GOOGLE")
+
+	var invalidErr *InvalidIdentifierError
+
+	assert.ErrorAs(t, err, &invalidErr)
+}
@@ -0,0 +1,215 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// BulkOptions configures the concurrency and progress reporting of LookupMany.
+type BulkOptions struct {
+	// Concurrency is the maximum number of lookups performed at once. Defaults to 8.
+	Concurrency int
+
+	// ServerConcurrency maps an RDAP server host (i.e. rdap.db.ripe.net) to the maximum
+	// number of requests which may be made to it at once.
+	ServerConcurrency map[string]int
+
+	// DefaultServerConcurrency is the maximum number of requests which may be made at once
+	// to an RDAP server host without an entry in ServerConcurrency. Defaults to 2.
+	DefaultServerConcurrency int
+
+	// OnProgress, when set, is called after each lookup completes. It is never called
+	// concurrently, nor once the context of LookupMany is done.
+	OnProgress func(progress BulkProgress)
+}
+
+// BulkProgress reports the progress of a call to LookupMany.
+type BulkProgress struct {
+	// Completed is the number of lookups which have finished, including those which failed.
+	Completed int
+
+	// Failed is the number of lookups which finished with an error.
+	Failed int
+
+	// Total is the number of lookups requested.
+	Total int
+}
+
+// BulkResult is the outcome of a single lookup performed by LookupMany.
+type BulkResult struct {
+	// Index is the position of the identifier in the slice passed to LookupMany.
+	Index int
+
+	// Input is the identifier as passed to LookupMany.
+	Input string
+
+	// Kind is the kind of resource the identifier was classified as.
+	Kind IdentifierKind
+
+	// Response is the typed RDAP response (i.e. *dns.Response or *ipv4.Response), or nil
+	// if the lookup failed.
+	Response any
+
+	// Err is the error encountered performing the lookup, if any.
+	Err error
+}
+
+// LookupMany looks up a batch of mixed identifiers (of any kind accepted by Lookup)
+// concurrently, sending each result on the returned channel as it completes.
+//
+// Results are delivered in completion order, not input order. A failed lookup is reported
+// on its result, and never stops the rest of the batch. The channel is closed once every
+// lookup has completed, or the context is done.
+//
+// The channel is buffered to hold every result, so the lookups run to completion (rather
+// than blocking) even if the caller stops receiving from it without cancelling the context.
+//
+// The lookups use the configuration of the client at the time LookupMany is called.
+func (client *Client) LookupMany(ctx context.Context, identifiers []string, options BulkOptions) <-chan BulkResult {
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = 8
+	}
+
+	// The lookups are made using a copy of the client which caps the concurrent requests
+	// made to each server, sharing its cache and rate limits.
+	bulk := *client
+	bulk.serverLimits = newServerLimits(options.ServerConcurrency, options.DefaultServerConcurrency)
+
+	jobs := make(chan int)
+	completed := make(chan BulkResult)
+	results := make(chan BulkResult, len(identifiers))
+
+	var workers sync.WaitGroup
+	for range min(concurrency, max(len(identifiers), 1)) {
+		workers.Add(1)
+
+		go func() {
+			defer workers.Done()
+
+			for index := range jobs {
+				completed <- bulk.lookupOne(ctx, index, identifiers[index])
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+
+		for index := range identifiers {
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- index:
+			}
+		}
+	}()
+
+	go func() {
+		workers.Wait()
+		close(completed)
+	}()
+
+	go func() {
+		defer close(results)
+
+		progress := BulkProgress{Total: len(identifiers)}
+
+		for result := range completed {
+			progress.Completed++
+			if result.Err != nil {
+				progress.Failed++
+			}
+
+			if ctx.Err() != nil {
+				// Keep draining completed lookups so that the workers can exit.
+				continue
+			}
+
+			if options.OnProgress != nil {
+				options.OnProgress(progress)
+			}
+
+			select {
+			case <-ctx.Done():
+			case results <- result:
+			}
+		}
+	}()
+
+	return results
+}
+
+// lookupOne performs a single lookup for LookupMany, converting any panic into an error
+// so that it cannot abort the rest of the batch.
+func (client *Client) lookupOne(ctx context.Context, index int, identifier string) (result BulkResult) {
+	result = BulkResult{Index: index, Input: identifier, Kind: classify(identifier)}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			result.Response = nil
+			result.Err = fmt.Errorf("lookup of %q panicked: %v", identifier, recovered)
+		}
+	}()
+
+	response, err := client.lookup(ctx, result.Kind, identifier)
+
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	result.Response = response
+
+	return result
+}
+
+// serverLimits caps the number of concurrent requests made to each RDAP server host.
+type serverLimits struct {
+	mu           sync.Mutex
+	limits       map[string]int
+	defaultLimit int
+	semaphores   map[string]chan struct{}
+}
+
+// newServerLimits creates the per-server concurrency limits for a bulk lookup.
+func newServerLimits(limits map[string]int, defaultLimit int) *serverLimits {
+	if defaultLimit <= 0 {
+		defaultLimit = 2
+	}
+
+	return &serverLimits{
+		limits:       limits,
+		defaultLimit: defaultLimit,
+		semaphores:   make(map[string]chan struct{}),
+	}
+}
+
+// acquire blocks until a request may be made to the host, or the context is done. The
+// returned function must be called once the request has completed.
+func (limits *serverLimits) acquire(ctx context.Context, host string) (func(), error) {
+	limits.mu.Lock()
+
+	semaphore, ok := limits.semaphores[host]
+
+	if !ok {
+		limit, ok := limits.limits[host]
+
+		if !ok || limit <= 0 {
+			limit = limits.defaultLimit
+		}
+
+		semaphore = make(chan struct{}, limit)
+		limits.semaphores[host] = semaphore
+	}
+
+	limits.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case semaphore <- struct{}{}:
+		return func() { <-semaphore }, nil
+	}
+}
@@ -0,0 +1,195 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/asn"
+	"github.com/ryanmab/rdap-go/pkg/client/response/dns"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv4"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookingUpManyIdentifiers(t *testing.T) {
+	client := New()
+
+	// Seed the cache, so that the lookups can be performed without making network requests.
+	client.cache.Set(query.DomainQuery, "example.com", dns.Response{LdhName: "EXAMPLE.COM"})
+	client.cache.Set(query.IPv4Query, "8.8.8.8", ipv4.Response{Name: "GOGL"})
+	client.cache.Set(query.IPv6Query, "2001:4860:4860::8888", ipv6.Response{Name: "GOOGLE-IPV6"})
+	client.cache.Set(query.AsnQuery, "15169", asn.Response{Name: "GOOGLE"})
+
+	identifiers := []string{
+		"example.com",
+		"8.8.8.8",
+		"not an identifier",
+		"2001:4860:4860::8888",
+		"AS15169",
+	}
+
+	var progress []BulkProgress
+
+	results := make(map[int]BulkResult)
+
+	for result := range client.LookupMany(context.Background(), identifiers, BulkOptions{
+		Concurrency: 2,
+		OnProgress: func(p BulkProgress) {
+			progress = append(progress, p)
+		},
+	}) {
+		results[result.Index] = result
+	}
+
+	assert.Len(t, results, len(identifiers))
+
+	for index, identifier := range identifiers {
+		assert.Equal(t, identifier, results[index].Input)
+	}
+
+	assert.Equal(t, KindDomain, results[0].Kind)
+	assert.Equal(t, "EXAMPLE.COM", results[0].Response.(*dns.Response).LdhName)
+
+	assert.Equal(t, KindIPv4, results[1].Kind)
+	assert.Equal(t, "GOGL", results[1].Response.(*ipv4.Response).Name)
+
+	assert.Equal(t, KindUnknown, results[2].Kind)
+	assert.Error(t, results[2].Err)
+	assert.Nil(t, results[2].Response)
+
+	assert.Equal(t, KindIPv6, results[3].Kind)
+	assert.Equal(t, "GOOGLE-IPV6", results[3].Response.(*ipv6.Response).Name)
+
+	assert.Equal(t, KindASN, results[4].Kind)
+	assert.Equal(t, "GOOGLE", results[4].Response.(*asn.Response).Name)
+
+	assert.Len(t, progress, len(identifiers))
+	assert.Equal(t, BulkProgress{Completed: 5, Failed: 1, Total: 5}, progress[len(progress)-1])
+}
+
+func TestProgressIsNotReportedOnceContextIsDone(t *testing.T) {
+	client := New()
+
+	identifiers := make([]string, 20)
+
+	for i := range identifiers {
+		identifiers[i] = fmt.Sprintf("8.8.8.%d", i)
+		client.cache.Set(query.IPv4Query, identifiers[i], ipv4.Response{Name: "GOGL"})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var progress []BulkProgress
+
+	for range client.LookupMany(ctx, identifiers, BulkOptions{
+		Concurrency: 4,
+		OnProgress: func(p BulkProgress) {
+			progress = append(progress, p)
+			cancel()
+		},
+	}) {
+	}
+
+	assert.Len(t, progress, 1)
+}
+
+func TestLookupsCompleteWhenResultsAreNotReceived(t *testing.T) {
+	client := New()
+
+	identifiers := make([]string, 20)
+
+	for i := range identifiers {
+		identifiers[i] = fmt.Sprintf("8.8.8.%d", i)
+		client.cache.Set(query.IPv4Query, identifiers[i], ipv4.Response{Name: "GOGL"})
+	}
+
+	results := client.LookupMany(context.Background(), identifiers, BulkOptions{Concurrency: 4})
+
+	// Every result is buffered, without any being received.
+	assert.Eventually(t, func() bool { return len(results) == len(identifiers) }, time.Second, time.Millisecond)
+
+	count := 0
+	for range results {
+		count++
+	}
+
+	assert.Equal(t, len(identifiers), count)
+}
+
+func TestLookingUpNoIdentifiers(t *testing.T) {
+	client := New()
+
+	count := 0
+	for range client.LookupMany(context.Background(), nil, BulkOptions{}) {
+		count++
+	}
+
+	assert.Equal(t, 0, count)
+}
+
+func TestServerLimitsCapConcurrentRequests(t *testing.T) {
+	limits := newServerLimits(map[string]int{"rdap.arin.net": 1}, 3)
+
+	var inFlight, peakArin, peakOther atomic.Int32
+
+	measure := func(host string, peak *atomic.Int32) {
+		release, err := limits.acquire(context.Background(), host)
+		assert.NoError(t, err)
+		defer release()
+
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			measure("rdap.arin.net", &peakArin)
+		}()
+	}
+	wg.Wait()
+
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			measure("rdap.db.ripe.net", &peakOther)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), peakArin.Load())
+	assert.LessOrEqual(t, peakOther.Load(), int32(3))
+}
+
+func TestServerLimitsRespectContext(t *testing.T) {
+	limits := newServerLimits(nil, 1)
+
+	release, err := limits.acquire(context.Background(), "rdap.arin.net")
+	assert.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = limits.acquire(ctx, "rdap.arin.net")
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -1,74 +1,122 @@
 package client
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/netip"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/ryanmab/rdap-go/internal/cache"
 	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/internal/ratelimit"
 	"github.com/ryanmab/rdap-go/internal/registry"
 	"github.com/ryanmab/rdap-go/pkg/client/response/asn"
 	"github.com/ryanmab/rdap-go/pkg/client/response/dns"
+	"github.com/ryanmab/rdap-go/pkg/client/response/entity"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ip"
 	"github.com/ryanmab/rdap-go/pkg/client/response/ipv4"
 	"github.com/ryanmab/rdap-go/pkg/client/response/ipv6"
 )
 
 // Client is an RDAP client for performing lookups on domains, IPv4, and IPv6 addresses.
 type Client struct {
-	httpClient *http.Client
-	cache      *cache.Cache
+	httpClient      *http.Client
+	policyClient    *http.Client
+	policyErr       error
+	cache           *cache.Cache
+	transportPolicy TransportPolicy
+	retryPolicy     RetryPolicy
+	rateLimiter     *ratelimit.Limiter
+	hedgeDelay      time.Duration
+	suffixPolicy    SuffixPolicy
+
+	validationPolicy ValidationPolicy
+	maxResponseSize  int64
+
+	embeddedIPv4Lookups bool
+
+	// serverLimits caps the concurrent requests made to each RDAP server host. It's only set
+	// on the copy of the Client used by LookupMany.
+	serverLimits *serverLimits
 }
 
 // New creates a new RDAP client instance with default settings.
 func New() *Client {
-	return &Client{
-		httpClient: &http.Client{},
-		cache:      cache.New(),
+	client := &Client{
+		httpClient:      &http.Client{},
+		cache:           cache.New(),
+		transportPolicy: DefaultTransportPolicy(),
+		retryPolicy:     NoRetryPolicy(),
+		rateLimiter:     newRateLimiter(),
+		maxResponseSize: DefaultMaxResponseSize,
 	}
+
+	client.applyTransportPolicy()
+
+	return client
 }
 
 // LookupDomain looks up a domain, using RDAP and retrieves its Domain registration data.
+//
+// Internationalized domain names may be given in either Unicode (i.e. bücher.de) or A-label
+// (i.e. xn--bcher-kva.de) form, and are converted to A-labels using UTS #46 processing
+// before the lookup is made.
+//
+// Hostnames are reduced to their registrable domain (i.e. www.mail.example.co.uk is looked
+// up as example.co.uk) according to the Client's suffix policy. See WithSuffixPolicy.
 func (client *Client) LookupDomain(domain string) (*dns.Response, error) {
-	domain = strings.ToLower(strings.TrimSpace(domain))
+	return client.LookupDomainContext(context.Background(), domain)
+}
 
-	if !strings.HasPrefix(domain, "http://") && !strings.HasPrefix(domain, "https://") {
-		domain = "https://" + domain
-	}
-	url, err := url.Parse(domain)
+// LookupDomainContext is like LookupDomain, but uses the provided context for the
+// lifetime of the lookup.
+func (client *Client) LookupDomainContext(ctx context.Context, domain string) (*dns.Response, error) {
+	lookup, err := client.LookupRegistrableDomainContext(ctx, domain)
 
 	if err != nil {
 		return nil, err
 	}
 
-	tld := url.Hostname()[strings.LastIndex(url.Hostname(), ".")+1:]
+	return lookup.Domain, nil
+}
 
-	slog.Info("Parsed domain to TLD for lookup", "domain", domain, "tld", tld)
+// LookupIPv4 looks up an IPv4 address, using RDAP and retrieves its IP registration data.
+func (client *Client) LookupIPv4(ip string) (*ipv4.Response, error) {
+	return client.LookupIPv4Context(context.Background(), ip)
+}
 
-	servers, err := registry.GetServers(query.DomainQuery, tld)
+// LookupIPv4Context is like LookupIPv4, but uses the provided context for the
+// lifetime of the lookup.
+func (client *Client) LookupIPv4Context(ctx context.Context, ip string) (*ipv4.Response, error) {
+	ip = strings.TrimSpace(ip)
+	addr, err := netip.ParseAddr(ip)
 
 	if err != nil {
-		slog.Error("failed to get RDAP servers for TLD", "tld", tld, "error", err)
-		return nil, err
+		return nil, &InvalidIdentifierError{Kind: "IPv4 address", Identifier: ip, Reason: err.Error()}
 	}
 
-	response, err := client.request(servers, query.DomainQuery, url.Hostname())
-
-	if response, ok := response.(dns.Response); ok {
-		return &response, err
-	}
+	return client.LookupIPv4AddrContext(ctx, addr)
+}
 
-	return nil, fmt.Errorf("unexpected response type returned from RDAP server call (expected dns.Response), type was: %T", response)
+// LookupIPv4Addr looks up an IPv4 address, using RDAP and retrieves its IP registration data.
+func (client *Client) LookupIPv4Addr(addr netip.Addr) (*ipv4.Response, error) {
+	return client.LookupIPv4AddrContext(context.Background(), addr)
 }
 
-// LookupIPv4 looks up an IPv4 address, using RDAP and retrieves its IP registration data.
-func (client *Client) LookupIPv4(ip string) (*ipv4.Response, error) {
-	ip = strings.TrimSpace(ip)
+// LookupIPv4AddrContext is like LookupIPv4Addr, but uses the provided context for the
+// lifetime of the lookup.
+func (client *Client) LookupIPv4AddrContext(ctx context.Context, addr netip.Addr) (*ipv4.Response, error) {
+	if !addr.Is4() {
+		return nil, &InvalidIdentifierError{Kind: "IPv4 address", Identifier: addr.String(), Reason: "not an IPv4 address"}
+	}
+
+	ip := addr.String()
 	servers, err := registry.GetServers(query.IPv4Query, ip)
 
 	if err != nil {
@@ -76,18 +124,48 @@ func (client *Client) LookupIPv4(ip string) (*ipv4.Response, error) {
 		return nil, err
 	}
 
-	response, err := client.request(servers, query.IPv4Query, ip)
-
-	if response, ok := response.(ipv4.Response); ok {
-		return &response, err
-	}
-
-	return nil, fmt.Errorf("unexpected response type returned from RDAP server call (expected ipv4.Response), type was: %T", response)
+	return typed[ipv4.Response](client.request(ctx, servers, query.IPv4Query, ip))
 }
 
 // LookupIPv6 looks up an IPv6 address, using RDAP and retrieves its IP registration data.
 func (client *Client) LookupIPv6(ip string) (*ipv6.Response, error) {
+	return client.LookupIPv6Context(context.Background(), ip)
+}
+
+// LookupIPv6Context is like LookupIPv6, but uses the provided context for the
+// lifetime of the lookup.
+func (client *Client) LookupIPv6Context(ctx context.Context, ip string) (*ipv6.Response, error) {
 	ip = strings.TrimSpace(ip)
+	addr, err := netip.ParseAddr(ip)
+
+	if err != nil {
+		return nil, &InvalidIdentifierError{Kind: "IPv6 address", Identifier: ip, Reason: err.Error()}
+	}
+
+	return client.LookupIPv6AddrContext(ctx, addr)
+}
+
+// LookupIPv6Addr looks up an IPv6 address, using RDAP and retrieves its IP registration data.
+//
+// IPv6 addresses which embed an IPv4 address that must be looked up instead (see
+// EmbeddedIPv4Error) are not sent to the IPv6 bootstrap servers - use LookupIP to look up
+// addresses of either family.
+func (client *Client) LookupIPv6Addr(addr netip.Addr) (*ipv6.Response, error) {
+	return client.LookupIPv6AddrContext(context.Background(), addr)
+}
+
+// LookupIPv6AddrContext is like LookupIPv6Addr, but uses the provided context for the
+// lifetime of the lookup.
+func (client *Client) LookupIPv6AddrContext(ctx context.Context, addr netip.Addr) (*ipv6.Response, error) {
+	if !addr.Is6() {
+		return nil, &InvalidIdentifierError{Kind: "IPv6 address", Identifier: addr.String(), Reason: "not an IPv6 address"}
+	}
+
+	if embedded, embedding, translated := client.translated(addr); translated {
+		return nil, &EmbeddedIPv4Error{Addr: addr, Embedded: embedded, Embedding: embedding}
+	}
+
+	ip := addr.String()
 	servers, err := registry.GetServers(query.IPv6Query, ip)
 
 	if err != nil {
@@ -95,17 +173,68 @@ func (client *Client) LookupIPv6(ip string) (*ipv6.Response, error) {
 		return nil, err
 	}
 
-	response, err := client.request(servers, query.IPv6Query, ip)
+	return typed[ipv6.Response](client.request(ctx, servers, query.IPv6Query, ip))
+}
+
+// LookupIPPrefix looks up an IP network (i.e. 192.0.2.0/24 or 2001:db8::/32), using RDAP
+// and retrieves the registration data of the network object.
+//
+// See Section 3.1.1: https://datatracker.ietf.org/doc/rfc9082/
+func (client *Client) LookupIPPrefix(prefix netip.Prefix) (*ip.Response, error) {
+	return client.LookupIPPrefixContext(context.Background(), prefix)
+}
 
-	if response, ok := response.(ipv6.Response); ok {
-		return &response, err
+// LookupIPPrefixContext is like LookupIPPrefix, but uses the provided context for the
+// lifetime of the lookup.
+func (client *Client) LookupIPPrefixContext(ctx context.Context, prefix netip.Prefix) (*ip.Response, error) {
+	if !prefix.IsValid() {
+		return nil, &InvalidIdentifierError{Kind: "IP prefix", Identifier: prefix.String(), Reason: "not a valid prefix"}
 	}
 
-	return nil, fmt.Errorf("unexpected response type returned from RDAP server call (expected ipv6.Response), type was: %T", response)
+	prefix = prefix.Masked()
+	identifier := prefix.String()
+
+	queryType := query.IPv6Query
+	if prefix.Addr().Is4() {
+		queryType = query.IPv4Query
+	}
+
+	servers, err := registry.GetServers(queryType, identifier)
+
+	if err != nil {
+		slog.Error("failed to get RDAP servers for IP prefix", "prefix", identifier, "error", err)
+		return nil, err
+	}
+
+	response, err := client.request(ctx, servers, queryType, identifier)
+
+	if queryType == query.IPv4Query {
+		network, err := typed[ipv4.Response](response, err)
+
+		if network == nil {
+			return nil, err
+		}
+
+		return &ip.Response{IPv4: network}, err
+	}
+
+	network, err := typed[ipv6.Response](response, err)
+
+	if network == nil {
+		return nil, err
+	}
+
+	return &ip.Response{IPv6: network}, err
 }
 
 // LookupASN looks up a given Autnum, using RDAP and retrieves its registration data.
 func (client *Client) LookupASN(autnum uint32) (*asn.Response, error) {
+	return client.LookupASNContext(context.Background(), autnum)
+}
+
+// LookupASNContext is like LookupASN, but uses the provided context for the
+// lifetime of the lookup.
+func (client *Client) LookupASNContext(ctx context.Context, autnum uint32) (*asn.Response, error) {
 	autnumAsString := strconv.FormatUint(uint64(autnum), 10)
 
 	servers, err := registry.GetServers(query.AsnQuery, autnumAsString)
@@ -115,14 +244,45 @@ func (client *Client) LookupASN(autnum uint32) (*asn.Response, error) {
 		return nil, err
 	}
 
-	response, err := client.request(servers, query.AsnQuery, autnumAsString)
+	return typed[asn.Response](client.request(ctx, servers, query.AsnQuery, autnumAsString))
+}
+
+// LookupEntity looks up an entity by its handle (i.e. ZG39-ARIN), using RDAP and retrieves
+// its registration data.
+//
+// The RDAP servers are found using the service provider tag the handle ends with.
+//
+// See: https://datatracker.ietf.org/doc/rfc8521/
+func (client *Client) LookupEntity(handle string) (*entity.Response, error) {
+	return client.LookupEntityContext(context.Background(), handle)
+}
+
+// LookupEntityContext is like LookupEntity, but uses the provided context for the
+// lifetime of the lookup.
+func (client *Client) LookupEntityContext(ctx context.Context, handle string) (*entity.Response, error) {
+	handle = strings.TrimSpace(handle)
 
-	if response, ok := response.(asn.Response); ok {
-		return &response, err
+	servers, err := registry.GetServers(query.EntityQuery, handle)
+
+	if err != nil {
+		slog.Error("failed to get RDAP servers for entity", "handle", handle, "error", err)
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("unexpected response type returned from RDAP server call (expected asn.Response), type was: %T", response)
+	return typed[entity.Response](client.request(ctx, servers, query.EntityQuery, url.PathEscape(handle)))
+}
 
+// typed asserts the response returned by an RDAP request is of the expected response type.
+func typed[T any](response any, err error) (*T, error) {
+	if typedResponse, ok := response.(T); ok {
+		return &typedResponse, err
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("unexpected response type returned from RDAP server call (expected %T), type was: %T", *new(T), response)
 }
 
 // ClearCache empties the cache of any responses previously recorded by the Client.
@@ -131,8 +291,13 @@ func (client *Client) ClearCache() {
 }
 
 // WithHTTPClient sets a custom HTTP client for the RDAP client to use for requests.
+//
+// The transport policy is enforced on a copy of the HTTP client. If the policy has a minimum
+// TLS version or pins, the HTTP client must use an *http.Transport (or the default transport),
+// otherwise lookups fail with ErrTransportPolicyUnenforceable.
 func (client *Client) WithHTTPClient(httpClient *http.Client) {
 	client.httpClient = httpClient
+	client.applyTransportPolicy()
 }
 
 // WithCache sets a custom cache for the RDAP client to use for caching responses.
@@ -140,102 +305,237 @@ func (client *Client) WithCache(cache *cache.Cache) {
 	client.cache = cache
 }
 
+// WithRetryPolicy sets the policy the RDAP client uses to retry requests to RDAP servers
+// which fail with transient errors.
+func (client *Client) WithRetryPolicy(policy RetryPolicy) {
+	client.retryPolicy = policy
+}
+
+// WithRateLimit sets the rate limit the RDAP client applies to requests made to the given
+// RDAP server host (i.e. rdap.db.ripe.net).
+//
+// Requests exceeding the rate limit block until they are permitted, or until the context of
+// the lookup is done. The rate limit is shared by all lookups performed by the client.
+func (client *Client) WithRateLimit(host string, limit RateLimit) {
+	client.rateLimiter.SetLimit(host, limit)
+}
+
+// WithDefaultRateLimit sets the rate limit the RDAP client applies to requests made to RDAP
+// server hosts which do not have a specific rate limit configured.
+//
+// By default, 10 requests per second are permitted to each host, with a burst of 10.
+func (client *Client) WithDefaultRateLimit(limit RateLimit) {
+	client.rateLimiter.SetDefaultLimit(limit)
+}
+
+// WithHedging enables hedged requests when the bootstrap registries list more than one RDAP
+// server for a lookup.
+//
+// When enabled, a request is started to the next server if the previous server has not
+// responded within the delay (or immediately, if it fails). The first successful response
+// is used, and any requests still in flight are cancelled. A zero delay disables hedging,
+// so that servers are only attempted once the previous server has failed.
+func (client *Client) WithHedging(delay time.Duration) {
+	client.hedgeDelay = delay
+}
+
+// WithEmbeddedIPv4Lookups sets whether LookupIP looks up IPv6 addresses which embed an IPv4
+// address (i.e. 6to4, Teredo or NAT64 addresses) using the embedded IPv4 address, rather than
+// the IPv6 address. When enabled, IPv6 lookups of these addresses (i.e. LookupIPv6) return an
+// EmbeddedIPv4Error. IPv4-mapped addresses are always looked up using the IPv4 address.
+func (client *Client) WithEmbeddedIPv4Lookups(enabled bool) {
+	client.embeddedIPv4Lookups = enabled
+}
+
+// WithTransportPolicy sets the transport security policy the RDAP client enforces when
+// contacting RDAP servers.
+func (client *Client) WithTransportPolicy(policy TransportPolicy) {
+	client.transportPolicy = policy
+	client.applyTransportPolicy()
+}
+
+// applyTransportPolicy builds the HTTP client used for requests, which enforces the transport
+// policy on the configured HTTP client.
+func (client *Client) applyTransportPolicy() {
+	client.policyClient, client.policyErr = client.transportPolicy.client(client.httpClient)
+}
+
 // Request performs an RDAP request to the provided servers for the given query type and identifier.
-func (client *Client) request(servers []string, queryType query.RdapQuery, identifier string) (any, error) {
-	if output := client.cache.Get(queryType, identifier); output != nil {
+func (client *Client) request(ctx context.Context, servers []string, queryType query.RdapQuery, identifier string) (any, error) {
+	key := client.cacheKey(identifier)
+
+	if output := client.cache.Get(queryType, key); output != nil {
 		slog.Info("Response cache hit. Using cached response instead of performing RDAP request", "identifier", identifier, "query", queryType)
 
 		return *output, nil
 	}
 
-	for _, server := range servers {
-		serverResponse, err := client.httpClient.Get(server + queryType.String() + "/" + identifier)
+	servers, errs := client.transportPolicy.order(servers)
 
-		if err != nil {
-			slog.Warn("RDAP server request failed. Using another server if available.", "server", server, "error", err)
-			continue
-		}
+	for _, err := range errs {
+		slog.Warn("RDAP server skipped due to transport policy.", "error", err)
+	}
 
-		if serverResponse.StatusCode != http.StatusOK {
-			slog.Warn("RDAP server request returned non-200 status code. Using another server if available.", "server", server, "code", serverResponse.StatusCode)
-			continue
-		}
+	budget := &attemptBudget{limit: int64(client.retryPolicy.MaxAttempts)}
 
-		defer serverResponse.Body.Close()
+	var response any
+	var err error
 
-		response, err := parseResponse(queryType, serverResponse)
+	if client.hedgeDelay > 0 && len(servers) > 1 {
+		response, err = client.requestHedged(ctx, servers, queryType, identifier, budget)
+	} else {
+		response, err = client.requestSequential(ctx, servers, queryType, identifier, budget)
+	}
+
+	if err != nil || response == nil {
+		return nil, fmt.Errorf("all RDAP servers failed for query type %s and identifier %s: %w", queryType.String(), identifier, errors.Join(append(errs, err)...))
+	}
+
+	client.cache.Set(queryType, key, response)
+
+	return response, nil
+}
+
+// cacheKey returns the key the response for the identifier is cached under. Responses are
+// cached separately for each validation policy, so that a response accepted under a more
+// lenient policy is never returned to a lookup using StrictValidation.
+func (client *Client) cacheKey(identifier string) string {
+	if client.validationPolicy == StrictValidation {
+		return identifier
+	}
+
+	return fmt.Sprintf("%s\x00%d", identifier, client.validationPolicy)
+}
+
+// RequestSequential attempts each server in turn, moving on to the next server only once
+// the previous server has failed.
+func (client *Client) requestSequential(ctx context.Context, servers []string, queryType query.RdapQuery, identifier string, budget *attemptBudget) (any, error) {
+	var errs []error
+
+	for _, server := range servers {
+		if isPlaintext(server) {
+			slog.Warn("Falling back to plaintext HTTP RDAP server.", "server", server)
+		}
+
+		response, err := client.attempt(ctx, server, queryType, identifier, budget)
 
 		if err != nil {
-			slog.Warn("Failed to parse RDAP server response. Using another server if available.", "server", server, "error", err)
-			return response, err
+			slog.Warn("RDAP server request failed. Using another server if available.", "server", server, "error", err)
+			errs = append(errs, err)
+
+			if ctx.Err() != nil || errors.Is(err, ErrAttemptBudgetExhausted) {
+				break
+			}
+
+			continue
 		}
 
 		slog.Info("RDAP server request successful", "server", server, "identifier", identifier, "query", queryType)
 
-		client.cache.Set(queryType, identifier, response)
-
-		return response, err
+		return response, nil
 	}
 
-	return nil, fmt.Errorf("all RDAP servers failed for query type %s and identifier %s", queryType.String(), identifier)
+	return nil, errors.Join(errs...)
 }
 
-// Parse the RDAP server response based on the query type into a validated response
-// struct.
-func parseResponse(queryType query.RdapQuery, response *http.Response) (any, error) {
-	decoder := json.NewDecoder(response.Body)
-	validate := validator.New(validator.WithRequiredStructEnabled())
+// Attempt performs an RDAP request to a single server, retrying transient failures
+// according to the retry policy while attempts remain in the budget.
+func (client *Client) attempt(ctx context.Context, server string, queryType query.RdapQuery, identifier string, budget *attemptBudget) (any, error) {
+	var errs []error
 
-	switch queryType {
-	case query.DomainQuery:
-		var output dns.Response
-		if err := decoder.Decode(&output); err != nil {
-			return nil, err
+	for try := 1; ; try++ {
+		if !budget.take() {
+			return nil, errors.Join(append(errs, ErrAttemptBudgetExhausted)...)
 		}
 
-		if err := validate.Struct(output); err != nil {
-			return nil, err
+		response, err := client.fetch(ctx, server, queryType, identifier)
+
+		if err == nil {
+			return response, nil
 		}
 
-		return output, nil
-	case query.IPv4Query:
-		var output ipv4.Response
+		errs = append(errs, err)
 
-		if err := decoder.Decode(&output); err != nil {
-			return nil, err
+		if !IsRetryable(err) || try >= client.retryPolicy.MaxAttemptsPerServer {
+			return nil, errors.Join(errs...)
 		}
 
-		if err := validate.Struct(output); err != nil {
-			return nil, err
+		wait, ok := client.retryPolicy.backoff(try, err)
+
+		if !ok {
+			return nil, errors.Join(errs...)
 		}
 
-		return output, nil
-	case query.IPv6Query:
-		var output ipv6.Response
+		slog.Info("Retrying RDAP server request after transient failure.", "server", server, "attempt", try, "wait", wait, "error", err)
 
-		if err := decoder.Decode(&output); err != nil {
-			return nil, err
+		if err := sleep(ctx, wait); err != nil {
+			return nil, errors.Join(append(errs, err)...)
 		}
+	}
+}
 
-		if err := validate.Struct(output); err != nil {
-			return nil, err
-		}
+// errParse marks a server response which was retrieved successfully, but could not be
+// parsed or failed validation. The next server is tried instead, as another server may
+// return a valid response.
+var errParse = errors.New("failed to parse RDAP server response")
+
+// Fetch performs a single RDAP request to the given server, waiting for the rate limit of
+// the server host. The transport policy is enforced by the HTTP client on every connection
+// used, including those of redirects.
+func (client *Client) fetch(ctx context.Context, server string, queryType query.RdapQuery, identifier string) (any, error) {
+	if client.policyErr != nil {
+		return nil, client.policyErr
+	}
 
-		return output, nil
+	serverURL, err := url.Parse(server)
 
-	case query.AsnQuery:
-		var output asn.Response
+	if err != nil {
+		return nil, err
+	}
 
-		if err := decoder.Decode(&output); err != nil {
+	if client.serverLimits != nil {
+		release, err := client.serverLimits.acquire(ctx, serverURL.Hostname())
+
+		if err != nil {
 			return nil, err
 		}
 
-		if err := validate.Struct(output); err != nil {
-			return nil, err
+		defer release()
+	}
+
+	if err := client.rateLimiter.Wait(ctx, serverURL.Hostname()); err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server+queryType.String()+"/"+identifier, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	serverResponse, err := client.policyClient.Do(request)
+
+	if err != nil {
+		return nil, client.transportPolicy.handshakeError(serverURL.Host, err)
+	}
+
+	defer serverResponse.Body.Close()
+
+	if serverResponse.StatusCode != http.StatusOK {
+		drain(serverResponse.Body)
+
+		return nil, &StatusError{
+			Server:     server,
+			StatusCode: serverResponse.StatusCode,
+			RetryAfter: parseRetryAfter(serverResponse.Header.Get("Retry-After"), time.Now()),
 		}
+	}
+
+	response, err := client.parseResponse(queryType, serverResponse)
 
-		return output, nil
-	default:
-		return nil, fmt.Errorf("unsupported query type: %s", queryType.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w from %s: %w", errParse, server, err)
 	}
+
+	return response, nil
 }
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response"
+	"github.com/ryanmab/rdap-go/pkg/client/response/asn"
+	"github.com/ryanmab/rdap-go/pkg/client/response/dns"
+	"github.com/ryanmab/rdap-go/pkg/client/response/entity"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv4"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv6"
+)
+
+// DefaultMaxResponseSize is the largest RDAP response body, in bytes, which a Client reads
+// unless configured otherwise.
+const DefaultMaxResponseSize = 16 << 20
+
+// ErrResponseTooLarge is returned when an RDAP server returns a response body larger than
+// the Client's maximum response size.
+var ErrResponseTooLarge = errors.New("RDAP response exceeds the maximum response size")
+
+// validate is shared by every Client, as building the validator's cache of struct metadata
+// is expensive. The validator is safe for concurrent use.
+var validate = newValidator()
+
+// decoder decodes and validates the body of an RDAP response into a response struct.
+type decoder func(data []byte, policy ValidationPolicy) (any, error)
+
+// decoders maps each query type to the decoder for the class of object it returns.
+var decoders = map[query.RdapQuery]decoder{
+	query.DomainQuery: decodeObject(func(output *dns.Response, raw json.RawMessage, warnings []response.ValidationWarning) {
+		output.Raw, output.Warnings = raw, warnings
+	}),
+	query.IPv4Query: decodeObject(func(output *ipv4.Response, raw json.RawMessage, warnings []response.ValidationWarning) {
+		output.Raw, output.Warnings = raw, warnings
+	}),
+	query.IPv6Query: decodeObject(func(output *ipv6.Response, raw json.RawMessage, warnings []response.ValidationWarning) {
+		output.Raw, output.Warnings = raw, warnings
+	}),
+	query.AsnQuery: decodeObject(func(output *asn.Response, raw json.RawMessage, warnings []response.ValidationWarning) {
+		output.Raw, output.Warnings = raw, warnings
+	}),
+	query.EntityQuery: decodeObject(func(output *entity.Response, raw json.RawMessage, warnings []response.ValidationWarning) {
+		output.Raw, output.Warnings = raw, warnings
+	}),
+}
+
+// decodeObject returns a decoder for the response type T, which records the raw object and
+// any validation warnings on the decoded response using annotate.
+func decodeObject[T any](annotate func(output *T, raw json.RawMessage, warnings []response.ValidationWarning)) decoder {
+	return func(data []byte, policy ValidationPolicy) (any, error) {
+		var output T
+
+		if err := json.Unmarshal(data, &output); err != nil {
+			return nil, err
+		}
+
+		warnings, err := validateResponse(validate, policy, &output)
+
+		if err != nil {
+			return nil, err
+		}
+
+		annotate(&output, data, warnings)
+
+		return output, nil
+	}
+}
+
+// WithMaxResponseSize sets the largest RDAP response body, in bytes, which the Client will
+// read. Larger responses fail with ErrResponseTooLarge.
+func (client *Client) WithMaxResponseSize(size int64) {
+	client.maxResponseSize = size
+}
+
+// Parse the RDAP server response based on the query type into a validated response
+// struct.
+func (client *Client) parseResponse(queryType query.RdapQuery, response *http.Response) (any, error) {
+	decode, ok := decoders[queryType]
+
+	if !ok {
+		return nil, fmt.Errorf("unsupported query type: %d", queryType)
+	}
+
+	data, err := readBody(response, client.maxResponseSize)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return decode(data, client.validationPolicy)
+}
+
+// readBody reads the body of the response, failing with ErrResponseTooLarge if it is larger
+// than the maximum size.
+func readBody(response *http.Response, maxSize int64) ([]byte, error) {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxResponseSize
+	}
+
+	if response.ContentLength > maxSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrResponseTooLarge, response.ContentLength)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxSize+1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrResponseTooLarge, maxSize)
+	}
+
+	return data, nil
+}
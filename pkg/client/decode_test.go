@@ -0,0 +1,150 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponsesLargerThanMaximumSizeAreRejected(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/ipv4.json")
+	assert.NoError(t, err)
+
+	t.Run("Declared length", func(t *testing.T) {
+		server := httptest.NewServer(newFixtureHandler(t, "ipv4.json", nil))
+		defer server.Close()
+
+		client := New()
+		client.WithMaxResponseSize(int64(len(fixture) - 1))
+
+		response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, ErrResponseTooLarge)
+	})
+
+	t.Run("Streamed", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/rdap+json")
+
+			// Flushing before writing the body omits the Content-Length header.
+			w.(http.Flusher).Flush()
+			_, _ = w.Write(fixture)
+		}))
+		defer server.Close()
+
+		client := New()
+		client.WithMaxResponseSize(int64(len(fixture) - 1))
+
+		response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, ErrResponseTooLarge)
+	})
+
+	t.Run("Within maximum", func(t *testing.T) {
+		server := httptest.NewServer(newFixtureHandler(t, "ipv4.json", nil))
+		defer server.Close()
+
+		client := New()
+		client.WithMaxResponseSize(int64(len(fixture)))
+
+		response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+}
+
+func TestEveryQueryTypeHasADecoder(t *testing.T) {
+	for _, queryType := range []query.RdapQuery{query.DomainQuery, query.IPv4Query, query.IPv6Query, query.AsnQuery, query.EntityQuery} {
+		assert.Contains(t, decoders, queryType, "Query type %d", queryType)
+	}
+}
+
+func TestDecodingUnsupportedQueryTypeReturnsAnError(t *testing.T) {
+	client := New()
+
+	_, err := client.parseResponse(query.RdapQuery(-1), &http.Response{Body: io.NopCloser(strings.NewReader("{}"))})
+
+	assert.Error(t, err)
+}
+
+func BenchmarkParsingResponses(b *testing.B) {
+	for _, test := range []struct {
+		fixture   string
+		queryType query.RdapQuery
+	}{
+		{"ipv4.json", query.IPv4Query},
+		{"reverse_domain.json", query.DomainQuery},
+		{"entity.json", query.EntityQuery},
+	} {
+		data, err := os.ReadFile("testdata/" + test.fixture)
+
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		client := New()
+
+		b.Run(test.fixture, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for b.Loop() {
+				_, err := client.parseResponse(test.queryType, &http.Response{
+					ContentLength: int64(len(data)),
+					Body:          io.NopCloser(bytes.NewReader(data)),
+				})
+
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkValidatingResponses compares validating with the shared validator against
+// creating a validator for each response, as was previously the case.
+func BenchmarkValidatingResponses(b *testing.B) {
+	data, err := os.ReadFile("testdata/ipv4.json")
+
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var output ipv4.Response
+
+	if err := output.UnmarshalJSON(data); err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("Shared validator", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for b.Loop() {
+			if _, err := validateResponse(validate, StrictValidation, &output); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Validator per response", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for b.Loop() {
+			if _, err := validateResponse(newValidator(), StrictValidation, &output); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
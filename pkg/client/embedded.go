@@ -0,0 +1,207 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/ryanmab/rdap-go/pkg/client/response/ip"
+)
+
+// Embedding is a form in which an IPv4 address can be embedded within an IPv6 address.
+type Embedding int
+
+const (
+	// EmbeddingNone signifies an address which does not embed an IPv4 address.
+	EmbeddingNone Embedding = iota
+
+	// EmbeddingIPv4Mapped is an IPv4-mapped IPv6 address (::ffff:0:0/96) - e.g. ::ffff:8.8.8.8
+	//
+	// See Section 2.5.5.2: https://datatracker.ietf.org/doc/rfc4291/
+	EmbeddingIPv4Mapped
+
+	// EmbeddingIPv4Compatible is a deprecated IPv4-compatible IPv6 address (::/96) - e.g. ::8.8.8.8
+	//
+	// See Section 2.5.5.1: https://datatracker.ietf.org/doc/rfc4291/
+	EmbeddingIPv4Compatible
+
+	// Embedding6to4 is a 6to4 address (2002::/16), with the IPv4 address in the 32 bits
+	// following the prefix - e.g. 2002:808:808::
+	//
+	// See Section 2: https://datatracker.ietf.org/doc/rfc3056/
+	Embedding6to4
+
+	// EmbeddingTeredo is a Teredo address (2001::/32), with the obfuscated IPv4 address of
+	// the client in the final 32 bits - e.g. 2001:0:4136:e378:8000:63bf:f7f7:f7f7
+	//
+	// See Section 4: https://datatracker.ietf.org/doc/rfc4380/
+	EmbeddingTeredo
+
+	// EmbeddingNAT64 is an address using the NAT64 well-known prefix (64:ff9b::/96) - e.g.
+	// 64:ff9b::808:808 - or the local-use prefix (64:ff9b:1::/48), with the IPv4 address in
+	// the final 32 bits (as when a /96 of the local-use prefix is used) - e.g.
+	// 64:ff9b:1::808:808
+	//
+	// See Section 2.1: https://datatracker.ietf.org/doc/rfc6052/
+	// See Section 4: https://datatracker.ietf.org/doc/rfc8215/
+	EmbeddingNAT64
+)
+
+func (embedding Embedding) String() string {
+	switch embedding {
+	case EmbeddingIPv4Mapped:
+		return "IPv4-mapped"
+	case EmbeddingIPv4Compatible:
+		return "IPv4-compatible"
+	case Embedding6to4:
+		return "6to4"
+	case EmbeddingTeredo:
+		return "Teredo"
+	case EmbeddingNAT64:
+		return "NAT64"
+	default:
+		return "none"
+	}
+}
+
+var (
+	ipv4CompatiblePrefix = netip.MustParsePrefix("::/96")
+	sixToFourPrefix      = netip.MustParsePrefix("2002::/16")
+	teredoPrefix         = netip.MustParsePrefix("2001::/32")
+	nat64Prefix          = netip.MustParsePrefix("64:ff9b::/96")
+	nat64LocalUsePrefix  = netip.MustParsePrefix("64:ff9b:1::/48")
+)
+
+// EmbeddedIPv4 returns the IPv4 address embedded within an IPv6 address, and the form of the
+// embedding. If the address does not embed an IPv4 address, EmbeddingNone is returned.
+func EmbeddedIPv4(addr netip.Addr) (netip.Addr, Embedding) {
+	if !addr.Is6() {
+		return netip.Addr{}, EmbeddingNone
+	}
+
+	bytes := addr.As16()
+
+	switch {
+	case addr.Is4In6():
+		return addr.Unmap(), EmbeddingIPv4Mapped
+	case nat64Prefix.Contains(addr), nat64LocalUsePrefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(bytes[12:16])), EmbeddingNAT64
+	case sixToFourPrefix.Contains(addr):
+		return netip.AddrFrom4([4]byte(bytes[2:6])), Embedding6to4
+	case teredoPrefix.Contains(addr):
+		// The client address is obfuscated by inverting all of its bits.
+		return netip.AddrFrom4([4]byte{^bytes[12], ^bytes[13], ^bytes[14], ^bytes[15]}), EmbeddingTeredo
+	case ipv4CompatiblePrefix.Contains(addr):
+		embedded := netip.AddrFrom4([4]byte(bytes[12:16]))
+
+		// The unspecified (::) and loopback (::1) addresses share the IPv4-compatible prefix,
+		// but do not embed an IPv4 address.
+		if embedded.As4()[0] == 0 {
+			return netip.Addr{}, EmbeddingNone
+		}
+
+		return embedded, EmbeddingIPv4Compatible
+	default:
+		return netip.Addr{}, EmbeddingNone
+	}
+}
+
+// EmbeddedIPv4Error is returned when an IPv6 lookup is made for an address which embeds an
+// IPv4 address, and so should be looked up using the IPv4 address instead (i.e. with
+// LookupIP or LookupIPv4Addr).
+//
+// It's returned for IPv4-mapped addresses, which are never registered as IPv6 networks, and
+// for any other embedding when looking up embedded IPv4 addresses is enabled (see
+// WithEmbeddedIPv4Lookups).
+type EmbeddedIPv4Error struct {
+	// Addr is the IPv6 address which was looked up.
+	Addr netip.Addr
+
+	// Embedded is the IPv4 address embedded within Addr.
+	Embedded netip.Addr
+
+	// Embedding is the form of the embedded IPv4 address.
+	Embedding Embedding
+}
+
+func (err *EmbeddedIPv4Error) Error() string {
+	return fmt.Sprintf("%s is a %s address of %s, which must be looked up as an IPv4 address", err.Addr, err.Embedding, err.Embedded)
+}
+
+// translated returns the IPv4 address an IPv6 address should be looked up as, when it embeds
+// an IPv4 address which is looked up instead.
+func (client *Client) translated(addr netip.Addr) (netip.Addr, Embedding, bool) {
+	embedded, embedding := EmbeddedIPv4(addr)
+
+	if embedding == EmbeddingIPv4Mapped || (embedding != EmbeddingNone && client.embeddedIPv4Lookups) {
+		return embedded, embedding, true
+	}
+
+	return netip.Addr{}, embedding, false
+}
+
+// IPLookup is the result of looking up an IP address with LookupIP.
+type IPLookup struct {
+	// Addr is the address as provided.
+	Addr netip.Addr
+
+	// Queried is the address which was looked up. This is the embedded IPv4 address when the
+	// lookup was translated, and Addr otherwise.
+	Queried netip.Addr
+
+	// Embedding is the form of IPv4 address embedded within Addr, if any.
+	Embedding Embedding
+
+	// Translated reports whether the lookup was performed on the embedded IPv4 address,
+	// rather than the IPv6 address provided.
+	Translated bool
+
+	// Network is the ip network containing the queried address.
+	Network *ip.Response
+}
+
+// LookupIP looks up an IP address of either address family, using RDAP and retrieves its IP
+// registration data.
+//
+// IPv4-mapped addresses (i.e. ::ffff:8.8.8.8) are always looked up using the IPv4 address.
+// When looking up embedded IPv4 addresses is enabled (see WithEmbeddedIPv4Lookups), IPv6
+// addresses which embed an IPv4 address in any other form are too.
+func (client *Client) LookupIP(addr netip.Addr) (*IPLookup, error) {
+	return client.LookupIPContext(context.Background(), addr)
+}
+
+// LookupIPContext is like LookupIP, but uses the provided context for the lifetime of the
+// lookup.
+func (client *Client) LookupIPContext(ctx context.Context, addr netip.Addr) (*IPLookup, error) {
+	lookup := &IPLookup{Addr: addr, Queried: addr}
+
+	embedded, embedding, translated := client.translated(addr)
+	lookup.Embedding = embedding
+
+	if translated {
+		lookup.Queried = embedded
+		lookup.Translated = true
+	}
+
+	if lookup.Queried.Is4() {
+		response, err := client.LookupIPv4AddrContext(ctx, lookup.Queried)
+
+		if err != nil {
+			return nil, err
+		}
+
+		lookup.Network = &ip.Response{IPv4: response}
+
+		return lookup, nil
+	}
+
+	response, err := client.LookupIPv6AddrContext(ctx, lookup.Queried)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lookup.Network = &ip.Response{IPv6: response}
+
+	return lookup, nil
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv4"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractingEmbeddedIPv4Addresses(t *testing.T) {
+	tests := []struct {
+		addr      string
+		embedded  string
+		embedding Embedding
+	}{
+		{"::ffff:8.8.8.8", "8.8.8.8", EmbeddingIPv4Mapped},
+		{"::ffff:808:808", "8.8.8.8", EmbeddingIPv4Mapped},
+		{"::8.8.8.8", "8.8.8.8", EmbeddingIPv4Compatible},
+		{"2002:0808:0808::", "8.8.8.8", Embedding6to4},
+		{"2002:c000:022d:1::1", "192.0.2.45", Embedding6to4},
+		{"2001:0000:4136:e378:8000:63bf:3fff:fdd2", "192.0.2.45", EmbeddingTeredo},
+		{"64:ff9b::808:808", "8.8.8.8", EmbeddingNAT64},
+		{"64:ff9b::192.0.2.33", "192.0.2.33", EmbeddingNAT64},
+		{"64:ff9b:1::808:808", "8.8.8.8", EmbeddingNAT64},
+	}
+
+	for _, test := range tests {
+		embedded, embedding := EmbeddedIPv4(netip.MustParseAddr(test.addr))
+
+		assert.Equal(t, test.embedding, embedding, "Address %s", test.addr)
+		assert.Equal(t, netip.MustParseAddr(test.embedded), embedded, "Address %s", test.addr)
+	}
+}
+
+func TestAddressesWithoutEmbeddedIPv4(t *testing.T) {
+	for _, addr := range []string{"::", "::1", "2001:4860:4860::8888", "2001:db8::1", "64:ff9b:2::808:808", "8.8.8.8"} {
+		embedded, embedding := EmbeddedIPv4(netip.MustParseAddr(addr))
+
+		assert.Equal(t, EmbeddingNone, embedding, "Address %s", addr)
+		assert.False(t, embedded.IsValid(), "Address %s", addr)
+	}
+}
+
+func TestLookingUpIPWithEmbeddedIPv4(t *testing.T) {
+	client := New()
+
+	// Seed the cache, so that the lookups can be performed without making network requests.
+	client.cache.Set(query.IPv4Query, "8.8.8.8", ipv4.Response{Name: "GOGL"})
+	client.cache.Set(query.IPv6Query, "2001:4860:4860::8888", ipv6.Response{Name: "GOOGLE-IPV6"})
+
+	t.Run("Translation enabled", func(t *testing.T) {
+		client.WithEmbeddedIPv4Lookups(true)
+
+		for addr, embedding := range map[string]Embedding{
+			"::ffff:8.8.8.8":   EmbeddingIPv4Mapped,
+			"2002:0808:0808::": Embedding6to4,
+			"64:ff9b::808:808": EmbeddingNAT64,
+		} {
+			lookup, err := client.LookupIP(netip.MustParseAddr(addr))
+
+			assert.NoError(t, err, "Address %s", addr)
+			assert.Equal(t, embedding, lookup.Embedding, "Address %s", addr)
+			assert.True(t, lookup.Translated, "Address %s", addr)
+			assert.Equal(t, netip.MustParseAddr("8.8.8.8"), lookup.Queried, "Address %s", addr)
+			assert.Equal(t, "GOGL", lookup.Network.IPv4.Name, "Address %s", addr)
+		}
+	})
+
+	t.Run("Translation disabled", func(t *testing.T) {
+		client.WithEmbeddedIPv4Lookups(false)
+
+		// The 6to4 address is looked up as an IPv6 address, which no RDAP server is
+		// registered for.
+		lookup, err := client.LookupIP(netip.MustParseAddr("2002:0808:0808::"))
+
+		assert.ErrorContains(t, err, "no RDAP servers found")
+		assert.Nil(t, lookup)
+
+		// IPv4-mapped addresses are always looked up using the IPv4 address.
+		lookup, err = client.LookupIP(netip.MustParseAddr("::ffff:8.8.8.8"))
+
+		assert.NoError(t, err)
+		assert.True(t, lookup.Translated)
+		assert.Equal(t, "GOGL", lookup.Network.IPv4.Name)
+	})
+
+	t.Run("Address without embedded IPv4", func(t *testing.T) {
+		client.WithEmbeddedIPv4Lookups(true)
+
+		lookup, err := client.LookupIP(netip.MustParseAddr("2001:4860:4860::8888"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, EmbeddingNone, lookup.Embedding)
+		assert.False(t, lookup.Translated)
+		assert.Equal(t, "GOOGLE-IPV6", lookup.Network.IPv6.Name)
+	})
+
+	t.Run("IPv4 address", func(t *testing.T) {
+		lookup, err := client.LookupIP(netip.MustParseAddr("8.8.8.8"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, EmbeddingNone, lookup.Embedding)
+		assert.Equal(t, "GOGL", lookup.Network.IPv4.Name)
+	})
+}
+
+func TestLookingUpIPv6WithEmbeddedIPv4(t *testing.T) {
+	client := New()
+
+	t.Run("IPv4-mapped address", func(t *testing.T) {
+		response, err := client.LookupIPv6Addr(netip.MustParseAddr("::ffff:8.8.8.8"))
+
+		var embeddedErr *EmbeddedIPv4Error
+
+		assert.ErrorAs(t, err, &embeddedErr)
+		assert.Nil(t, response)
+		assert.Equal(t, EmbeddingIPv4Mapped, embeddedErr.Embedding)
+		assert.Equal(t, netip.MustParseAddr("8.8.8.8"), embeddedErr.Embedded)
+	})
+
+	t.Run("Translation enabled", func(t *testing.T) {
+		client.WithEmbeddedIPv4Lookups(true)
+
+		for _, addr := range []string{"2002:0808:0808::", "64:ff9b::808:808", "64:ff9b:1::808:808"} {
+			_, err := client.LookupIPv6Addr(netip.MustParseAddr(addr))
+
+			var embeddedErr *EmbeddedIPv4Error
+
+			assert.ErrorAs(t, err, &embeddedErr, "Address %s", addr)
+		}
+	})
+
+	t.Run("Translation disabled", func(t *testing.T) {
+		client.WithEmbeddedIPv4Lookups(false)
+
+		response, err := client.LookupIPv6Addr(netip.MustParseAddr("2002:0808:0808::"))
+
+		var embeddedErr *EmbeddedIPv4Error
+
+		assert.False(t, errors.As(err, &embeddedErr))
+		assert.ErrorContains(t, err, "no RDAP servers found")
+		assert.Nil(t, response)
+	})
+}
@@ -0,0 +1,7 @@
+package client
+
+import "github.com/ryanmab/rdap-go/internal/query"
+
+// InvalidIdentifierError is returned when an identifier passed to a lookup is malformed, and
+// so cannot be looked up.
+type InvalidIdentifierError = query.InvalidIdentifierError
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponsesRetainRawJSONAndExtensions(t *testing.T) {
+	data, err := os.ReadFile("testdata/ipv4.json")
+	assert.NoError(t, err)
+
+	var members map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(data, &members))
+
+	// The fixture only contains modelled members, so a registry-specific member is added.
+	members["example_registry"] = json.RawMessage(`{"source": "example"}`)
+
+	fixture, err := json.Marshal(members)
+	assert.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rdap+json")
+		_, _ = w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := New()
+
+	response, err := typed[ipv4.Response](client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8"))
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(fixture), string(response.Raw))
+
+	assert.Len(t, response.Extensions, 1)
+	assert.Contains(t, response.Extensions, "example_registry")
+	assert.Len(t, response.CIDRs, 1)
+	assert.Equal(t, netip.MustParsePrefix("8.8.8.0/24"), response.CIDRs[0].Prefix())
+
+	t.Run("Round trip", func(t *testing.T) {
+		data, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		var encoded map[string]json.RawMessage
+		assert.NoError(t, json.Unmarshal(data, &encoded))
+
+		assert.JSONEq(t, string(response.Extensions["example_registry"]), string(encoded["example_registry"]))
+
+		var decoded ipv4.Response
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+
+		assert.Len(t, decoded.Extensions, 1)
+		assert.JSONEq(t, string(response.Extensions["example_registry"]), string(decoded.Extensions["example_registry"]))
+		assert.Equal(t, response.Handle, decoded.Handle)
+	})
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+)
+
+// hedgedResult is the outcome of a hedged request to a single server.
+type hedgedResult struct {
+	server   string
+	response any
+	err      error
+}
+
+// RequestHedged attempts each server in turn, but starts the request to the next server if
+// the previous server has not responded within the hedging delay. The first successful
+// response is returned, and all other requests are cancelled.
+func (client *Client) requestHedged(ctx context.Context, servers []string, queryType query.RdapQuery, identifier string, budget *attemptBudget) (any, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffered so that requests which finish after a response has been chosen do not block.
+	results := make(chan hedgedResult, len(servers))
+
+	next, inFlight := 0, 0
+	launch := func() {
+		server := servers[next]
+		next++
+		inFlight++
+
+		if isPlaintext(server) {
+			slog.Warn("Falling back to plaintext HTTP RDAP server.", "server", server)
+		}
+
+		go func() {
+			response, err := client.attempt(ctx, server, queryType, identifier, budget)
+			results <- hedgedResult{server: server, response: response, err: err}
+		}()
+	}
+
+	launch()
+
+	timer := time.NewTimer(client.hedgeDelay)
+	defer timer.Stop()
+
+	var errs []error
+
+	for inFlight > 0 {
+		select {
+		case <-timer.C:
+			if next < len(servers) {
+				slog.Info("RDAP server has not responded within hedging delay. Starting request to another server.", "server", servers[next], "delay", client.hedgeDelay)
+
+				launch()
+				timer.Reset(client.hedgeDelay)
+			}
+		case result := <-results:
+			inFlight--
+
+			if result.err == nil {
+				slog.Info("RDAP server request successful", "server", result.server, "identifier", identifier, "query", queryType)
+				return result.response, nil
+			}
+
+			slog.Warn("RDAP server request failed. Using another server if available.", "server", result.server, "error", result.err)
+			errs = append(errs, result.err)
+
+			if ctx.Err() != nil || errors.Is(result.err, ErrAttemptBudgetExhausted) {
+				continue
+			}
+
+			// Rather than waiting out the remainder of the delay, move straight on to the
+			// next server when a request fails.
+			if next < len(servers) {
+				launch()
+				timer.Reset(client.hedgeDelay)
+			}
+		}
+	}
+
+	return nil, errors.Join(errs...)
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/stretchr/testify/assert"
+)
+
+// newSlowHandler returns a handler which waits for the delay before serving the fixture,
+// recording whether the request was cancelled by the client.
+func newSlowHandler(t *testing.T, delay time.Duration, cancelled *atomic.Bool) http.HandlerFunc {
+	fixture := newFixtureHandler(t, "ipv4.json", nil)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			cancelled.Store(true)
+		case <-time.After(delay):
+			fixture(w, r)
+		}
+	}
+}
+
+func TestHedgedRequestsUseFirstResponse(t *testing.T) {
+	var slowCancelled atomic.Bool
+
+	slowServer := httptest.NewServer(newSlowHandler(t, 2*time.Second, &slowCancelled))
+	defer slowServer.Close()
+
+	var fastRequests atomic.Int32
+	fastServer := httptest.NewServer(newFixtureHandler(t, "ipv4.json", &fastRequests))
+	defer fastServer.Close()
+
+	client := New()
+	client.WithHedging(20 * time.Millisecond)
+
+	start := time.Now()
+
+	response, err := client.request(
+		context.Background(),
+		[]string{slowServer.URL + "/", fastServer.URL + "/"},
+		query.IPv4Query,
+		"8.8.8.8",
+	)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int32(1), fastRequests.Load())
+
+	assert.Eventually(t, slowCancelled.Load, time.Second, 10*time.Millisecond)
+}
+
+func TestHedgedRequestsDoNotStartWhenFirstServerIsFast(t *testing.T) {
+	var firstRequests, secondRequests atomic.Int32
+
+	firstServer := httptest.NewServer(newFixtureHandler(t, "ipv4.json", &firstRequests))
+	defer firstServer.Close()
+
+	secondServer := httptest.NewServer(newFixtureHandler(t, "ipv4.json", &secondRequests))
+	defer secondServer.Close()
+
+	client := New()
+	client.WithHedging(time.Second)
+
+	response, err := client.request(
+		context.Background(),
+		[]string{firstServer.URL + "/", secondServer.URL + "/"},
+		query.IPv4Query,
+		"8.8.8.8",
+	)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, int32(1), firstRequests.Load())
+	assert.Equal(t, int32(0), secondRequests.Load())
+}
+
+func TestHedgedRequestsMoveOnImmediatelyAfterFailure(t *testing.T) {
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer failingServer.Close()
+
+	server := httptest.NewServer(newFixtureHandler(t, "ipv4.json", nil))
+	defer server.Close()
+
+	client := New()
+	client.WithHedging(5 * time.Second)
+
+	start := time.Now()
+
+	response, err := client.request(
+		context.Background(),
+		[]string{failingServer.URL + "/", server.URL + "/"},
+		query.IPv4Query,
+		"8.8.8.8",
+	)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestHedgedRequestsReportAllFailures(t *testing.T) {
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer failingServer.Close()
+
+	client := New()
+	client.WithHedging(10 * time.Millisecond)
+
+	response, err := client.request(
+		context.Background(),
+		[]string{failingServer.URL + "/a/", failingServer.URL + "/b/"},
+		query.IPv4Query,
+		"8.8.8.8",
+	)
+
+	var statusErr *StatusError
+
+	assert.Nil(t, response)
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// domainProfile converts domain names to their A-label (xn--) form using UTS #46
+// processing, validating the result against IDNA2008.
+//
+// See: https://www.unicode.org/reports/tr46/
+var domainProfile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(false),
+	idna.BidiRule(),
+	idna.ValidateLabels(true),
+	idna.StrictDomainName(true),
+	idna.VerifyDNSLength(true),
+	idna.CheckHyphens(true),
+	idna.CheckJoiners(true),
+)
+
+// toASCIIDomain converts a domain name, which may contain U-labels (i.e. bücher.de), to the
+// lowercase A-label form (i.e. xn--bcher-kva.de) used by RDAP servers and the IANA bootstrap
+// registry.
+func toASCIIDomain(domain string) (string, error) {
+	domain = strings.TrimSuffix(domain, ".")
+
+	ascii, err := domainProfile.ToASCII(domain)
+
+	if err != nil {
+		return "", &InvalidIdentifierError{Kind: "domain", Identifier: domain, Reason: err.Error()}
+	}
+
+	return strings.ToLower(ascii), nil
+}
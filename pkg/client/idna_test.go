@@ -0,0 +1,57 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/dns"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertingDomainsToALabels(t *testing.T) {
+	tests := map[string]string{
+		"example.com":                     "example.com",
+		"EXAMPLE.COM.":                    "example.com",
+		"bücher.de":                       "xn--bcher-kva.de",
+		"BÜCHER.DE":                       "xn--bcher-kva.de",
+		"xn--bcher-kva.de":                "xn--bcher-kva.de",
+		"faß.de":                          "xn--fa-hia.de",
+		"bücher.vermögensberater":         "xn--bcher-kva.xn--vermgensberater-ctb",
+		"example.谷歌":                      "example.xn--flw351e",
+		"ｅｘａｍｐｌｅ．ｃｏｍ":                     "example.com",
+		"xn--bcher-kva.XN--FLW351E":       "xn--bcher-kva.xn--flw351e",
+		"münchen.xn--vermgensberater-ctb": "xn--mnchen-3ya.xn--vermgensberater-ctb",
+	}
+
+	for domain, expected := range tests {
+		ascii, err := toASCIIDomain(domain)
+
+		assert.NoError(t, err, "Domain %q", domain)
+		assert.Equal(t, expected, ascii, "Domain %q", domain)
+	}
+}
+
+func TestConvertingInvalidDomainsToALabelsReturnsAnError(t *testing.T) {
+	for _, domain := range []string{"-example.com", "exa mple.com", "example..com", "xn--a.com", "ab--c.com"} {
+		_, err := toASCIIDomain(domain)
+
+		var invalidErr *InvalidIdentifierError
+
+		assert.ErrorAs(t, err, &invalidErr, "Domain %q", domain)
+	}
+}
+
+func TestLookingUpInternationalizedDomain(t *testing.T) {
+	client := New()
+
+	// Seed the cache, so that the lookups can be performed without making network requests.
+	client.cache.Set(query.DomainQuery, "xn--bcher-kva.xn--vermgensberater-ctb", dns.Response{LdhName: "xn--bcher-kva.xn--vermgensberater-ctb"})
+
+	for _, domain := range []string{"bücher.vermögensberater", "BÜCHER.vermögensberater", "https://bücher.vermögensberater/", "xn--bcher-kva.xn--vermgensberater-ctb"} {
+		response, err := client.LookupDomain(domain)
+
+		assert.NoError(t, err, "Domain %q", domain)
+		assert.Equal(t, "xn--bcher-kva.xn--vermgensberater-ctb", response.ALabelName(), "Domain %q", domain)
+		assert.Equal(t, "bücher.vermögensberater", response.ULabelName(), "Domain %q", domain)
+	}
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv4"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookingUpIPPrefix(t *testing.T) {
+	client := New()
+
+	// Seed the cache, so that the lookups can be performed without making network requests.
+	client.cache.Set(query.IPv4Query, "8.8.8.0/24", ipv4.Response{Name: "GOGL", StartAddress: "8.8.8.0", EndAddress: "8.8.8.255"})
+	client.cache.Set(query.IPv6Query, "2001:4860::/32", ipv6.Response{Name: "GOOGLE-IPV6", StartAddress: "2001:4860::", EndAddress: "2001:4860:ffff:ffff:ffff:ffff:ffff:ffff"})
+
+	t.Run("IPv4 prefix", func(t *testing.T) {
+		response, err := client.LookupIPPrefix(netip.MustParsePrefix("8.8.8.0/24"))
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response.IPv4)
+		assert.Equal(t, "GOGL", response.IPv4.Name)
+		assert.Equal(t, netip.MustParseAddr("8.8.8.0"), response.StartAddr())
+		assert.Equal(t, netip.MustParseAddr("8.8.8.255"), response.EndAddr())
+	})
+
+	t.Run("Unmasked IPv4 prefix", func(t *testing.T) {
+		response, err := client.LookupIPPrefix(netip.MustParsePrefix("8.8.8.8/24"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "GOGL", response.IPv4.Name)
+	})
+
+	t.Run("IPv6 prefix", func(t *testing.T) {
+		response, err := client.LookupIPPrefix(netip.MustParsePrefix("2001:4860::/32"))
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response.IPv6)
+		assert.Equal(t, "GOOGLE-IPV6", response.IPv6.Name)
+	})
+
+	t.Run("Invalid prefix", func(t *testing.T) {
+		response, err := client.LookupIPPrefix(netip.Prefix{})
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+}
+
+func TestLookingUpIPAddr(t *testing.T) {
+	client := New()
+
+	client.cache.Set(query.IPv4Query, "8.8.8.8", ipv4.Response{Name: "GOGL"})
+	client.cache.Set(query.IPv6Query, "2001:4860:4860::8888", ipv6.Response{Name: "GOOGLE-IPV6"})
+
+	t.Run("IPv4 address", func(t *testing.T) {
+		response, err := client.LookupIPv4Addr(netip.MustParseAddr("8.8.8.8"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "GOGL", response.Name)
+	})
+
+	t.Run("IPv6 address", func(t *testing.T) {
+		response, err := client.LookupIPv6Addr(netip.MustParseAddr("2001:4860:4860:0000:0000:0000:0000:8888"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "GOOGLE-IPV6", response.Name)
+	})
+
+	t.Run("Mismatched address family", func(t *testing.T) {
+		_, err := client.LookupIPv4Addr(netip.MustParseAddr("2001:4860:4860::8888"))
+		assert.Error(t, err)
+
+		_, err = client.LookupIPv6Addr(netip.MustParseAddr("8.8.8.8"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid address string", func(t *testing.T) {
+		_, err := client.LookupIPv4("8.8.8")
+		assert.Error(t, err)
+
+		_, err = client.LookupIPv6("2001:4860:4860::88888")
+		assert.Error(t, err)
+	})
+}
+
+func TestLookingUpMalformedIPReturnsInvalidIdentifierError(t *testing.T) {
+	client := New()
+
+	for _, ip := range []string{"008.8.8.8", "8.8.8", "256.1.1.1"} {
+		_, err := client.LookupIPv4(ip)
+
+		var invalidErr *InvalidIdentifierError
+
+		assert.ErrorAs(t, err, &invalidErr, "IP %q", ip)
+	}
+}
@@ -0,0 +1,259 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/ryanmab/rdap-go/pkg/client/response/asn"
+	"github.com/ryanmab/rdap-go/pkg/client/response/dns"
+	"github.com/ryanmab/rdap-go/pkg/client/response/entity"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ip"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv4"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv6"
+)
+
+// Result is the outcome of a call to Lookup. Exactly one of Domain, Network, Autnum or
+// Entity is set, depending on the kind of identifier which was looked up.
+type Result struct {
+	// Input is the identifier as passed to Lookup.
+	Input string
+
+	// Kind is the kind of resource the identifier was classified as.
+	Kind IdentifierKind
+
+	// Domain is the registration data of a domain, or of the domain of a URL or email
+	// address.
+	Domain *dns.Response
+
+	// Network is the registration data of an IP network, for IP addresses, IP prefixes and
+	// URLs with an IP address as their host.
+	Network *ip.Response
+
+	// Autnum is the registration data of an autonomous system number.
+	Autnum *asn.Response
+
+	// Entity is the registration data of an entity.
+	Entity *entity.Response
+}
+
+// Response returns the typed RDAP response held by the result (i.e. *dns.Response or
+// *ip.Response).
+func (result *Result) Response() any {
+	switch {
+	case result.Domain != nil:
+		return result.Domain
+	case result.Network != nil:
+		return result.Network
+	case result.Autnum != nil:
+		return result.Autnum
+	case result.Entity != nil:
+		return result.Entity
+	default:
+		return nil
+	}
+}
+
+// Lookup looks up an identifier of any kind, using RDAP and retrieves its registration data.
+//
+// The identifier is classified as an IP address, IP prefix (CIDR), ASN, URL, email address,
+// domain or entity handle, and looked up using the matching query. URLs and email addresses
+// are looked up by their host and domain respectively.
+//
+// ASNs in asdot notation must have an "AS" prefix (i.e. AS1.10), otherwise they're classified
+// as a domain. Use LookupASNString to look up an ASN without a prefix.
+func (client *Client) Lookup(identifier string) (*Result, error) {
+	return client.LookupContext(context.Background(), identifier)
+}
+
+// LookupContext is like Lookup, but uses the provided context for the lifetime of the lookup.
+func (client *Client) LookupContext(ctx context.Context, identifier string) (*Result, error) {
+	result := &Result{Input: identifier, Kind: classify(identifier)}
+
+	response, err := client.lookup(ctx, result.Kind, identifier)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch response := response.(type) {
+	case *dns.Response:
+		result.Domain = response
+	case *ip.Response:
+		result.Network = response
+	case *ipv4.Response:
+		result.Network = &ip.Response{IPv4: response}
+	case *ipv6.Response:
+		result.Network = &ip.Response{IPv6: response}
+	case *asn.Response:
+		result.Autnum = response
+	case *entity.Response:
+		result.Entity = response
+	}
+
+	return result, nil
+}
+
+// IdentifierKind is the kind of resource an identifier passed to the Client refers to.
+type IdentifierKind int
+
+const (
+	// KindUnknown is an identifier which could not be classified.
+	KindUnknown IdentifierKind = iota
+	// KindDomain is a domain name - e.g. example.com
+	KindDomain
+	// KindIPv4 is an IPv4 address - e.g. 8.8.8.8
+	KindIPv4
+	// KindIPv6 is an IPv6 address - e.g. 2001:4860:4860::8888
+	KindIPv6
+	// KindASN is an autonomous system number - e.g. AS15169, 15169 or AS1.10
+	KindASN
+	// KindIPPrefix is an IP network in CIDR notation - e.g. 192.0.2.0/24 or 2001:db8::/32
+	KindIPPrefix
+	// KindURL is a URL, which is looked up by its host - e.g. https://www.example.com/path
+	KindURL
+	// KindEmail is an email address, which is looked up by its domain - e.g. joe@example.com
+	KindEmail
+	// KindEntity is an entity handle tagged with its service provider - e.g. ZG39-ARIN
+	KindEntity
+)
+
+func (kind IdentifierKind) String() string {
+	switch kind {
+	case KindDomain:
+		return "domain"
+	case KindIPv4:
+		return "ipv4"
+	case KindIPv6:
+		return "ipv6"
+	case KindASN:
+		return "asn"
+	case KindIPPrefix:
+		return "ip prefix"
+	case KindURL:
+		return "url"
+	case KindEmail:
+		return "email"
+	case KindEntity:
+		return "entity"
+	default:
+		return "unknown"
+	}
+}
+
+// classify determines the kind of resource the identifier refers to.
+func classify(identifier string) IdentifierKind {
+	identifier = strings.TrimSpace(identifier)
+
+	if identifier == "" || strings.ContainsAny(identifier, " \t\r\n") {
+		return KindUnknown
+	}
+
+	if addr, err := netip.ParseAddr(identifier); err == nil {
+		if addr.Is4() {
+			return KindIPv4
+		}
+
+		return KindIPv6
+	}
+
+	if _, err := netip.ParsePrefix(identifier); err == nil {
+		return KindIPPrefix
+	}
+
+	if isASN(identifier) {
+		return KindASN
+	}
+
+	if strings.Contains(identifier, "://") {
+		if parsed, err := url.Parse(identifier); err == nil && parsed.Hostname() != "" {
+			return KindURL
+		}
+
+		return KindUnknown
+	}
+
+	if local, domain, found := strings.Cut(identifier, "@"); found {
+		if local != "" && isDomain(domain) {
+			return KindEmail
+		}
+
+		return KindUnknown
+	}
+
+	if isDomain(identifier) {
+		return KindDomain
+	}
+
+	if index := strings.LastIndex(identifier, "-"); index > 0 && index < len(identifier)-1 && !strings.Contains(identifier, ".") {
+		return KindEntity
+	}
+
+	return KindUnknown
+}
+
+// isASN reports whether the identifier is an autonomous system number. ASNs in asdot
+// notation must have an "AS" prefix (i.e. AS1.10), as 1.10 could equally be a domain or an
+// abbreviated IPv4 address.
+func isASN(identifier string) bool {
+	if _, err := ParseASN(identifier); err != nil {
+		return false
+	}
+
+	return !strings.Contains(identifier, ".") || (len(identifier) >= 2 && strings.EqualFold(identifier[:2], "AS"))
+}
+
+// isDomain reports whether the identifier is a domain name with more than one label.
+func isDomain(identifier string) bool {
+	ascii, err := toASCIIDomain(identifier)
+
+	return err == nil && strings.Contains(ascii, ".")
+}
+
+// lookup dispatches the identifier to the lookup method for its kind.
+func (client *Client) lookup(ctx context.Context, kind IdentifierKind, identifier string) (any, error) {
+	identifier = strings.TrimSpace(identifier)
+
+	switch kind {
+	case KindDomain:
+		return client.LookupDomainContext(ctx, identifier)
+	case KindIPv4:
+		return client.LookupIPv4Context(ctx, identifier)
+	case KindIPv6:
+		return client.LookupIPv6Context(ctx, identifier)
+	case KindASN:
+		return client.LookupASNStringContext(ctx, identifier)
+	case KindIPPrefix:
+		prefix, err := netip.ParsePrefix(identifier)
+
+		if err != nil {
+			return nil, &InvalidIdentifierError{Kind: "IP prefix", Identifier: identifier, Reason: err.Error()}
+		}
+
+		return client.LookupIPPrefixContext(ctx, prefix)
+	case KindURL:
+		parsed, err := url.Parse(identifier)
+
+		if err != nil {
+			return nil, &InvalidIdentifierError{Kind: "URL", Identifier: identifier, Reason: err.Error()}
+		}
+
+		if addr, err := netip.ParseAddr(parsed.Hostname()); err == nil {
+			if addr.Is4() {
+				return client.LookupIPv4AddrContext(ctx, addr)
+			}
+
+			return client.LookupIPv6AddrContext(ctx, addr)
+		}
+
+		return client.LookupDomainContext(ctx, parsed.Hostname())
+	case KindEmail:
+		return client.LookupDomainContext(ctx, identifier[strings.LastIndex(identifier, "@")+1:])
+	case KindEntity:
+		return client.LookupEntityContext(ctx, identifier)
+	default:
+		return nil, fmt.Errorf("unable to determine the kind of identifier: %q", identifier)
+	}
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/asn"
+	"github.com/ryanmab/rdap-go/pkg/client/response/dns"
+	"github.com/ryanmab/rdap-go/pkg/client/response/entity"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv4"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyingIdentifiers(t *testing.T) {
+	tests := map[string]IdentifierKind{
+		"example.com":          KindDomain,
+		"WWW.EXAMPLE.CO.UK":    KindDomain,
+		"8.8.8.8":              KindIPv4,
+		" 8.8.8.8 ":            KindIPv4,
+		"2001:4860:4860::8888": KindIPv6,
+		"AS15169":              KindASN,
+		"as15169":              KindASN,
+		"15169":                KindASN,
+		"AS1.10":               KindASN,
+		"as1.10":               KindASN,
+		"1.10":                 KindDomain,
+		"8.8":                  KindDomain,
+		"192.0.2.0/24":         KindIPPrefix,
+		"2001:db8::/32":        KindIPPrefix,
+		"https://example.com/": KindURL,
+		"http://8.8.8.8/path":  KindURL,
+		"joe@example.com":      KindEmail,
+		"bücher.de":            KindDomain,
+		"ZG39-ARIN":            KindEntity,
+		"@example.com":         KindUnknown,
+		"https:///path":        KindUnknown,
+		"":                     KindUnknown,
+		"not an identifier":    KindUnknown,
+		"localhost":            KindUnknown,
+	}
+
+	for identifier, expected := range tests {
+		assert.Equal(t, expected, classify(identifier), "Identifier %q", identifier)
+	}
+}
+
+func TestLookingUpAnyIdentifier(t *testing.T) {
+	client := New()
+
+	// Seed the cache, so that the lookups can be performed without making network requests.
+	client.cache.Set(query.DomainQuery, "example.com", dns.Response{LdhName: "EXAMPLE.COM"})
+	client.cache.Set(query.IPv4Query, "8.8.8.8", ipv4.Response{Name: "GOGL"})
+	client.cache.Set(query.IPv4Query, "8.8.8.0/24", ipv4.Response{Name: "GOGL"})
+	client.cache.Set(query.IPv6Query, "2001:4860:4860::8888", ipv6.Response{Name: "GOOGLE-IPV6"})
+	client.cache.Set(query.AsnQuery, "15169", asn.Response{Name: "GOOGLE"})
+	client.cache.Set(query.EntityQuery, "ZG39-ARIN", entity.Response{Entity: entity.Entity{Handle: "ZG39-ARIN"}})
+
+	tests := []struct {
+		identifier string
+		kind       IdentifierKind
+		name       func(result *Result) string
+	}{
+		{"example.com", KindDomain, func(result *Result) string { return result.Domain.LdhName }},
+		{"www.example.com", KindDomain, func(result *Result) string { return result.Domain.LdhName }},
+		{"https://www.example.com/path?query", KindURL, func(result *Result) string { return result.Domain.LdhName }},
+		{"joe@mail.example.com", KindEmail, func(result *Result) string { return result.Domain.LdhName }},
+		{"8.8.8.8", KindIPv4, func(result *Result) string { return result.Network.IPv4.Name }},
+		{"http://8.8.8.8:8080/", KindURL, func(result *Result) string { return result.Network.IPv4.Name }},
+		{"8.8.8.0/24", KindIPPrefix, func(result *Result) string { return result.Network.IPv4.Name }},
+		{"2001:4860:4860::8888", KindIPv6, func(result *Result) string { return result.Network.IPv6.Name }},
+		{"AS15169", KindASN, func(result *Result) string { return result.Autnum.Name }},
+		{"ZG39-ARIN", KindEntity, func(result *Result) string { return result.Entity.Handle }},
+	}
+
+	for _, test := range tests {
+		result, err := client.Lookup(test.identifier)
+
+		if !assert.NoError(t, err, "Identifier %q", test.identifier) {
+			continue
+		}
+
+		assert.Equal(t, test.identifier, result.Input, "Identifier %q", test.identifier)
+		assert.Equal(t, test.kind, result.Kind, "Identifier %q", test.identifier)
+		assert.NotEmpty(t, test.name(result), "Identifier %q", test.identifier)
+		assert.NotNil(t, result.Response(), "Identifier %q", test.identifier)
+	}
+}
+
+func TestLookingUpUnknownIdentifierReturnsAnError(t *testing.T) {
+	client := New()
+
+	result, err := client.Lookup("not an identifier")
+
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/ryanmab/rdap-go/pkg/client/response/asn"
+)
+
+// Origin is an autonomous system which originates routes for an IP network.
+type Origin struct {
+	// Autnum is the autonomous system number.
+	Autnum uint32
+
+	// Response is the registration data of the autonomous system, or nil if origins were not
+	// resolved, or the lookup failed.
+	Response *asn.Response
+
+	// Err is the error from looking up the autonomous system, if any.
+	Err error
+}
+
+// OriginLookup is the result of looking up an IP address along with the autonomous systems
+// which originate routes for its network.
+type OriginLookup struct {
+	IPLookup
+
+	// Origins is the autonomous systems which originate routes for the network, in the order
+	// they were returned by the server.
+	Origins []Origin
+}
+
+// LookupIPOrigins looks up an IP address of either address family (see LookupIP), along with
+// the autonomous systems which originate routes for its network.
+//
+// Origins are only known when the server supports the arin_originas0 extension. When resolve
+// is true, each origin is also looked up using LookupASN - a failure to look up an origin is
+// recorded on the origin, rather than failing the whole lookup.
+func (client *Client) LookupIPOrigins(addr netip.Addr, resolve bool) (*OriginLookup, error) {
+	return client.LookupIPOriginsContext(context.Background(), addr, resolve)
+}
+
+// LookupIPOriginsContext is like LookupIPOrigins, but uses the provided context for the
+// lifetime of the lookup.
+func (client *Client) LookupIPOriginsContext(ctx context.Context, addr netip.Addr, resolve bool) (*OriginLookup, error) {
+	lookup, err := client.LookupIPContext(ctx, addr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	autnums := lookup.Network.OriginAutnums()
+	result := &OriginLookup{IPLookup: *lookup, Origins: make([]Origin, 0, len(autnums))}
+
+	for _, autnum := range autnums {
+		origin := Origin{Autnum: autnum}
+
+		if resolve {
+			origin.Response, origin.Err = client.LookupASNContext(ctx, autnum)
+		}
+
+		result.Origins = append(result.Origins, origin)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"encoding/json"
+	"net/netip"
+	"os"
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/asn"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodingOriginAutnums(t *testing.T) {
+	data, err := os.ReadFile("testdata/ipv4.json")
+	assert.NoError(t, err)
+
+	var response ipv4.Response
+	assert.NoError(t, json.Unmarshal(data, &response))
+
+	assert.Equal(t, []uint32{15169}, response.OriginAutnums)
+	assert.NotContains(t, response.Extensions, "arin_originas0_originautnums")
+}
+
+func TestLookingUpIPOrigins(t *testing.T) {
+	client := New()
+
+	// Seed the cache, so that the lookups can be performed without making network requests.
+	client.cache.Set(query.IPv4Query, "8.8.8.8", ipv4.Response{Name: "GOGL", OriginAutnums: []uint32{15169, 36040}})
+	client.cache.Set(query.AsnQuery, "15169", asn.Response{Name: "GOOGLE"})
+	client.cache.Set(query.AsnQuery, "36040", asn.Response{Name: "YOUTUBE"})
+
+	t.Run("Unresolved", func(t *testing.T) {
+		lookup, err := client.LookupIPOrigins(netip.MustParseAddr("8.8.8.8"), false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "GOGL", lookup.Network.IPv4.Name)
+		assert.Equal(t, []Origin{{Autnum: 15169}, {Autnum: 36040}}, lookup.Origins)
+	})
+
+	t.Run("Resolved", func(t *testing.T) {
+		lookup, err := client.LookupIPOrigins(netip.MustParseAddr("8.8.8.8"), true)
+
+		assert.NoError(t, err)
+		assert.Len(t, lookup.Origins, 2)
+
+		for i, name := range []string{"GOOGLE", "YOUTUBE"} {
+			assert.NoError(t, lookup.Origins[i].Err)
+			assert.Equal(t, name, lookup.Origins[i].Response.Name)
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package client
+
+import (
+	"github.com/ryanmab/rdap-go/internal/ratelimit"
+)
+
+// RateLimit describes the rate at which the Client may make requests to a single RDAP
+// server host.
+//
+// Rate is the average number of requests per second, and Burst is the number of requests
+// which may be made at once before the rate applies. A zero Rate applies no limit.
+type RateLimit = ratelimit.Limit
+
+// defaultRateLimit is the rate limit applied to RDAP server hosts which do not have a
+// specific rate limit configured (see WithRateLimit and WithDefaultRateLimit).
+var defaultRateLimit = RateLimit{Rate: 10, Burst: 10}
+
+// newRateLimiter creates a rate limiter which applies the default rate limit.
+func newRateLimiter() *ratelimit.Limiter {
+	return ratelimit.New(defaultRateLimit)
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestsAreRateLimitedPerHost(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(newFixtureHandler(t, "ipv4.json", &requests))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+
+	client := New()
+	client.WithRateLimit(serverURL.Hostname(), RateLimit{Rate: 20, Burst: 1})
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for _, ip := range []string{"8.8.8.1", "8.8.8.2", "8.8.8.3", "8.8.8.4"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, ip)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// The first request uses the burst, and the remaining three are spaced 50ms apart.
+	assert.GreaterOrEqual(t, time.Since(start), 140*time.Millisecond)
+	assert.Equal(t, int32(4), requests.Load())
+}
+
+func TestRateLimitedRequestsRespectContext(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(newFixtureHandler(t, "ipv4.json", &requests))
+	defer server.Close()
+
+	client := New()
+	client.WithDefaultRateLimit(RateLimit{Rate: 0.1, Burst: 1})
+
+	_, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.1")
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	response, err := client.request(ctx, []string{server.URL + "/"}, query.IPv4Query, "8.8.8.2")
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), requests.Load())
+}
@@ -0,0 +1,24 @@
+package asn
+
+import "github.com/ryanmab/rdap-go/pkg/client/response"
+
+// Notation is a textual representation of an autonomous system number.
+//
+// See: https://datatracker.ietf.org/doc/rfc5396/
+type Notation = response.ASNNotation
+
+const (
+	// ASPlain represents an ASN as a single decimal number - i.e. 65546.
+	ASPlain = response.ASPlain
+	// ASDot represents 2-byte ASNs in asplain notation, and 4-byte ASNs as two decimal
+	// numbers holding the high and low order 16 bits, separated by a dot - i.e. 1.10.
+	ASDot = response.ASDot
+	// ASDotPlus represents every ASN as two decimal numbers holding the high and low order
+	// 16 bits, separated by a dot - i.e. 0.15169 or 1.10.
+	ASDotPlus = response.ASDotPlus
+)
+
+// Format returns the autonomous system number in the given notation, without an "AS" prefix.
+func Format(autnum uint32, notation Notation) string {
+	return response.FormatASN(autnum, notation)
+}
@@ -0,0 +1,39 @@
+package asn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormattingASNs(t *testing.T) {
+	tests := []struct {
+		autnum                    uint32
+		asPlain, asDot, asDotPlus string
+	}{
+		{0, "0", "0", "0.0"},
+		{15169, "15169", "15169", "0.15169"},
+		{65535, "65535", "65535", "0.65535"},
+		{65536, "65536", "1.0", "1.0"},
+		{65546, "65546", "1.10", "1.10"},
+		{4294967295, "4294967295", "65535.65535", "65535.65535"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.asPlain, Format(test.autnum, ASPlain), "ASN %d", test.autnum)
+		assert.Equal(t, test.asDot, Format(test.autnum, ASDot), "ASN %d", test.autnum)
+		assert.Equal(t, test.asDotPlus, Format(test.autnum, ASDotPlus), "ASN %d", test.autnum)
+	}
+}
+
+func TestFormattingASNRanges(t *testing.T) {
+	single := Response{StartAsn: 15169, EndAsn: 15169}
+
+	assert.Equal(t, "15169", single.FormatRange(ASPlain))
+	assert.Equal(t, "0.15169", single.FormatRange(ASDotPlus))
+
+	block := Response{StartAsn: 65546, EndAsn: 65556}
+
+	assert.Equal(t, "65546-65556", block.FormatRange(ASPlain))
+	assert.Equal(t, "1.10-1.20", block.FormatRange(ASDot))
+}
@@ -0,0 +1,109 @@
+package response
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ryanmab/rdap-go/pkg/client/response/internal/members"
+)
+
+// Autnum represents the RDAP specification's autnum object. It's both the response to autnum
+// queries (see asn.Response) and the type of the autnums held by entities.
+//
+// See Section 5.5: https://datatracker.ietf.org/doc/rfc9083/
+type Autnum struct {
+	// An array of strings each providing a hint as to the
+	// specifications used in the construction of the
+	Conformance []string `json:"rdapConformance" validate:"dive,required"`
+
+	ObjectType string `json:"objectClassName" validate:"required,eq=autnum"`
+	Handle     string `json:"handle" validate:"required"`
+
+	Name    string `json:"name" validate:"required"`
+	Type    string `json:"type,omitempty"`
+	Country string `json:"country,omitempty" validate:"omitempty,len=2"`
+
+	Lang string `json:"lang,omitempty"`
+
+	Events Events   `json:"events" validate:"dive,required"`
+	Status []Status `json:"status" validate:"dive,required"`
+	Links  []Link   `json:"links,omitempty" validate:"dive,required"`
+
+	Entities []Entity `json:"entities,omitempty" validate:"dive,required"`
+
+	Remarks []Notice `json:"remarks,omitempty" validate:"dive"`
+	Notices []Notice `json:"notices,omitempty" validate:"dive"`
+
+	// The host of the WHOIS (port 43) service of the registry
+	WhoisURI *string `json:"port43,omitempty" validate:"omitempty"`
+
+	StartAsn uint32 `json:"startAutnum" validate:"required"`
+	EndAsn   uint32 `json:"endAutnum" validate:"required"`
+
+	// Raw is the RDAP object exactly as it was returned by the server. It's only set on the
+	// responses returned by the Client, not on the objects they hold.
+	Raw json.RawMessage `json:"-"`
+
+	// Extensions holds the top-level members of the object which are not modelled by the
+	// response (i.e. registry-specific extensions), keyed by member name.
+	Extensions map[string]json.RawMessage `json:"-"`
+
+	// Warnings lists the members of the object which failed validation, when the response
+	// was decoded using lenient validation.
+	Warnings []ValidationWarning `json:"-"`
+}
+
+// ASNNotation is a textual representation of an autonomous system number (see asn.Notation).
+//
+// See: https://datatracker.ietf.org/doc/rfc5396/
+type ASNNotation int
+
+const (
+	// ASPlain represents an ASN as a single decimal number - i.e. 65546.
+	ASPlain ASNNotation = iota
+	// ASDot represents 2-byte ASNs in asplain notation, and 4-byte ASNs as two decimal
+	// numbers holding the high and low order 16 bits, separated by a dot - i.e. 1.10.
+	ASDot
+	// ASDotPlus represents every ASN as two decimal numbers holding the high and low order
+	// 16 bits, separated by a dot - i.e. 0.15169 or 1.10.
+	ASDotPlus
+)
+
+// FormatASN returns the autonomous system number in the given notation, without an "AS"
+// prefix.
+func FormatASN(autnum uint32, notation ASNNotation) string {
+	high, low := autnum>>16, autnum&0xffff
+
+	switch {
+	case notation == ASDotPlus, notation == ASDot && high > 0:
+		return strconv.FormatUint(uint64(high), 10) + "." + strconv.FormatUint(uint64(low), 10)
+	default:
+		return strconv.FormatUint(uint64(autnum), 10)
+	}
+}
+
+// FormatRange returns the range of autonomous system numbers the autnum object covers in the
+// given notation - i.e. 15169, or 1.10-1.20 when the range covers more than one number.
+func (response *Autnum) FormatRange(notation ASNNotation) string {
+	if response.StartAsn == response.EndAsn {
+		return FormatASN(response.StartAsn, notation)
+	}
+
+	return FormatASN(response.StartAsn, notation) + "-" + FormatASN(response.EndAsn, notation)
+}
+
+// UnmarshalJSON decodes the RDAP object, retaining any members which are not modelled by the
+// response.
+func (response *Autnum) UnmarshalJSON(data []byte) error {
+	type plain Autnum
+
+	return members.Unmarshal(data, (*plain)(response), &response.Extensions)
+}
+
+// MarshalJSON encodes the RDAP object, including any members which are not modelled by the
+// response.
+func (response Autnum) MarshalJSON() ([]byte, error) {
+	type plain Autnum
+
+	return members.Encode(plain(response), response.Extensions)
+}
@@ -0,0 +1,24 @@
+package response
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodingAutnumOmitsUnsetLanguage(t *testing.T) {
+	data, err := json.Marshal(Autnum{ObjectType: "autnum", Handle: "AS15169", StartAsn: 15169, EndAsn: 15169})
+
+	assert.NoError(t, err)
+
+	var members map[string]json.RawMessage
+
+	assert.NoError(t, json.Unmarshal(data, &members))
+	assert.NotContains(t, members, "lang")
+
+	data, err = json.Marshal(Autnum{ObjectType: "autnum", Lang: "en"})
+
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"lang":"en"`)
+}
@@ -0,0 +1,190 @@
+package dns
+
+import "strconv"
+
+// Recommendation is the requirement level for implementing a DNSSEC algorithm or DS digest
+// type, as set out in RFC 8624.
+//
+// See: https://datatracker.ietf.org/doc/rfc8624/
+type Recommendation int
+
+const (
+	// RecommendationNone is used for algorithms and digest types without a recommendation,
+	// including those which are not registered with IANA.
+	RecommendationNone Recommendation = iota
+
+	// RecommendationMustNot is used for algorithms and digest types which must not be used.
+	RecommendationMustNot
+
+	// RecommendationNotRecommended is used for algorithms and digest types which should not
+	// be used, as they are being phased out.
+	RecommendationNotRecommended
+
+	// RecommendationMay is used for algorithms and digest types which may be used.
+	RecommendationMay
+
+	// RecommendationRecommended is used for algorithms and digest types which should be used.
+	RecommendationRecommended
+
+	// RecommendationMust is used for algorithms and digest types which must be implemented.
+	RecommendationMust
+)
+
+func (recommendation Recommendation) String() string {
+	switch recommendation {
+	case RecommendationMustNot:
+		return "MUST NOT"
+	case RecommendationNotRecommended:
+		return "NOT RECOMMENDED"
+	case RecommendationMay:
+		return "MAY"
+	case RecommendationRecommended:
+		return "RECOMMENDED"
+	case RecommendationMust:
+		return "MUST"
+	default:
+		return "none"
+	}
+}
+
+// registration is the IANA registration of an algorithm or digest type.
+type registration struct {
+	name           string
+	recommendation Recommendation
+}
+
+// Algorithm is a DNSSEC security algorithm number.
+//
+// See: https://www.iana.org/assignments/dns-sec-alg-numbers/dns-sec-alg-numbers.xhtml
+type Algorithm int
+
+// The algorithms registered with IANA.
+const (
+	AlgorithmRSAMD5           Algorithm = 1
+	AlgorithmDH               Algorithm = 2
+	AlgorithmDSA              Algorithm = 3
+	AlgorithmRSASHA1          Algorithm = 5
+	AlgorithmDSANSEC3SHA1     Algorithm = 6
+	AlgorithmRSASHA1NSEC3SHA1 Algorithm = 7
+	AlgorithmRSASHA256        Algorithm = 8
+	AlgorithmRSASHA512        Algorithm = 10
+	AlgorithmECCGOST          Algorithm = 12
+	AlgorithmECDSAP256SHA256  Algorithm = 13
+	AlgorithmECDSAP384SHA384  Algorithm = 14
+	AlgorithmED25519          Algorithm = 15
+	AlgorithmED448            Algorithm = 16
+	AlgorithmSM2SM3           Algorithm = 17
+	AlgorithmECCGOST12        Algorithm = 23
+	AlgorithmIndirect         Algorithm = 252
+	AlgorithmPrivateDNS       Algorithm = 253
+	AlgorithmPrivateOID       Algorithm = 254
+)
+
+// algorithms is the registered algorithms, with the recommendations for DNSSEC signing from
+// Section 3.1 of RFC 8624.
+var algorithms = map[Algorithm]registration{
+	AlgorithmRSAMD5:           {"RSAMD5", RecommendationMustNot},
+	AlgorithmDH:               {"DH", RecommendationNone},
+	AlgorithmDSA:              {"DSA", RecommendationMustNot},
+	AlgorithmRSASHA1:          {"RSASHA1", RecommendationNotRecommended},
+	AlgorithmDSANSEC3SHA1:     {"DSA-NSEC3-SHA1", RecommendationMustNot},
+	AlgorithmRSASHA1NSEC3SHA1: {"RSASHA1-NSEC3-SHA1", RecommendationNotRecommended},
+	AlgorithmRSASHA256:        {"RSASHA256", RecommendationMust},
+	AlgorithmRSASHA512:        {"RSASHA512", RecommendationNotRecommended},
+	AlgorithmECCGOST:          {"ECC-GOST", RecommendationMustNot},
+	AlgorithmECDSAP256SHA256:  {"ECDSAP256SHA256", RecommendationMust},
+	AlgorithmECDSAP384SHA384:  {"ECDSAP384SHA384", RecommendationMay},
+	AlgorithmED25519:          {"ED25519", RecommendationRecommended},
+	AlgorithmED448:            {"ED448", RecommendationMay},
+	AlgorithmSM2SM3:           {"SM2SM3", RecommendationNone},
+	AlgorithmECCGOST12:        {"ECC-GOST12", RecommendationNone},
+	AlgorithmIndirect:         {"INDIRECT", RecommendationNone},
+	AlgorithmPrivateDNS:       {"PRIVATEDNS", RecommendationNone},
+	AlgorithmPrivateOID:       {"PRIVATEOID", RecommendationNone},
+}
+
+// String returns the mnemonic of the algorithm (i.e. ECDSAP256SHA256), or its number if it
+// isn't registered.
+func (algorithm Algorithm) String() string {
+	if registration, ok := algorithms[algorithm]; ok {
+		return registration.name
+	}
+
+	return "Algorithm(" + strconv.Itoa(int(algorithm)) + ")"
+}
+
+// Registered reports whether the algorithm is registered with IANA.
+func (algorithm Algorithm) Registered() bool {
+	_, ok := algorithms[algorithm]
+
+	return ok
+}
+
+// Recommendation returns the recommendation for signing zones using the algorithm.
+func (algorithm Algorithm) Recommendation() Recommendation {
+	return algorithms[algorithm].recommendation
+}
+
+// Deprecated reports whether zones should no longer be signed using the algorithm (i.e.
+// RSASHA1 or RSAMD5).
+func (algorithm Algorithm) Deprecated() bool {
+	recommendation := algorithm.Recommendation()
+
+	return recommendation == RecommendationMustNot || recommendation == RecommendationNotRecommended
+}
+
+// DigestType is a DS record digest algorithm number.
+//
+// See: https://www.iana.org/assignments/ds-rr-types/ds-rr-types.xhtml
+type DigestType int
+
+// The digest types registered with IANA.
+const (
+	DigestTypeSHA1         DigestType = 1
+	DigestTypeSHA256       DigestType = 2
+	DigestTypeGOSTR341194  DigestType = 3
+	DigestTypeSHA384       DigestType = 4
+	DigestTypeGOSTR3411012 DigestType = 5
+	DigestTypeSM3          DigestType = 6
+)
+
+// digestTypes is the registered digest types, with the recommendations for DS generation from
+// Section 3.3 of RFC 8624.
+var digestTypes = map[DigestType]registration{
+	DigestTypeSHA1:         {"SHA-1", RecommendationMustNot},
+	DigestTypeSHA256:       {"SHA-256", RecommendationMust},
+	DigestTypeGOSTR341194:  {"GOST R 34.11-94", RecommendationMustNot},
+	DigestTypeSHA384:       {"SHA-384", RecommendationMay},
+	DigestTypeGOSTR3411012: {"GOST R 34.11-2012", RecommendationNone},
+	DigestTypeSM3:          {"SM3", RecommendationNone},
+}
+
+// String returns the name of the digest type (i.e. SHA-256), or its number if it isn't
+// registered.
+func (digestType DigestType) String() string {
+	if registration, ok := digestTypes[digestType]; ok {
+		return registration.name
+	}
+
+	return "DigestType(" + strconv.Itoa(int(digestType)) + ")"
+}
+
+// Registered reports whether the digest type is registered with IANA.
+func (digestType DigestType) Registered() bool {
+	_, ok := digestTypes[digestType]
+
+	return ok
+}
+
+// Recommendation returns the recommendation for generating DS records using the digest type.
+func (digestType DigestType) Recommendation() Recommendation {
+	return digestTypes[digestType].recommendation
+}
+
+// Deprecated reports whether DS records should no longer be generated using the digest type
+// (i.e. SHA-1).
+func (digestType DigestType) Deprecated() bool {
+	recommendation := digestType.Recommendation()
+
+	return recommendation == RecommendationMustNot || recommendation == RecommendationNotRecommended
+}
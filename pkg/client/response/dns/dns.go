@@ -1,6 +1,14 @@
 package dns
 
-import "github.com/ryanmab/rdap-go/pkg/client/response"
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ryanmab/rdap-go/pkg/client/response"
+	"github.com/ryanmab/rdap-go/pkg/client/response/internal/members"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ip"
+	"golang.org/x/net/idna"
+)
 
 // Response represents the RDAP response structure for domain queries.
 // See: https://datatracker.ietf.org/doc/rfc9083/
@@ -18,33 +26,107 @@ type Response struct {
 	// A string describing a domain name in Unicode form
 	UnicodeName *string `json:"unicodeName,omitempty"`
 
-	Events []response.Event  `json:"events" validate:"dive,required"`
+	// The internationalized domain name (IDN) variants of the domain
+	Variants []Variant `json:"variants,omitempty" validate:"dive"`
+
+	Events response.Events   `json:"events" validate:"dive,required"`
 	Status []response.Status `json:"status" validate:"dive,required"`
 	Links  []response.Link   `json:"links,omitempty" validate:"dive,required"`
 
 	Nameservers []response.Nameserver `json:"nameservers" validate:"dive"`
 
-	SecureDNS *struct {
-		ZoneSigned       *bool `json:"zoneSigned,omitempty"`
-		DelegationSigned *bool `json:"delegationSigned,omitempty"`
-		MaxSignatureLife *int  `json:"maxSigLife,omitempty" validate:"omitempty,min=0"`
-
-		DsData []struct {
-			KeyTag     int              `json:"keyTag" validate:"required,min=0"`
-			Algorithm  int              `json:"algorithm" validate:"required,min=0"`
-			DigestType int              `json:"digestType" validate:"required,min=0"`
-			Digest     string           `json:"digest" validate:"required"`
-			Events     []response.Event `json:"events,omitempty" validate:"dive,required"`
-		} `json:"dsData,omitempty" validate:"dive"`
-
-		KeyData []struct {
-			Flags     int              `json:"flags" validate:"required,min=0"`
-			Protocol  int              `json:"protocol" validate:"required,min=0"`
-			Algorithm int              `json:"algorithm" validate:"required,min=0"`
-			PublicKey string           `json:"publicKey" validate:"required"`
-			Events    []response.Event `json:"events,omitempty" validate:"dive,required"`
-		} `json:"keyData,omitempty" validate:"dive"`
-	} `json:"secureDNS,omitempty"`
+	SecureDNS *SecureDNS `json:"secureDNS,omitempty"`
 
 	Entities []response.Entity `json:"entities,omitempty" validate:"dive,required"`
+
+	PublicIds []response.PublicID `json:"publicIds,omitempty" validate:"dive,required"`
+
+	Remarks []response.Notice `json:"remarks,omitempty" validate:"dive"`
+	Notices []response.Notice `json:"notices,omitempty" validate:"dive"`
+
+	// The host of the WHOIS (port 43) service of the registry
+	WhoisURI *string `json:"port43,omitempty" validate:"omitempty"`
+
+	// The language of the text of the response (i.e. en)
+	Lang string `json:"lang,omitempty"`
+
+	// The ip network of the address space a reverse DNS domain (i.e. 2.0.192.in-addr.arpa)
+	// is delegated for.
+	Network *ip.Response `json:"network,omitempty"`
+
+	// Raw is the RDAP object exactly as it was returned by the server. It's only set on the
+	// responses returned by the Client, not on the objects they hold.
+	Raw json.RawMessage `json:"-"`
+
+	// Extensions holds the top-level members of the object which are not modelled by the
+	// response (i.e. registry-specific extensions), keyed by member name.
+	Extensions map[string]json.RawMessage `json:"-"`
+
+	// Warnings lists the members of the object which failed validation, when the response
+	// was decoded using lenient validation.
+	Warnings []response.ValidationWarning `json:"-"`
+}
+
+// Variant is a set of internationalized domain name (IDN) variants of a domain, which share
+// the same relationship to it.
+//
+// See Section 5.3: https://datatracker.ietf.org/doc/rfc9083/
+type Variant struct {
+	// The relationship of the variants to the domain (i.e. "registered", "unregistered",
+	// "registration restricted", "open registration" or "conjoined")
+	Relation []string `json:"relation,omitempty"`
+
+	// The name of the IDN table of codepoints the variants were generated from
+	IdnTable *string `json:"idnTable,omitempty"`
+
+	VariantNames []VariantName `json:"variantNames,omitempty" validate:"dive"`
+}
+
+// VariantName is a single variant of a domain name.
+type VariantName struct {
+	// A string describing the variant in LDH form
+	LdhName string `json:"ldhName,omitempty"`
+
+	// A string describing the variant in Unicode form
+	UnicodeName *string `json:"unicodeName,omitempty"`
+}
+
+// ALabelName returns the domain name in its lowercase A-label (LDH) form - i.e.
+// xn--bcher-kva.de.
+func (response *Response) ALabelName() string {
+	return strings.ToLower(response.LdhName)
+}
+
+// ULabelName returns the domain name in its Unicode form - i.e. bücher.de.
+//
+// The unicodeName returned by the server is used when present. Otherwise, the name is
+// derived from the ldhName by converting any A-labels to U-labels.
+func (response *Response) ULabelName() string {
+	if response.UnicodeName != nil && *response.UnicodeName != "" {
+		return *response.UnicodeName
+	}
+
+	unicode, err := idna.ToUnicode(response.ALabelName())
+
+	if err != nil {
+		return response.ALabelName()
+	}
+
+	return unicode
+}
+
+// UnmarshalJSON decodes the RDAP object, retaining any members which are not modelled by the
+// response.
+func (response *Response) UnmarshalJSON(data []byte) error {
+	type plain Response
+
+	return members.Unmarshal(data, (*plain)(response), &response.Extensions)
+}
+
+// MarshalJSON encodes the RDAP object, including any members which are not modelled by the
+// response.
+func (response Response) MarshalJSON() ([]byte, error) {
+	type plain Response
+
+	return members.Encode(plain(response), response.Extensions)
 }
@@ -0,0 +1,72 @@
+package dns
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodingDomainVariants(t *testing.T) {
+	var response Response
+
+	// The variants member of the example domain in Section 5.3 of RFC 9083.
+	err := json.Unmarshal([]byte(`{
+		"objectClassName": "domain",
+		"handle": "XXXX",
+		"ldhName": "xn--fo-5ja.example",
+		"unicodeName": "fóo.example",
+		"variants": [
+			{
+				"relation": ["registered", "conjoined"],
+				"variantNames": [
+					{"ldhName": "xn--fo-cka.example", "unicodeName": "fõo.example"},
+					{"ldhName": "xn--fo-fka.example", "unicodeName": "föo.example"}
+				]
+			},
+			{
+				"relation": ["unregistered", "registration restricted"],
+				"idnTable": ".EXAMPLE Swedish",
+				"variantNames": [
+					{"ldhName": "xn--fo-8ja.example", "unicodeName": "fôo.example"}
+				]
+			}
+		]
+	}`), &response)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.Variants, 2)
+
+	assert.Equal(t, []string{"registered", "conjoined"}, response.Variants[0].Relation)
+	assert.Nil(t, response.Variants[0].IdnTable)
+	assert.Len(t, response.Variants[0].VariantNames, 2)
+	assert.Equal(t, "xn--fo-cka.example", response.Variants[0].VariantNames[0].LdhName)
+	assert.Equal(t, "fõo.example", *response.Variants[0].VariantNames[0].UnicodeName)
+
+	assert.Equal(t, ".EXAMPLE Swedish", *response.Variants[1].IdnTable)
+	assert.Equal(t, "fôo.example", *response.Variants[1].VariantNames[0].UnicodeName)
+}
+
+func TestDomainNameForms(t *testing.T) {
+	unicodeName := "fóo.example"
+
+	t.Run("Server provided Unicode name", func(t *testing.T) {
+		response := Response{LdhName: "XN--FO-5JA.EXAMPLE", UnicodeName: &unicodeName}
+
+		assert.Equal(t, "xn--fo-5ja.example", response.ALabelName())
+		assert.Equal(t, "fóo.example", response.ULabelName())
+	})
+
+	t.Run("Derived Unicode name", func(t *testing.T) {
+		response := Response{LdhName: "XN--FO-5JA.EXAMPLE"}
+
+		assert.Equal(t, "fóo.example", response.ULabelName())
+	})
+
+	t.Run("ASCII only name", func(t *testing.T) {
+		response := Response{LdhName: "EXAMPLE.COM"}
+
+		assert.Equal(t, "example.com", response.ALabelName())
+		assert.Equal(t, "example.com", response.ULabelName())
+	})
+}
@@ -0,0 +1,282 @@
+package dns
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+	"unicode"
+
+	"github.com/ryanmab/rdap-go/pkg/client/response"
+)
+
+var (
+	// ErrUnsupportedDigestType is returned when a DS digest can't be calculated, as the
+	// digest type isn't supported (i.e. GOST R 34.11-94).
+	ErrUnsupportedDigestType = errors.New("unsupported DS digest type")
+
+	// ErrNoMatchingKey is returned when verifying dsData which doesn't match the key tag and
+	// algorithm of any keyData.
+	ErrNoMatchingKey = errors.New("no keyData matches the key tag and algorithm")
+
+	// ErrDigestMismatch is returned when verifying dsData whose digest doesn't match the
+	// digest of the keyData with the same key tag and algorithm.
+	ErrDigestMismatch = errors.New("DS digest does not match the keyData")
+)
+
+// SecureDNS represents the RDAP specification's secureDNS member of a domain, describing the
+// DNSSEC delegation of the domain.
+//
+// See Section 5.3: https://datatracker.ietf.org/doc/rfc9083/
+type SecureDNS struct {
+	ZoneSigned       *bool `json:"zoneSigned,omitempty"`
+	DelegationSigned *bool `json:"delegationSigned,omitempty"`
+	MaxSignatureLife *int  `json:"maxSigLife,omitempty" validate:"omitempty,min=0"`
+
+	DsData  []DsData  `json:"dsData,omitempty" validate:"dive"`
+	KeyData []KeyData `json:"keyData,omitempty" validate:"dive"`
+}
+
+// DsData is a delegation signer (DS) record of a domain.
+type DsData struct {
+	KeyTag     int             `json:"keyTag" validate:"required,min=0"`
+	Algorithm  Algorithm       `json:"algorithm" validate:"required,min=0"`
+	DigestType DigestType      `json:"digestType" validate:"required,min=0"`
+	Digest     string          `json:"digest" validate:"required"`
+	Events     response.Events `json:"events,omitempty" validate:"dive,required"`
+	Links      []response.Link `json:"links,omitempty" validate:"dive,required"`
+}
+
+// KeyData is a DNSKEY record of a domain.
+type KeyData struct {
+	Flags     int             `json:"flags" validate:"required,min=0"`
+	Protocol  int             `json:"protocol" validate:"required,min=0"`
+	Algorithm Algorithm       `json:"algorithm" validate:"required,min=0"`
+	PublicKey string          `json:"publicKey" validate:"required"`
+	Events    response.Events `json:"events,omitempty" validate:"dive,required"`
+	Links     []response.Link `json:"links,omitempty" validate:"dive,required"`
+}
+
+// withoutSpaces removes the whitespace which servers may include in base64 and hex encoded
+// values (i.e. copied from zone files).
+func withoutSpaces(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+
+		return r
+	}, value)
+}
+
+// rdata returns the DNSKEY record data in wire format.
+//
+// See Section 2.1: https://datatracker.ietf.org/doc/rfc4034/
+func (key *KeyData) rdata() ([]byte, error) {
+	publicKey, err := base64.StdEncoding.DecodeString(withoutSpaces(key.PublicKey))
+
+	if err != nil {
+		return nil, fmt.Errorf("keyData has a malformed publicKey: %w", err)
+	}
+
+	rdata := binary.BigEndian.AppendUint16(nil, uint16(key.Flags))
+	rdata = append(rdata, byte(key.Protocol), byte(key.Algorithm))
+
+	return append(rdata, publicKey...), nil
+}
+
+// KeyTag calculates the key tag of the key, which identifies it in DS records.
+//
+// See Appendix B: https://datatracker.ietf.org/doc/rfc4034/
+func (key *KeyData) KeyTag() (int, error) {
+	rdata, err := key.rdata()
+
+	if err != nil {
+		return 0, err
+	}
+
+	if key.Algorithm == AlgorithmRSAMD5 {
+		if len(rdata) < 4 {
+			return 0, errors.New("keyData has a malformed publicKey: too short")
+		}
+
+		return int(binary.BigEndian.Uint16(rdata[len(rdata)-3:])), nil
+	}
+
+	var accumulator uint32
+
+	for i, b := range rdata {
+		if i%2 == 0 {
+			accumulator += uint32(b) << 8
+		} else {
+			accumulator += uint32(b)
+		}
+	}
+
+	accumulator += accumulator >> 16 & 0xFFFF
+
+	return int(accumulator & 0xFFFF), nil
+}
+
+// DS calculates the DS record of the key for the domain which owns it, using the digest type.
+// ErrUnsupportedDigestType is returned if the digest type isn't SHA-1, SHA-256 or SHA-384.
+//
+// See Section 5.1.4: https://datatracker.ietf.org/doc/rfc4034/
+func (key *KeyData) DS(owner string, digestType DigestType) (*DsData, error) {
+	var digest hash.Hash
+
+	switch digestType {
+	case DigestTypeSHA1:
+		digest = sha1.New()
+	case DigestTypeSHA256:
+		digest = sha256.New()
+	case DigestTypeSHA384:
+		digest = sha512.New384()
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedDigestType, digestType)
+	}
+
+	name, err := wireName(owner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rdata, err := key.rdata()
+
+	if err != nil {
+		return nil, err
+	}
+
+	keyTag, err := key.KeyTag()
+
+	if err != nil {
+		return nil, err
+	}
+
+	digest.Write(name)
+	digest.Write(rdata)
+
+	return &DsData{
+		KeyTag:     keyTag,
+		Algorithm:  key.Algorithm,
+		DigestType: digestType,
+		Digest:     strings.ToUpper(hex.EncodeToString(digest.Sum(nil))),
+	}, nil
+}
+
+// wireName returns the domain name in canonical (lowercase) DNS wire format.
+//
+// See Section 6.2: https://datatracker.ietf.org/doc/rfc4034/
+func wireName(domain string) ([]byte, error) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	var name []byte
+
+	if domain != "" {
+		for _, label := range strings.Split(domain, ".") {
+			if len(label) == 0 || len(label) > 63 {
+				return nil, fmt.Errorf("domain %q has a label which is empty or longer than 63 octets", domain)
+			}
+
+			name = append(name, byte(len(label)))
+			name = append(name, label...)
+		}
+	}
+
+	return append(name, 0), nil
+}
+
+// DsVerification is the outcome of verifying dsData against the keyData of a domain.
+type DsVerification struct {
+	DsData DsData
+
+	// KeyData is the key with the key tag and algorithm of the dsData, or nil if there is
+	// none.
+	KeyData *KeyData
+
+	// Err is the reason the dsData could not be verified (i.e. ErrDigestMismatch), or nil if
+	// its digest matches the key.
+	Err error
+
+	// WeakAlgorithm reports whether the algorithm of the key is deprecated (i.e. RSASHA1).
+	WeakAlgorithm bool
+
+	// WeakDigestType reports whether the digest type of the dsData is deprecated (i.e. SHA-1).
+	WeakDigestType bool
+}
+
+// Verified reports whether the digest of the dsData matches the key.
+func (verification *DsVerification) Verified() bool {
+	return verification.Err == nil
+}
+
+// Verify calculates the DS digest of the keyData with the key tag and algorithm of each
+// dsData, and checks it against the digest of the dsData. The owner is the domain the
+// secureDNS data belongs to.
+func (secureDNS *SecureDNS) Verify(owner string) []DsVerification {
+	verifications := make([]DsVerification, 0, len(secureDNS.DsData))
+
+	for _, ds := range secureDNS.DsData {
+		verification := DsVerification{
+			DsData:         ds,
+			Err:            ErrNoMatchingKey,
+			WeakAlgorithm:  ds.Algorithm.Deprecated(),
+			WeakDigestType: ds.DigestType.Deprecated(),
+		}
+
+		for i := range secureDNS.KeyData {
+			key := &secureDNS.KeyData[i]
+
+			if keyTag, err := key.KeyTag(); err != nil || key.Algorithm != ds.Algorithm || keyTag != ds.KeyTag {
+				continue
+			}
+
+			verification.KeyData = key
+
+			calculated, err := key.DS(owner, ds.DigestType)
+
+			if err != nil {
+				verification.Err = err
+				break
+			}
+
+			expected, err := hex.DecodeString(withoutSpaces(ds.Digest))
+
+			if err != nil {
+				verification.Err = fmt.Errorf("dsData has a malformed digest: %w", err)
+				break
+			}
+
+			actual, _ := hex.DecodeString(calculated.Digest)
+
+			if bytes.Equal(expected, actual) {
+				verification.Err = nil
+				break
+			}
+
+			verification.Err = ErrDigestMismatch
+		}
+
+		verifications = append(verifications, verification)
+	}
+
+	return verifications
+}
+
+// VerifyDelegationSigners verifies the dsData of the domain against its keyData (see
+// SecureDNS.Verify). Nil is returned if the response has no secureDNS member.
+func (response *Response) VerifyDelegationSigners() []DsVerification {
+	if response.SecureDNS == nil {
+		return nil
+	}
+
+	return response.SecureDNS.Verify(response.LdhName)
+}
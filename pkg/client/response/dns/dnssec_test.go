@@ -0,0 +1,131 @@
+package dns
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The key and DS record from the example in Section 5.4 of RFC 4034.
+var rfc4034Key = KeyData{
+	Flags:     256,
+	Protocol:  3,
+	Algorithm: AlgorithmRSASHA1,
+	PublicKey: "AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZ " +
+		"DRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9Xzc " +
+		"nOf+EPbtG9DMBmADjFDc2w/rljwvFw==",
+}
+
+var ecdsaKey = KeyData{
+	Flags:     257,
+	Protocol:  3,
+	Algorithm: AlgorithmECDSAP256SHA256,
+	PublicKey: "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIjJCUmJygpKissLS4vMDEyMzQ1Njc4OTo7PD0+Pw==",
+}
+
+func TestCalculatingKeyTag(t *testing.T) {
+	keyTag, err := rfc4034Key.KeyTag()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 60485, keyTag)
+
+	keyTag, err = ecdsaKey.KeyTag()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 59409, keyTag)
+}
+
+func TestCalculatingDS(t *testing.T) {
+	tests := []struct {
+		key        KeyData
+		owner      string
+		digestType DigestType
+		digest     string
+	}{
+		{rfc4034Key, "dskey.example.com.", DigestTypeSHA1, "2BB183AF5F22588179A53B0A98631FAD1A292118"},
+		{rfc4034Key, "DSKEY.example.com", DigestTypeSHA256, "D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"},
+		{ecdsaKey, "example.com", DigestTypeSHA256, "186EA634D4CF62F2671F3C757F507F8D3FE985FE431A1181B661F8EED27A59CA"},
+		{ecdsaKey, "example.com", DigestTypeSHA384, "6B21ED9F069FA8C7ED8BF5049DA80C9FF6423BDBC1007AB750310AA6DD390C39B2144E2F684EE9E48218F752E3C2806A"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.owner+" "+test.digestType.String(), func(t *testing.T) {
+			ds, err := test.key.DS(test.owner, test.digestType)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.key.Algorithm, ds.Algorithm)
+			assert.Equal(t, test.digestType, ds.DigestType)
+			assert.Equal(t, test.digest, ds.Digest)
+		})
+	}
+
+	t.Run("Unsupported digest type", func(t *testing.T) {
+		_, err := ecdsaKey.DS("example.com", DigestTypeGOSTR341194)
+
+		assert.ErrorIs(t, err, ErrUnsupportedDigestType)
+	})
+}
+
+func TestVerifyingDelegationSigners(t *testing.T) {
+	var response Response
+
+	err := json.Unmarshal([]byte(`{
+		"objectClassName": "domain",
+		"ldhName": "example.com",
+		"secureDNS": {
+			"delegationSigned": true,
+			"dsData": [
+				{"keyTag": 59409, "algorithm": 13, "digestType": 2, "digest": "186ea634d4cf62f2671f3c757f507f8d 3fe985fe431a1181b661f8eed27a59ca"},
+				{"keyTag": 59409, "algorithm": 13, "digestType": 4, "digest": "00"},
+				{"keyTag": 60485, "algorithm": 5, "digestType": 1, "digest": "2BB183AF5F22588179A53B0A98631FAD1A292118"},
+				{"keyTag": 12345, "algorithm": 8, "digestType": 2, "digest": "00"}
+			],
+			"keyData": [
+				{"flags": 257, "protocol": 3, "algorithm": 13, "publicKey": "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIjJCUmJygpKissLS4vMDEyMzQ1Njc4OTo7PD0+Pw=="}
+			]
+		}
+	}`), &response)
+
+	assert.NoError(t, err)
+
+	verifications := response.VerifyDelegationSigners()
+
+	assert.Len(t, verifications, 4)
+
+	// The digest is compared regardless of case and whitespace.
+	assert.True(t, verifications[0].Verified())
+	assert.Equal(t, &response.SecureDNS.KeyData[0], verifications[0].KeyData)
+	assert.False(t, verifications[0].WeakAlgorithm)
+	assert.False(t, verifications[0].WeakDigestType)
+
+	assert.ErrorIs(t, verifications[1].Err, ErrDigestMismatch)
+	assert.NotNil(t, verifications[1].KeyData)
+
+	// The key of the DS record was not returned, but the weak algorithm and digest type are
+	// still flagged.
+	assert.ErrorIs(t, verifications[2].Err, ErrNoMatchingKey)
+	assert.Nil(t, verifications[2].KeyData)
+	assert.True(t, verifications[2].WeakAlgorithm)
+	assert.True(t, verifications[2].WeakDigestType)
+
+	assert.ErrorIs(t, verifications[3].Err, ErrNoMatchingKey)
+}
+
+func TestAlgorithmAndDigestTypeRegistrations(t *testing.T) {
+	assert.Equal(t, "ECDSAP256SHA256", AlgorithmECDSAP256SHA256.String())
+	assert.Equal(t, RecommendationMust, AlgorithmECDSAP256SHA256.Recommendation())
+	assert.False(t, AlgorithmECDSAP256SHA256.Deprecated())
+
+	assert.True(t, AlgorithmRSAMD5.Deprecated())
+	assert.True(t, AlgorithmRSASHA1.Deprecated())
+
+	assert.Equal(t, "Algorithm(99)", Algorithm(99).String())
+	assert.False(t, Algorithm(99).Registered())
+	assert.False(t, Algorithm(99).Deprecated())
+
+	assert.Equal(t, "SHA-256", DigestTypeSHA256.String())
+	assert.True(t, DigestTypeSHA1.Deprecated())
+	assert.Equal(t, "MUST NOT", DigestTypeSHA1.Recommendation().String())
+	assert.Equal(t, "DigestType(99)", DigestType(99).String())
+}
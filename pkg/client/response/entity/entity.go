@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"encoding/json"
+
+	"github.com/ryanmab/rdap-go/pkg/client/response"
+	"github.com/ryanmab/rdap-go/pkg/client/response/internal/members"
+)
+
+// Response represents the RDAP response structure for entity queries.
+// See: https://datatracker.ietf.org/doc/rfc9083/
+type Response struct {
+	// An array of strings each providing a hint as to the
+	// specifications used in the construction of the
+	Conformance []string `json:"rdapConformance" validate:"dive,required"`
+
+	Entity
+
+	Notices []response.Notice `json:"notices,omitempty" validate:"dive"`
+
+	// Raw is the RDAP object exactly as it was returned by the server. It's only set on the
+	// responses returned by the Client, not on the objects they hold.
+	Raw json.RawMessage `json:"-"`
+
+	// Extensions holds the top-level members of the object which are not modelled by the
+	// response (i.e. registry-specific extensions), keyed by member name.
+	Extensions map[string]json.RawMessage `json:"-"`
+
+	// Warnings lists the members of the object which failed validation, when the response
+	// was decoded using lenient validation.
+	Warnings []response.ValidationWarning `json:"-"`
+}
+
+// Entity represents the RDAP specification's entity object, including the ip networks and
+// autnums of the entity (i.e. all of the resources of an organization), and the entities it
+// holds. It's the same type as the entities held by every other response.
+//
+// See Section 5.1: https://datatracker.ietf.org/doc/rfc9083/
+type Entity = response.Entity
+
+// UnmarshalJSON decodes the RDAP object, retaining any members which are not modelled by the
+// response.
+func (response *Response) UnmarshalJSON(data []byte) error {
+	type plain Response
+
+	return members.Unmarshal(data, (*plain)(response), &response.Extensions)
+}
+
+// MarshalJSON encodes the RDAP object, including any members which are not modelled by the
+// response.
+func (response Response) MarshalJSON() ([]byte, error) {
+	type plain Response
+
+	return members.Encode(plain(response), response.Extensions)
+}
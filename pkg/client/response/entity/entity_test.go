@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodingEntity(t *testing.T) {
+	var response Response
+
+	err := json.Unmarshal([]byte(`{
+		"rdapConformance": ["rdap_level_0"],
+		"objectClassName": "entity",
+		"handle": "XXXX-ARIN",
+		"vcardArray": ["vcard", [["version", {}, "text", "4.0"], ["fn", {}, "text", "Joe User"]]],
+		"roles": ["registrar"],
+		"status": ["validated", "locked"],
+		"port43": "whois.example.net",
+		"lang": "en",
+		"remarks": [{"title": "Remark", "description": ["The entity is an example."]}],
+		"notices": [{"title": "Terms of Use", "description": ["Service subject to terms of use."]}],
+		"events": [{"eventAction": "registration", "eventDate": "1990-12-31T23:59:59Z"}],
+		"networks": [
+			{"objectClassName": "ip network", "name": "EXAMPLE-V4", "ipVersion": "v4", "startAddress": "192.0.2.0", "endAddress": "192.0.2.255"},
+			{"objectClassName": "ip network", "name": "EXAMPLE-V6", "ipVersion": "v6", "startAddress": "2001:db8::", "endAddress": "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff"}
+		],
+		"autnums": [{"objectClassName": "autnum", "handle": "AS64496", "startAutnum": 64496, "endAutnum": 64496, "name": "EXAMPLE-AS"}]
+	}`), &response)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rdap_level_0"}, response.Conformance)
+	assert.Equal(t, "XXXX-ARIN", response.Handle)
+	assert.Equal(t, []string{"registrar"}, response.Roles)
+	assert.Equal(t, "whois.example.net", *response.WhoisURI)
+	assert.Equal(t, "en", response.Lang)
+	assert.Equal(t, []string{"The entity is an example."}, response.Remarks[0].Description)
+	assert.Equal(t, "Terms of Use", response.Notices[0].Title)
+	assert.Empty(t, response.Extensions)
+
+	assert.Len(t, response.Networks, 2)
+	assert.Equal(t, "EXAMPLE-V4", response.Networks[0].IPv4.Name)
+	assert.Equal(t, "EXAMPLE-V6", response.Networks[1].IPv6.Name)
+
+	assert.Len(t, response.Autnums, 1)
+	assert.Equal(t, uint32(64496), response.Autnums[0].StartAsn)
+}
+
+func TestDecodingEntityResources(t *testing.T) {
+	var response Response
+
+	err := json.Unmarshal([]byte(`{
+		"rdapConformance": ["rdap_level_0"],
+		"objectClassName": "entity",
+		"handle": "EXAMPLE-ARIN",
+		"roles": ["registrant"],
+		"entities": [
+			{
+				"objectClassName": "entity",
+				"handle": "ABUSE-ARIN",
+				"roles": ["abuse"],
+				"autnums": [{"objectClassName": "autnum", "handle": "AS64497", "startAutnum": 64497, "endAutnum": 64497}]
+			}
+		],
+		"networks": [
+			{"objectClassName": "ip network", "ipVersion": "v4", "startAddress": "192.0.2.0", "endAddress": "192.0.2.255"}
+		]
+	}`), &response)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "EXAMPLE-ARIN", response.Handle)
+	assert.Equal(t, []string{"registrant"}, response.Roles)
+
+	assert.Len(t, response.Networks, 1)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24")}, response.Networks[0].Prefixes())
+
+	// The entities held by the entity are the same type, so their resources are typed too.
+	assert.Len(t, response.Entities, 1)
+	assert.Equal(t, "ABUSE-ARIN", response.Entities[0].Handle)
+	assert.Len(t, response.Entities[0].Autnums, 1)
+	assert.Equal(t, uint32(64497), response.Entities[0].Autnums[0].StartAsn)
+}
+
+func TestEncodingEntityRoundTrips(t *testing.T) {
+	var response Response
+
+	err := json.Unmarshal([]byte(`{
+		"rdapConformance": ["rdap_level_0"],
+		"objectClassName": "entity",
+		"handle": "EXAMPLE-ARIN",
+		"lang": "en",
+		"remarks": [{"description": ["An example."]}],
+		"notices": [{"title": "Terms of Use", "description": ["Service subject to terms of use."]}],
+		"autnums": [{"objectClassName": "autnum", "handle": "AS64496", "startAutnum": 64496, "endAutnum": 64496}]
+	}`), &response)
+
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(response)
+	assert.NoError(t, err)
+
+	var decoded Response
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+
+	assert.Empty(t, decoded.Extensions)
+	assert.Equal(t, response.Lang, decoded.Lang)
+	assert.Equal(t, response.Remarks, decoded.Remarks)
+	assert.Equal(t, response.Notices, decoded.Notices)
+	assert.Equal(t, response.Autnums[0].Handle, decoded.Autnums[0].Handle)
+}
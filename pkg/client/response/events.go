@@ -0,0 +1,176 @@
+package response
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// eventDateLayouts are the layouts an eventDate is parsed with, in order. RFC 9083 requires
+// RFC 3339 dates, but some registries return dates without a time zone (which are assumed to
+// be UTC), with a space in place of the "T", with a numeric offset lacking a colon, or without
+// a time at all.
+var eventDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// parseEventDate parses an eventDate using the first of the layouts which matches it.
+func parseEventDate(value string) (time.Time, bool) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+
+	for _, layout := range eventDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// MalformedDate returns the eventDate exactly as it was returned by the server, when it
+// could not be parsed as a date (in which case Date is zero).
+//
+// A malformed eventDate fails validation, so is only returned by the Client under lenient
+// validation (with a warning) or without validation.
+func (event Event) MalformedDate() (string, bool) {
+	return event.malformedDate, event.malformedDate != ""
+}
+
+// UnmarshalJSON decodes the event, accepting dates which are not in RFC 3339 format (see
+// eventDateLayouts). A date which cannot be parsed is retained (see MalformedDate), rather
+// than failing to decode the event.
+func (event *Event) UnmarshalJSON(data []byte) error {
+	var decoded struct {
+		Action Action  `json:"eventAction"`
+		Actor  *string `json:"eventActor"`
+		Date   *string `json:"eventDate"`
+		Links  []Link  `json:"links"`
+	}
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*event = Event{Action: decoded.Action, Actor: decoded.Actor, Links: decoded.Links}
+
+	if decoded.Date == nil {
+		return nil
+	}
+
+	date, ok := parseEventDate(*decoded.Date)
+
+	if !ok {
+		event.malformedDate = *decoded.Date
+
+		return nil
+	}
+
+	event.Date = date
+
+	return nil
+}
+
+// MarshalJSON encodes the event, with its date in RFC 3339 format. A malformed date is
+// encoded exactly as it was returned by the server, and an event without a date is encoded
+// without one.
+func (event Event) MarshalJSON() ([]byte, error) {
+	var date string
+
+	if malformed, ok := event.MalformedDate(); ok {
+		date = malformed
+	} else if !event.Date.IsZero() {
+		date = event.Date.Format(time.RFC3339Nano)
+	}
+
+	return json.Marshal(struct {
+		Action Action  `json:"eventAction"`
+		Actor  *string `json:"eventActor,omitempty"`
+		Date   string  `json:"eventDate,omitempty"`
+		Links  []Link  `json:"links,omitempty"`
+	}{event.Action, event.Actor, date, event.Links})
+}
+
+// Events is the events of an RDAP object, with accessors for the dates of the actions in the
+// lifecycle of the object.
+//
+// See Section 4.5: https://datatracker.ietf.org/doc/rfc9083/
+type Events []Event
+
+// Find returns the event with the action. If there are several, the latest is returned, with
+// events which have a date taking precedence over those without one.
+func (events Events) Find(action Action) (Event, bool) {
+	var (
+		found Event
+		ok    bool
+	)
+
+	for _, event := range events {
+		if event.Action == action && (!ok || event.Date.After(found.Date)) {
+			found, ok = event, true
+		}
+	}
+
+	return found, ok
+}
+
+// Date returns the date of the event with the action. If there are several, the latest is
+// returned. Events without a date, or with a date which could not be parsed, are ignored.
+func (events Events) Date(action Action) (time.Time, bool) {
+	event, ok := events.Find(action)
+
+	if !ok || event.Date.IsZero() {
+		return time.Time{}, false
+	}
+
+	return event.Date, true
+}
+
+// Registration returns the date the object was registered.
+func (events Events) Registration() (time.Time, bool) {
+	return events.Date(ActionRegistration)
+}
+
+// Expiration returns the date the registration of the object expires.
+func (events Events) Expiration() (time.Time, bool) {
+	return events.Date(ActionExpiration)
+}
+
+// LastChanged returns the date the information of the object was last changed.
+func (events Events) LastChanged() (time.Time, bool) {
+	return events.Date(ActionLastChanged)
+}
+
+// LastUpdateOfRDAPDatabase returns the date the database of the RDAP service was last updated
+// from the registry. It's usually only included in the events of the top-level object.
+func (events Events) LastUpdateOfRDAPDatabase() (time.Time, bool) {
+	return events.Date(ActionLastUpdateOfRDAPDatabase)
+}
+
+// Age returns the time between the registration of the object and now.
+func (events Events) Age(now time.Time) (time.Duration, bool) {
+	registration, ok := events.Registration()
+
+	if !ok {
+		return 0, false
+	}
+
+	return now.Sub(registration), true
+}
+
+// TimeUntilExpiry returns the time between now and the expiry of the registration of the
+// object, which is negative if it has already expired.
+func (events Events) TimeUntilExpiry(now time.Time) (time.Duration, bool) {
+	expiration, ok := events.Expiration()
+
+	if !ok {
+		return 0, false
+	}
+
+	return expiration.Sub(now), true
+}
@@ -0,0 +1,197 @@
+package response
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodingEventDates(t *testing.T) {
+	tests := []struct {
+		date     string
+		expected time.Time
+	}{
+		{"2024-03-01T12:30:45Z", time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC)},
+		{"2024-03-01T12:30:45.123Z", time.Date(2024, 3, 1, 12, 30, 45, 123000000, time.UTC)},
+		{"2024-03-01t12:30:45z", time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC)},
+		{"2024-03-01T13:30:45+01:00", time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC)},
+		{"2024-03-01T13:30:45+0100", time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC)},
+		{"2024-03-01T12:30:45", time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC)},
+		{"2024-03-01 12:30:45Z", time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC)},
+		{"2024-03-01 12:30:45", time.Date(2024, 3, 1, 12, 30, 45, 0, time.UTC)},
+		{" 2024-03-01 ", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.date, func(t *testing.T) {
+			var event Event
+
+			err := json.Unmarshal([]byte(`{"eventAction":"registration","eventDate":"`+test.date+`"}`), &event)
+
+			assert.NoError(t, err)
+			assert.Equal(t, ActionRegistration, event.Action)
+			assert.True(t, test.expected.Equal(event.Date), "expected %s, got %s", test.expected, event.Date)
+		})
+	}
+
+	t.Run("Malformed date", func(t *testing.T) {
+		var event Event
+
+		err := json.Unmarshal([]byte(`{"eventAction":"registration","eventDate":"1st March 2024"}`), &event)
+
+		assert.NoError(t, err)
+		assert.True(t, event.Date.IsZero())
+
+		date, ok := event.MalformedDate()
+		assert.True(t, ok)
+		assert.Equal(t, "1st March 2024", date)
+
+		encoded, err := json.Marshal(event)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"eventAction":"registration","eventDate":"1st March 2024"}`, string(encoded))
+	})
+
+	t.Run("Missing date", func(t *testing.T) {
+		var event Event
+
+		err := json.Unmarshal([]byte(`{"eventAction":"registration"}`), &event)
+
+		assert.NoError(t, err)
+		assert.True(t, event.Date.IsZero())
+
+		_, ok := event.MalformedDate()
+		assert.False(t, ok)
+	})
+}
+
+func TestEncodingEventRoundTrips(t *testing.T) {
+	data := []byte(`{"eventAction":"last changed","eventActor":"REGISTRAR","eventDate":"2024-03-01T12:30:45Z"}`)
+
+	var event Event
+
+	assert.NoError(t, json.Unmarshal(data, &event))
+
+	encoded, err := json.Marshal(event)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(data), string(encoded))
+}
+
+func TestFindingEvents(t *testing.T) {
+	var events Events
+
+	err := json.Unmarshal([]byte(`[
+		{"eventAction": "registration", "eventDate": "1995-08-14T04:00:00Z"},
+		{"eventAction": "last changed", "eventDate": "2023-01-01T00:00:00Z"},
+		{"eventAction": "last changed", "eventDate": "2024-07-08T10:00:00Z"},
+		{"eventAction": "expiration", "eventDate": "2999-08-13T04:00:00Z"},
+		{"eventAction": "last update of RDAP database", "eventDate": "2025-01-02 03:04:05"}
+	]`), &events)
+
+	assert.NoError(t, err)
+
+	registration, ok := events.Registration()
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(1995, 8, 14, 4, 0, 0, 0, time.UTC), registration)
+
+	// The latest of several events with the same action is used.
+	lastChanged, ok := events.LastChanged()
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, 7, 8, 10, 0, 0, 0, time.UTC), lastChanged)
+
+	expiration, ok := events.Expiration()
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2999, 8, 13, 4, 0, 0, 0, time.UTC), expiration)
+
+	lastUpdate, ok := events.LastUpdateOfRDAPDatabase()
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), lastUpdate)
+
+	_, ok = events.Date(ActionTransfer)
+	assert.False(t, ok)
+
+	now := time.Date(2025, 8, 14, 4, 0, 0, 0, time.UTC)
+
+	age, ok := events.Age(now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Sub(registration), age)
+
+	untilExpiry, ok := events.TimeUntilExpiry(now)
+	assert.True(t, ok)
+	assert.Equal(t, expiration.Sub(now), untilExpiry)
+}
+
+func TestEncodingEventWithoutDateOmitsDate(t *testing.T) {
+	data := []byte(`{"eventAction":"last changed","eventActor":"REGISTRAR"}`)
+
+	var event Event
+
+	assert.NoError(t, json.Unmarshal(data, &event))
+
+	encoded, err := json.Marshal(event)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(data), string(encoded))
+}
+
+func TestEventsWithoutUsableDatesAreIgnored(t *testing.T) {
+	now := time.Date(2025, 8, 14, 4, 0, 0, 0, time.UTC)
+
+	for name, data := range map[string]string{
+		"Missing date":   `[{"eventAction": "expiration"}, {"eventAction": "registration"}]`,
+		"Malformed date": `[{"eventAction": "expiration", "eventDate": "garbage"}, {"eventAction": "registration", "eventDate": "garbage"}]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var events Events
+
+			assert.NoError(t, json.Unmarshal([]byte(data), &events))
+
+			_, ok := events.Expiration()
+			assert.False(t, ok)
+
+			_, ok = events.TimeUntilExpiry(now)
+			assert.False(t, ok)
+
+			_, ok = events.Age(now)
+			assert.False(t, ok)
+
+			// The event itself can still be found.
+			event, ok := events.Find(ActionExpiration)
+			assert.True(t, ok)
+			assert.Equal(t, ActionExpiration, event.Action)
+		})
+	}
+
+	t.Run("Dated event takes precedence", func(t *testing.T) {
+		var events Events
+
+		assert.NoError(t, json.Unmarshal([]byte(`[
+			{"eventAction": "expiration", "eventDate": "2999-08-13T04:00:00Z"},
+			{"eventAction": "expiration", "eventDate": "garbage"},
+			{"eventAction": "expiration"}
+		]`), &events))
+
+		expiration, ok := events.Expiration()
+		assert.True(t, ok)
+		assert.Equal(t, time.Date(2999, 8, 13, 4, 0, 0, 0, time.UTC), expiration)
+	})
+}
+
+func TestLifecycleHelpersWithoutEvents(t *testing.T) {
+	var events Events
+
+	_, ok := events.Registration()
+	assert.False(t, ok)
+
+	_, ok = events.Age(time.Now())
+	assert.False(t, ok)
+
+	// An expiration in the past gives a negative duration.
+	events = Events{{Action: ActionExpiration, Date: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}}
+
+	untilExpiry, ok := events.TimeUntilExpiry(time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Negative(t, untilExpiry)
+}
@@ -0,0 +1,87 @@
+// Package addrrange implements the calculations shared by the responses which describe an IP
+// network as a range of addresses, from a start address to an end address.
+package addrrange
+
+import (
+	"math/big"
+	"net/netip"
+)
+
+// valid reports whether start and end form a range of addresses of the same family.
+func valid(start netip.Addr, end netip.Addr) bool {
+	return start.IsValid() && end.IsValid() && start.Is4() == end.Is4() && start.Compare(end) <= 0
+}
+
+// Prefixes returns the minimal set of prefixes which exactly cover the addresses from start
+// to end, in ascending order. Nil is returned if start and end do not form a valid range.
+func Prefixes(start netip.Addr, end netip.Addr) []netip.Prefix {
+	if !valid(start, end) {
+		return nil
+	}
+
+	var prefixes []netip.Prefix
+
+	for current := start; ; {
+		// The largest prefix starting at the current address which ends within the range is
+		// always part of the minimal set.
+		for bits := 0; bits <= current.BitLen(); bits++ {
+			prefix := netip.PrefixFrom(current, bits)
+
+			if prefix.Masked().Addr() != current || Last(prefix).Compare(end) > 0 {
+				continue
+			}
+
+			prefixes = append(prefixes, prefix)
+
+			break
+		}
+
+		last := Last(prefixes[len(prefixes)-1])
+
+		if last == end {
+			return prefixes
+		}
+
+		current = last.Next()
+	}
+}
+
+// Last returns the last address in the prefix.
+func Last(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Masked().Addr()
+	bytes := addr.AsSlice()
+
+	for bit := prefix.Bits(); bit < addr.BitLen(); bit++ {
+		bytes[bit/8] |= 0x80 >> (bit % 8)
+	}
+
+	last, _ := netip.AddrFromSlice(bytes)
+
+	return last
+}
+
+// Contains reports whether the address is within the range from start to end. IPv4-mapped
+// IPv6 addresses are treated as the IPv4 address they map.
+func Contains(start netip.Addr, end netip.Addr, addr netip.Addr) bool {
+	if !valid(start, end) || !addr.IsValid() {
+		return false
+	}
+
+	if start.Is4() {
+		addr = addr.Unmap()
+	}
+
+	return addr.Is4() == start.Is4() && start.Compare(addr) <= 0 && addr.Compare(end) <= 0
+}
+
+// Size returns the number of addresses from start to end, inclusive. Zero is returned if
+// start and end do not form a valid range.
+func Size(start netip.Addr, end netip.Addr) *big.Int {
+	if !valid(start, end) {
+		return new(big.Int)
+	}
+
+	size := new(big.Int).Sub(new(big.Int).SetBytes(end.AsSlice()), new(big.Int).SetBytes(start.AsSlice()))
+
+	return size.Add(size, big.NewInt(1))
+}
@@ -0,0 +1,66 @@
+package addrrange
+
+import (
+	"math/big"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func prefixes(values ...string) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(values))
+
+	for _, value := range values {
+		prefixes = append(prefixes, netip.MustParsePrefix(value))
+	}
+
+	return prefixes
+}
+
+func TestPrefixes(t *testing.T) {
+	tests := []struct {
+		start    string
+		end      string
+		expected []netip.Prefix
+	}{
+		{"8.8.8.0", "8.8.8.255", prefixes("8.8.8.0/24")},
+		{"192.0.2.1", "192.0.2.1", prefixes("192.0.2.1/32")},
+		{"0.0.0.0", "255.255.255.255", prefixes("0.0.0.0/0")},
+		{"192.0.2.1", "192.0.2.14", prefixes("192.0.2.1/32", "192.0.2.2/31", "192.0.2.4/30", "192.0.2.8/30", "192.0.2.12/31", "192.0.2.14/32")},
+		{"10.0.0.0", "10.2.255.255", prefixes("10.0.0.0/15", "10.2.0.0/16")},
+		{"255.255.255.254", "255.255.255.255", prefixes("255.255.255.254/31")},
+		{"2001:db8::", "2001:db8:ffff:ffff:ffff:ffff:ffff:ffff", prefixes("2001:db8::/32")},
+		{"2001:db8::", "2001:db9:7fff:ffff:ffff:ffff:ffff:ffff", prefixes("2001:db8::/32", "2001:db9::/33")},
+		{"::", "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff", prefixes("::/0")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.start+"-"+test.end, func(t *testing.T) {
+			assert.Equal(t, test.expected, Prefixes(netip.MustParseAddr(test.start), netip.MustParseAddr(test.end)))
+		})
+	}
+
+	t.Run("Invalid ranges", func(t *testing.T) {
+		assert.Nil(t, Prefixes(netip.MustParseAddr("192.0.2.255"), netip.MustParseAddr("192.0.2.0")))
+		assert.Nil(t, Prefixes(netip.MustParseAddr("192.0.2.0"), netip.MustParseAddr("2001:db8::")))
+		assert.Nil(t, Prefixes(netip.Addr{}, netip.MustParseAddr("192.0.2.0")))
+	})
+}
+
+func TestContains(t *testing.T) {
+	start, end := netip.MustParseAddr("192.0.2.0"), netip.MustParseAddr("192.0.2.127")
+
+	assert.True(t, Contains(start, end, netip.MustParseAddr("192.0.2.0")))
+	assert.True(t, Contains(start, end, netip.MustParseAddr("192.0.2.127")))
+	assert.True(t, Contains(start, end, netip.MustParseAddr("::ffff:192.0.2.1")))
+	assert.False(t, Contains(start, end, netip.MustParseAddr("192.0.2.128")))
+	assert.False(t, Contains(start, end, netip.MustParseAddr("2001:db8::")))
+	assert.False(t, Contains(start, end, netip.Addr{}))
+}
+
+func TestSize(t *testing.T) {
+	assert.Equal(t, big.NewInt(256), Size(netip.MustParseAddr("8.8.8.0"), netip.MustParseAddr("8.8.8.255")))
+	assert.Equal(t, new(big.Int).Lsh(big.NewInt(1), 128), Size(netip.MustParseAddr("::"), netip.MustParseAddr("ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff")))
+	assert.Equal(t, new(big.Int), Size(netip.MustParseAddr("8.8.8.255"), netip.MustParseAddr("8.8.8.0")))
+}
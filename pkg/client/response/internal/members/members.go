@@ -0,0 +1,163 @@
+// Package members decodes and encodes RDAP objects while preserving the members which are
+// not modelled by a response type, such as registry-specific extensions.
+package members
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// known caches the lowercase names of the members modelled by each struct type.
+var known sync.Map
+
+// Decode decodes the RDAP object into v, which must be a pointer to a struct without a
+// custom UnmarshalJSON method, and returns the members of the object which do not match any
+// field of the struct, or nil if there are none.
+func Decode(data []byte, v any) (map[string]json.RawMessage, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	var object map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	names := names(reflect.TypeOf(v).Elem())
+
+	var extensions map[string]json.RawMessage
+
+	for name, value := range object {
+		if _, ok := names[strings.ToLower(name)]; ok {
+			continue
+		}
+
+		if extensions == nil {
+			extensions = make(map[string]json.RawMessage)
+		}
+
+		extensions[name] = value
+	}
+
+	return extensions, nil
+}
+
+// Unmarshal decodes the RDAP object into v, as Decode, storing the members which are not
+// modelled by the struct in extensions. It's used to implement the UnmarshalJSON method of
+// each response type.
+func Unmarshal(data []byte, v any, extensions *map[string]json.RawMessage) error {
+	decoded, err := Decode(data, v)
+
+	if err != nil {
+		return err
+	}
+
+	*extensions = decoded
+
+	return nil
+}
+
+// Encode encodes v, which must be a struct without a custom MarshalJSON method, as a JSON
+// object and appends the extension members, ordered by name. Extension members which
+// collide with a member modelled by the struct are skipped.
+func Encode(v any, extensions map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+
+	if err != nil || len(extensions) == 0 {
+		return data, err
+	}
+
+	names := names(reflect.TypeOf(v))
+
+	var buffer bytes.Buffer
+	buffer.Write(data[:len(data)-1])
+
+	empty := len(bytes.TrimSpace(data[1:len(data)-1])) == 0
+
+	for _, name := range slices.Sorted(maps.Keys(extensions)) {
+		if _, ok := names[strings.ToLower(name)]; ok {
+			continue
+		}
+
+		key, err := json.Marshal(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		value := extensions[name]
+
+		if len(value) == 0 {
+			value = json.RawMessage("null")
+		}
+
+		if !empty {
+			buffer.WriteByte(',')
+		}
+
+		empty = false
+
+		buffer.Write(key)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
+
+// names returns the lowercase names of the members modelled by the struct type, including
+// those of any embedded structs.
+func names(t reflect.Type) map[string]struct{} {
+	if cached, ok := known.Load(t); ok {
+		return cached.(map[string]struct{})
+	}
+
+	found := make(map[string]struct{})
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		name, _, _ := strings.Cut(tag, ",")
+
+		if name == "-" && tag == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				for name := range names(embedded) {
+					found[name] = struct{}{}
+				}
+
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		found[strings.ToLower(name)] = struct{}{}
+	}
+
+	known.Store(t, found)
+
+	return found
+}
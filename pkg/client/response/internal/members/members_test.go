@@ -0,0 +1,140 @@
+package members
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type embedded struct {
+	Handle string `json:"handle"`
+}
+
+type object struct {
+	embedded
+
+	ObjectType string `json:"objectClassName"`
+	Port43     string `json:"port43,omitempty"`
+	Untagged   string
+	Ignored    string `json:"-"`
+}
+
+func TestDecodingUnmodelledMembers(t *testing.T) {
+	var decoded object
+
+	extensions, err := Decode([]byte(`{
+		"objectClassName": "entity",
+		"handle": "XXXX",
+		"untagged": "value",
+		"Ignored": "value",
+		"fred_auth_info": {"value": 1},
+		"arin_originas0_originautnums": [15169]
+	}`), &decoded)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "entity", decoded.ObjectType)
+	assert.Equal(t, "XXXX", decoded.Handle)
+	assert.Equal(t, "value", decoded.Untagged)
+	assert.Empty(t, decoded.Ignored)
+
+	assert.Len(t, extensions, 3)
+	assert.JSONEq(t, `{"value": 1}`, string(extensions["fred_auth_info"]))
+	assert.JSONEq(t, `[15169]`, string(extensions["arin_originas0_originautnums"]))
+	assert.JSONEq(t, `"value"`, string(extensions["Ignored"]))
+}
+
+func TestDecodingObjectWithoutUnmodelledMembers(t *testing.T) {
+	var decoded object
+
+	extensions, err := Decode([]byte(`{"objectClassName": "entity", "handle": "XXXX"}`), &decoded)
+
+	assert.NoError(t, err)
+	assert.Nil(t, extensions)
+}
+
+func TestDecodingMatchesMemberNamesCaseInsensitively(t *testing.T) {
+	var decoded object
+
+	extensions, err := Decode([]byte(`{"OBJECTCLASSNAME": "entity", "Handle": "XXXX"}`), &decoded)
+
+	assert.NoError(t, err)
+	assert.Nil(t, extensions)
+	assert.Equal(t, "entity", decoded.ObjectType)
+	assert.Equal(t, "XXXX", decoded.Handle)
+}
+
+func TestDecodingMembersWithNestedAndEscapedValues(t *testing.T) {
+	var decoded object
+
+	extensions, err := Decode([]byte(` {
+		"handle" : "a \"quoted\" }, handle" ,
+		"nested": {"array": [1, {"b": "]"}], "number": -1.5e3},
+		"esc\u0061ped": [true, false, null],
+		"number":12
+	} `), &decoded)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `a "quoted" }, handle`, decoded.Handle)
+
+	assert.Len(t, extensions, 3)
+	assert.JSONEq(t, `{"array": [1, {"b": "]"}], "number": -1.5e3}`, string(extensions["nested"]))
+	assert.JSONEq(t, `[true, false, null]`, string(extensions["escaped"]))
+	assert.Equal(t, `12`, string(extensions["number"]))
+}
+
+func TestDecodingNullLeavesObjectUnchanged(t *testing.T) {
+	decoded := object{ObjectType: "entity"}
+
+	extensions, err := Decode([]byte(`null`), &decoded)
+
+	assert.NoError(t, err)
+	assert.Nil(t, extensions)
+	assert.Equal(t, "entity", decoded.ObjectType)
+}
+
+func TestDecodingInvalidObjectReturnsAnError(t *testing.T) {
+	var decoded object
+
+	for _, data := range []string{`["not", "an", "object"]`, `{"handle": "XXXX"`, `{"handle" "XXXX"}`, `{handle: "XXXX"}`, `{"handle": "XXXX";}`} {
+		_, err := Decode([]byte(data), &decoded)
+
+		assert.Error(t, err, "Data %s", data)
+	}
+}
+
+func TestEncodingUnmodelledMembers(t *testing.T) {
+	t.Run("Ordered by name", func(t *testing.T) {
+		data, err := Encode(object{ObjectType: "entity"}, map[string]json.RawMessage{
+			"zzz_extension": json.RawMessage(`true`),
+			"aaa_extension": json.RawMessage(`{"nested": [1, 2]}`),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, `{"handle":"","objectClassName":"entity","Untagged":"","aaa_extension":{"nested": [1, 2]},"zzz_extension":true}`, string(data))
+	})
+
+	t.Run("Colliding with modelled members", func(t *testing.T) {
+		data, err := Encode(object{ObjectType: "entity"}, map[string]json.RawMessage{
+			"objectClassName": json.RawMessage(`"domain"`),
+			"PORT43":          json.RawMessage(`"whois.example.com"`),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, `{"handle":"","objectClassName":"entity","Untagged":""}`, string(data))
+	})
+
+	t.Run("Without unmodelled members", func(t *testing.T) {
+		data, err := Encode(embedded{Handle: "XXXX"}, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, `{"handle":"XXXX"}`, string(data))
+	})
+
+	t.Run("Into an empty object", func(t *testing.T) {
+		data, err := Encode(struct{}{}, map[string]json.RawMessage{"extension": json.RawMessage(`1`)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, `{"extension":1}`, string(data))
+	})
+}
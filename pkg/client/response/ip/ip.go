@@ -0,0 +1,9 @@
+package ip
+
+import "github.com/ryanmab/rdap-go/pkg/client/response"
+
+// Response represents an RDAP ip network object of either address family. Exactly one of
+// IPv4 or IPv6 is set. It's the same type as the ip networks held by entities.
+//
+// See Section 5.4: https://datatracker.ietf.org/doc/rfc9083/
+type Response = response.IPNetwork
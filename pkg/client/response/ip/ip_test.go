@@ -0,0 +1,141 @@
+package ip
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodingIPNetworkOfEitherAddressFamily(t *testing.T) {
+	t.Run("IPv4", func(t *testing.T) {
+		var response Response
+
+		err := json.Unmarshal([]byte(`{"objectClassName":"ip network","ipVersion":"v4","startAddress":"192.0.2.0","endAddress":"192.0.2.255"}`), &response)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response.IPv4)
+		assert.Nil(t, response.IPv6)
+		assert.Equal(t, netip.MustParseAddr("192.0.2.0"), response.StartAddr())
+		assert.Equal(t, netip.MustParseAddr("192.0.2.255"), response.EndAddr())
+	})
+
+	t.Run("IPv6", func(t *testing.T) {
+		var response Response
+
+		err := json.Unmarshal([]byte(`{"objectClassName":"ip network","ipVersion":"v6","startAddress":"2001:db8::","endAddress":"2001:db8:ffff:ffff:ffff:ffff:ffff:ffff"}`), &response)
+
+		assert.NoError(t, err)
+		assert.Nil(t, response.IPv4)
+		assert.NotNil(t, response.IPv6)
+		assert.Equal(t, netip.MustParseAddr("2001:db8::"), response.StartAddr())
+		assert.Equal(t, netip.MustParseAddr("2001:db8:ffff:ffff:ffff:ffff:ffff:ffff"), response.EndAddr())
+	})
+
+	t.Run("Invalid version", func(t *testing.T) {
+		var response Response
+
+		err := json.Unmarshal([]byte(`{"objectClassName":"ip network","ipVersion":"v5","startAddress":"2001:db8::"}`), &response)
+
+		assert.NoError(t, err)
+		assert.Nil(t, response.IPv4)
+		assert.NotNil(t, response.IPv6)
+		assert.Equal(t, "v5", response.IPv6.IPVersion)
+	})
+
+	t.Run("Missing version", func(t *testing.T) {
+		var response Response
+
+		err := json.Unmarshal([]byte(`{"objectClassName":"ip network","startAddress":"192.0.2.0"}`), &response)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response.IPv4)
+		assert.Nil(t, response.IPv6)
+		assert.Equal(t, netip.MustParseAddr("192.0.2.0"), response.StartAddr())
+	})
+
+	t.Run("Unknown address family", func(t *testing.T) {
+		var response Response
+
+		err := json.Unmarshal([]byte(`{"objectClassName":"ip network","ipVersion":"v5"}`), &response)
+
+		assert.NoError(t, err)
+		assert.Nil(t, response.IPv4)
+		assert.Nil(t, response.IPv6)
+	})
+}
+
+func TestEncodingIPNetworkRoundTrips(t *testing.T) {
+	var response Response
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"objectClassName":"ip network","ipVersion":"v4","startAddress":"192.0.2.0","endAddress":"192.0.2.255"}`), &response))
+
+	data, err := json.Marshal(response)
+	assert.NoError(t, err)
+
+	var decoded Response
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+
+	assert.Equal(t, response, decoded)
+}
+
+func TestUnsetIPNetworkHasNoBounds(t *testing.T) {
+	var response Response
+
+	assert.False(t, response.StartAddr().IsValid())
+	assert.False(t, response.EndAddr().IsValid())
+}
+
+func TestDecodingCIDR0Prefixes(t *testing.T) {
+	var response Response
+
+	err := json.Unmarshal([]byte(`{"objectClassName":"ip network","ipVersion":"v6","startAddress":"2001:db8::","endAddress":"2001:db8:ffff:ffff:ffff:ffff:ffff:ffff","cidr0_cidrs":[{"v6prefix":"2001:db8::","length":32}]}`), &response)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.IPv6.CIDRs, 1)
+	assert.Equal(t, netip.MustParsePrefix("2001:db8::/32"), response.IPv6.CIDRs[0].Prefix())
+	assert.Empty(t, response.IPv6.Extensions)
+}
+
+func TestCalculatingPrefixesOfIPNetwork(t *testing.T) {
+	t.Run("IPv4", func(t *testing.T) {
+		var response Response
+
+		// The network does not align to a single prefix, and has no cidr0_cidrs member.
+		assert.NoError(t, json.Unmarshal([]byte(`{"objectClassName":"ip network","ipVersion":"v4","startAddress":"192.0.2.0","endAddress":"192.0.3.127"}`), &response))
+
+		assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24"), netip.MustParsePrefix("192.0.3.0/25")}, response.Prefixes())
+		assert.Equal(t, uint64(384), response.IPv4.Size())
+		assert.Equal(t, big.NewInt(384), response.Size())
+		assert.True(t, response.Contains(netip.MustParseAddr("192.0.3.127")))
+		assert.False(t, response.Contains(netip.MustParseAddr("192.0.3.128")))
+	})
+
+	t.Run("IPv6", func(t *testing.T) {
+		var response Response
+
+		assert.NoError(t, json.Unmarshal([]byte(`{"objectClassName":"ip network","ipVersion":"v6","startAddress":"2001:db8::","endAddress":"2001:db8:ffff:ffff:ffff:ffff:ffff:ffff"}`), &response))
+
+		assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("2001:db8::/32")}, response.Prefixes())
+		assert.Equal(t, new(big.Int).Lsh(big.NewInt(1), 96), response.Size())
+		assert.True(t, response.Contains(netip.MustParseAddr("2001:db8::1")))
+		assert.False(t, response.Contains(netip.MustParseAddr("192.0.2.1")))
+	})
+}
+
+func TestEventsOfIPNetwork(t *testing.T) {
+	var response Response
+
+	err := json.Unmarshal([]byte(`{"objectClassName":"ip network","ipVersion":"v6","startAddress":"2001:db8::","endAddress":"2001:db8::ffff","events":[{"eventAction":"registration","eventDate":"2010-05-06"}]}`), &response)
+
+	assert.NoError(t, err)
+
+	registration, ok := response.Events().Registration()
+
+	assert.True(t, ok)
+	assert.Equal(t, 2010, registration.Year())
+
+	assert.Nil(t, (&Response{}).Events())
+}
@@ -2,29 +2,13 @@ package ipv6
 
 import "github.com/ryanmab/rdap-go/pkg/client/response"
 
-// Response represents the RDAP response structure for ipv6 queries.
+// Response represents the RDAP response structure for ipv6 queries. It's the same type as
+// the IPv6 networks held by entities.
 //
 // See: https://datatracker.ietf.org/doc/rfc9083/
-type Response struct {
-	// An array of strings each providing a hint as to the
-	// specifications used in the construction of the
-	Conformance []string `json:"rdapConformance" validate:"dive,required"`
+type Response = response.IPv6Network
 
-	ObjectType   string `json:"objectClassName" validate:"required,eq=ip network"`
-	Handle       string `json:"handle" validate:"required"`
-	Name         string `json:"name" validate:"required"`
-	Type         string `json:"type" validate:"required"`
-	ParentHandle string `json:"parentHandle,omitempty" validate:"omitempty,required"`
-	Country      string `json:"country,omitempty" validate:"omitempty,required,len=2"`
-
-	StartAddress string `json:"startAddress" validate:"required,ipv6"`
-	EndAddress   string `json:"endAddress" validate:"required,ipv6"`
-	IPVersion    string `json:"ipVersion" validate:"required,eq=v6"`
-
-	Events []response.Event  `json:"events" validate:"dive,required"`
-	Status []response.Status `json:"status" validate:"dive,required"`
-
-	Entities []response.Entity `json:"entities,omitempty" validate:"dive,required"`
-
-	Links []response.Link `json:"links,omitempty" validate:"dive,required"`
-}
+// CIDR is a prefix of the network, as described by the cidr0 extension.
+//
+// See: https://bitbucket.org/nroecg/nro-rdap-cidr/src/master/nro-rdap-cidr.txt
+type CIDR = response.IPv6CIDR
@@ -0,0 +1,418 @@
+package response
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/netip"
+
+	"github.com/ryanmab/rdap-go/pkg/client/response/internal/addrrange"
+	"github.com/ryanmab/rdap-go/pkg/client/response/internal/members"
+)
+
+// IPv4Network represents the RDAP specification's ip network object for IPv4 networks. It's
+// both the response to ipv4 queries (see ipv4.Response) and the type of the IPv4 networks
+// held by entities.
+//
+// See Section 5.4: https://datatracker.ietf.org/doc/rfc9083/
+type IPv4Network struct {
+	// An array of strings each providing a hint as to the
+	// specifications used in the construction of the
+	Conformance []string `json:"rdapConformance" validate:"dive,required"`
+
+	ObjectType   string `json:"objectClassName" validate:"required,eq=ip network"`
+	Handle       string `json:"handle" validate:"required"`
+	Name         string `json:"name" validate:"required"`
+	Type         string `json:"type" validate:"required"`
+	ParentHandle string `json:"parentHandle,omitempty" validate:"omitempty,required"`
+	Country      string `json:"country,omitempty" validate:"omitempty,required,len=2"`
+
+	StartAddress string `json:"startAddress" validate:"required,ipv4"`
+	EndAddress   string `json:"endAddress" validate:"required,ipv4"`
+	IPVersion    string `json:"ipVersion" validate:"required,eq=v4"`
+
+	// CIDRs is the network expressed as prefixes, when the server supports the cidr0
+	// extension.
+	CIDRs []IPv4CIDR `json:"cidr0_cidrs,omitempty" validate:"dive"`
+
+	// OriginAutnums is the autonomous system numbers which originate routes for the network,
+	// when the server supports the arin_originas0 extension.
+	OriginAutnums []uint32 `json:"arin_originas0_originautnums,omitempty"`
+
+	Events Events   `json:"events" validate:"dive,required"`
+	Status []Status `json:"status" validate:"dive,required"`
+
+	Entities []Entity `json:"entities,omitempty" validate:"dive,required"`
+
+	Links []Link `json:"links,omitempty" validate:"dive,required"`
+
+	Remarks []Notice `json:"remarks,omitempty" validate:"dive"`
+	Notices []Notice `json:"notices,omitempty" validate:"dive"`
+
+	// The host of the WHOIS (port 43) service of the registry
+	WhoisURI *string `json:"port43,omitempty" validate:"omitempty"`
+
+	// The language of the text of the response (i.e. en)
+	Lang string `json:"lang,omitempty"`
+
+	// Raw is the RDAP object exactly as it was returned by the server. It's only set on the
+	// responses returned by the Client, not on the objects they hold.
+	Raw json.RawMessage `json:"-"`
+
+	// Extensions holds the top-level members of the object which are not modelled by the
+	// response (i.e. registry-specific extensions), keyed by member name.
+	Extensions map[string]json.RawMessage `json:"-"`
+
+	// Warnings lists the members of the object which failed validation, when the response
+	// was decoded using lenient validation.
+	Warnings []ValidationWarning `json:"-"`
+}
+
+// IPv4CIDR is a prefix of an IPv4 network, as described by the cidr0 extension.
+//
+// See: https://bitbucket.org/nroecg/nro-rdap-cidr/src/master/nro-rdap-cidr.txt
+type IPv4CIDR struct {
+	V4Prefix string `json:"v4prefix" validate:"required,ipv4"`
+	Length   int    `json:"length" validate:"min=0,max=32"`
+}
+
+// Prefix returns the prefix, or the zero Prefix if it could not be parsed.
+func (cidr IPv4CIDR) Prefix() netip.Prefix {
+	return parsePrefix(cidr.V4Prefix, cidr.Length)
+}
+
+// StartAddr returns the first address in the network, or the zero Addr if the start address
+// could not be parsed.
+func (response *IPv4Network) StartAddr() netip.Addr {
+	addr, _ := netip.ParseAddr(response.StartAddress)
+
+	return addr
+}
+
+// EndAddr returns the last address in the network, or the zero Addr if the end address could
+// not be parsed.
+func (response *IPv4Network) EndAddr() netip.Addr {
+	addr, _ := netip.ParseAddr(response.EndAddress)
+
+	return addr
+}
+
+// Prefixes returns the minimal set of prefixes which exactly cover the network, from
+// StartAddress to EndAddress, in ascending order. The prefixes are calculated from the range
+// of the network, so are available even when the server does not support the cidr0
+// extension. Nil is returned if the range could not be parsed.
+func (response *IPv4Network) Prefixes() []netip.Prefix {
+	return addrrange.Prefixes(response.StartAddr(), response.EndAddr())
+}
+
+// Contains reports whether the address is within the network.
+func (response *IPv4Network) Contains(addr netip.Addr) bool {
+	return addrrange.Contains(response.StartAddr(), response.EndAddr(), addr)
+}
+
+// Size returns the number of addresses in the network, or zero if the range could not be
+// parsed.
+func (response *IPv4Network) Size() uint64 {
+	return addrrange.Size(response.StartAddr(), response.EndAddr()).Uint64()
+}
+
+// UnmarshalJSON decodes the RDAP object, retaining any members which are not modelled by the
+// response.
+func (response *IPv4Network) UnmarshalJSON(data []byte) error {
+	type plain IPv4Network
+
+	return members.Unmarshal(data, (*plain)(response), &response.Extensions)
+}
+
+// MarshalJSON encodes the RDAP object, including any members which are not modelled by the
+// response.
+func (response IPv4Network) MarshalJSON() ([]byte, error) {
+	type plain IPv4Network
+
+	return members.Encode(plain(response), response.Extensions)
+}
+
+// IPv6Network represents the RDAP specification's ip network object for IPv6 networks. It's
+// both the response to ipv6 queries (see ipv6.Response) and the type of the IPv6 networks
+// held by entities.
+//
+// See Section 5.4: https://datatracker.ietf.org/doc/rfc9083/
+type IPv6Network struct {
+	// An array of strings each providing a hint as to the
+	// specifications used in the construction of the
+	Conformance []string `json:"rdapConformance" validate:"dive,required"`
+
+	ObjectType   string `json:"objectClassName" validate:"required,eq=ip network"`
+	Handle       string `json:"handle" validate:"required"`
+	Name         string `json:"name" validate:"required"`
+	Type         string `json:"type" validate:"required"`
+	ParentHandle string `json:"parentHandle,omitempty" validate:"omitempty,required"`
+	Country      string `json:"country,omitempty" validate:"omitempty,required,len=2"`
+
+	StartAddress string `json:"startAddress" validate:"required,ipv6"`
+	EndAddress   string `json:"endAddress" validate:"required,ipv6"`
+	IPVersion    string `json:"ipVersion" validate:"required,eq=v6"`
+
+	// CIDRs is the network expressed as prefixes, when the server supports the cidr0
+	// extension.
+	CIDRs []IPv6CIDR `json:"cidr0_cidrs,omitempty" validate:"dive"`
+
+	// OriginAutnums is the autonomous system numbers which originate routes for the network,
+	// when the server supports the arin_originas0 extension.
+	OriginAutnums []uint32 `json:"arin_originas0_originautnums,omitempty"`
+
+	Events Events   `json:"events" validate:"dive,required"`
+	Status []Status `json:"status" validate:"dive,required"`
+
+	Entities []Entity `json:"entities,omitempty" validate:"dive,required"`
+
+	Links []Link `json:"links,omitempty" validate:"dive,required"`
+
+	Remarks []Notice `json:"remarks,omitempty" validate:"dive"`
+	Notices []Notice `json:"notices,omitempty" validate:"dive"`
+
+	// The host of the WHOIS (port 43) service of the registry
+	WhoisURI *string `json:"port43,omitempty" validate:"omitempty"`
+
+	// The language of the text of the response (i.e. en)
+	Lang string `json:"lang,omitempty"`
+
+	// Raw is the RDAP object exactly as it was returned by the server. It's only set on the
+	// responses returned by the Client, not on the objects they hold.
+	Raw json.RawMessage `json:"-"`
+
+	// Extensions holds the top-level members of the object which are not modelled by the
+	// response (i.e. registry-specific extensions), keyed by member name.
+	Extensions map[string]json.RawMessage `json:"-"`
+
+	// Warnings lists the members of the object which failed validation, when the response
+	// was decoded using lenient validation.
+	Warnings []ValidationWarning `json:"-"`
+}
+
+// IPv6CIDR is a prefix of an IPv6 network, as described by the cidr0 extension.
+//
+// See: https://bitbucket.org/nroecg/nro-rdap-cidr/src/master/nro-rdap-cidr.txt
+type IPv6CIDR struct {
+	V6Prefix string `json:"v6prefix" validate:"required,ipv6"`
+	Length   int    `json:"length" validate:"min=0,max=128"`
+}
+
+// Prefix returns the prefix, or the zero Prefix if it could not be parsed.
+func (cidr IPv6CIDR) Prefix() netip.Prefix {
+	return parsePrefix(cidr.V6Prefix, cidr.Length)
+}
+
+// StartAddr returns the first address in the network, or the zero Addr if the start address
+// could not be parsed.
+func (response *IPv6Network) StartAddr() netip.Addr {
+	addr, _ := netip.ParseAddr(response.StartAddress)
+
+	return addr
+}
+
+// EndAddr returns the last address in the network, or the zero Addr if the end address could
+// not be parsed.
+func (response *IPv6Network) EndAddr() netip.Addr {
+	addr, _ := netip.ParseAddr(response.EndAddress)
+
+	return addr
+}
+
+// Prefixes returns the minimal set of prefixes which exactly cover the network, from
+// StartAddress to EndAddress, in ascending order. The prefixes are calculated from the range
+// of the network, so are available even when the server does not support the cidr0
+// extension. Nil is returned if the range could not be parsed.
+func (response *IPv6Network) Prefixes() []netip.Prefix {
+	return addrrange.Prefixes(response.StartAddr(), response.EndAddr())
+}
+
+// Contains reports whether the address is within the network.
+func (response *IPv6Network) Contains(addr netip.Addr) bool {
+	return addrrange.Contains(response.StartAddr(), response.EndAddr(), addr)
+}
+
+// Size returns the number of addresses in the network, or zero if the range could not be
+// parsed. The size of an IPv6 network may not fit in 64 bits, so is returned as a big.Int.
+func (response *IPv6Network) Size() *big.Int {
+	return addrrange.Size(response.StartAddr(), response.EndAddr())
+}
+
+// UnmarshalJSON decodes the RDAP object, retaining any members which are not modelled by the
+// response.
+func (response *IPv6Network) UnmarshalJSON(data []byte) error {
+	type plain IPv6Network
+
+	return members.Unmarshal(data, (*plain)(response), &response.Extensions)
+}
+
+// MarshalJSON encodes the RDAP object, including any members which are not modelled by the
+// response.
+func (response IPv6Network) MarshalJSON() ([]byte, error) {
+	type plain IPv6Network
+
+	return members.Encode(plain(response), response.Extensions)
+}
+
+// parsePrefix returns the prefix of the address with the length, or the zero Prefix if it
+// could not be parsed.
+func parsePrefix(address string, length int) netip.Prefix {
+	addr, err := netip.ParseAddr(address)
+
+	if err != nil {
+		return netip.Prefix{}
+	}
+
+	prefix, err := addr.Prefix(length)
+
+	if err != nil {
+		return netip.Prefix{}
+	}
+
+	return prefix
+}
+
+// IPNetwork represents an RDAP ip network object of either address family (see ip.Response).
+// Exactly one of IPv4 or IPv6 is set.
+//
+// See Section 5.4: https://datatracker.ietf.org/doc/rfc9083/
+type IPNetwork struct {
+	IPv4 *IPv4Network
+	IPv6 *IPv6Network
+}
+
+// StartAddr returns the first address in the network.
+func (response *IPNetwork) StartAddr() netip.Addr {
+	switch {
+	case response.IPv4 != nil:
+		return response.IPv4.StartAddr()
+	case response.IPv6 != nil:
+		return response.IPv6.StartAddr()
+	default:
+		return netip.Addr{}
+	}
+}
+
+// EndAddr returns the last address in the network.
+func (response *IPNetwork) EndAddr() netip.Addr {
+	switch {
+	case response.IPv4 != nil:
+		return response.IPv4.EndAddr()
+	case response.IPv6 != nil:
+		return response.IPv6.EndAddr()
+	default:
+		return netip.Addr{}
+	}
+}
+
+// UnmarshalJSON decodes an ip network object into the response type matching its address
+// family, using the ipVersion member.
+//
+// If the ipVersion is missing or invalid, the address family is inferred from the
+// startAddress instead (and the ipVersion fails validation). If neither identifies the
+// address family, neither IPv4 nor IPv6 is set, which also fails validation.
+func (response *IPNetwork) UnmarshalJSON(data []byte) error {
+	var probe struct {
+		IPVersion    string `json:"ipVersion"`
+		StartAddress string `json:"startAddress"`
+	}
+
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+
+	version := probe.IPVersion
+
+	if version != "v4" && version != "v6" {
+		if addr, err := netip.ParseAddr(probe.StartAddress); err == nil && addr.Is4() {
+			version = "v4"
+		} else if err == nil {
+			version = "v6"
+		}
+	}
+
+	switch version {
+	case "v4":
+		response.IPv4, response.IPv6 = &IPv4Network{}, nil
+		return json.Unmarshal(data, response.IPv4)
+	case "v6":
+		response.IPv4, response.IPv6 = nil, &IPv6Network{}
+		return json.Unmarshal(data, response.IPv6)
+	default:
+		response.IPv4, response.IPv6 = nil, nil
+		return nil
+	}
+}
+
+// MarshalJSON encodes the ip network object of whichever address family is set.
+func (response IPNetwork) MarshalJSON() ([]byte, error) {
+	switch {
+	case response.IPv4 != nil:
+		return json.Marshal(response.IPv4)
+	case response.IPv6 != nil:
+		return json.Marshal(response.IPv6)
+	default:
+		return []byte("null"), nil
+	}
+}
+
+// Prefixes returns the minimal set of prefixes which exactly cover the network, in ascending
+// order.
+func (response *IPNetwork) Prefixes() []netip.Prefix {
+	switch {
+	case response.IPv4 != nil:
+		return response.IPv4.Prefixes()
+	case response.IPv6 != nil:
+		return response.IPv6.Prefixes()
+	default:
+		return nil
+	}
+}
+
+// Contains reports whether the address is within the network.
+func (response *IPNetwork) Contains(addr netip.Addr) bool {
+	switch {
+	case response.IPv4 != nil:
+		return response.IPv4.Contains(addr)
+	case response.IPv6 != nil:
+		return response.IPv6.Contains(addr)
+	default:
+		return false
+	}
+}
+
+// Size returns the number of addresses in the network.
+func (response *IPNetwork) Size() *big.Int {
+	switch {
+	case response.IPv4 != nil:
+		return new(big.Int).SetUint64(response.IPv4.Size())
+	case response.IPv6 != nil:
+		return response.IPv6.Size()
+	default:
+		return new(big.Int)
+	}
+}
+
+// OriginAutnums returns the autonomous system numbers which originate routes for the network,
+// when the server supports the arin_originas0 extension.
+func (response *IPNetwork) OriginAutnums() []uint32 {
+	switch {
+	case response.IPv4 != nil:
+		return response.IPv4.OriginAutnums
+	case response.IPv6 != nil:
+		return response.IPv6.OriginAutnums
+	default:
+		return nil
+	}
+}
+
+// Events returns the events of the network (i.e. its registration and last changed dates).
+func (response *IPNetwork) Events() Events {
+	switch {
+	case response.IPv4 != nil:
+		return response.IPv4.Events
+	case response.IPv6 != nil:
+		return response.IPv6.Events
+	default:
+		return nil
+	}
+}
@@ -1,6 +1,9 @@
 package response
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // Status represents the RDAP specification's status of the Domain.
 //
@@ -119,17 +122,35 @@ const (
 	ActionDeletion Action = "deletion"
 
 	// ActionReinstation signifies the reinstation of the object instance's registration.
+	//
+	// Deprecated: the action is registered as "reinstantiation", use ActionReinstantiation.
 	ActionReinstation Action = "reinstation"
 
+	// ActionReinstantiation signifies the reinstantiation of the object instance's
+	// registration.
+	ActionReinstantiation Action = "reinstantiation"
+
 	// ActionTransfer signifies the transfer of the object instance's registration from
 	// one registrar to another.
 	ActionTransfer Action = "transfer"
 
-	//ActionLocked signifies that the object instance has been locked.
+	// ActionLocked signifies that the object instance has been locked.
 	ActionLocked Action = "locked"
 
 	// ActionUnlocked signifies that the object instance has been unlocked.
 	ActionUnlocked Action = "unlocked"
+
+	// ActionLastUpdateOfRDAPDatabase signifies the last date and time the database used by
+	// the RDAP service was updated from the registry.
+	ActionLastUpdateOfRDAPDatabase Action = "last update of RDAP database"
+
+	// ActionRegistrarExpiration signifies the expiration of the object instance's
+	// registration with the registrar, which may differ from the expiration with the registry.
+	ActionRegistrarExpiration Action = "registrar expiration"
+
+	// ActionEnumValidationExpiration signifies the expiration of the object instance's ENUM
+	// validation.
+	ActionEnumValidationExpiration Action = "enum validation expiration"
 )
 
 // Event represents the RDAP specification's event object.
@@ -138,7 +159,11 @@ const (
 type Event struct {
 	Action Action    `json:"eventAction" validate:"required"`
 	Actor  *string   `json:"eventActor,omitempty"`
-	Date   time.Time `json:"eventDate,format:datetime"`
+	Date   time.Time `json:"eventDate"`
+	Links  []Link    `json:"links,omitempty" validate:"dive,required"`
+
+	// malformedDate is the eventDate returned by the server, when it could not be parsed.
+	malformedDate string
 }
 
 // Nameserver represents the RDAP specification's nameserver object.
@@ -149,40 +174,88 @@ type Nameserver struct {
 	Handle      *string  `json:"handle,omitempty"`
 	LdhName     string   `json:"ldhName" validate:"required"`
 	UnicodeName *string  `json:"unicodeName,omitempty"`
-	Events      []Event  `json:"events,omitempty" validate:"dive,required"`
+	Events      Events   `json:"events,omitempty" validate:"dive,required"`
 	Status      []Status `json:"status,omitempty" validate:"dive,required"`
 	IPAddresses struct {
 		V4 []string `json:"v4,omitempty" validate:"dive,ipv4"`
 		V6 []string `json:"v6,omitempty" validate:"dive,ipv6"`
 	} `json:"ipAddresses"`
+	Entities []Entity `json:"entities,omitempty" validate:"dive,required"`
+	WhoisURI *string  `json:"port43,omitempty" validate:"omitempty"`
+	Lang     string   `json:"lang,omitempty"`
+	Remarks  []Notice `json:"remarks,omitempty" validate:"dive"`
+	Links    []Link   `json:"links,omitempty" validate:"dive,required"`
 }
 
 // Entity represents the RDAP specification's entity object.
 //
 // See Section 5.1: https://datatracker.ietf.org/doc/rfc9083/
 type Entity struct {
-	ObjectType string   `json:"objectClassName" validate:"required,eq=entity"`
-	Handle     string   `json:"handle"`
-	VCardArray any      `json:"vcardArray" validate:"required"`
-	Roles      []string `json:"roles,omitempty" validate:"dive,required"`
-	PublicIds  []struct {
-		Type       string `json:"type" validate:"required"`
-		Identifier string `json:"identifier" validate:"required"`
-	} `json:"publicIds,omitempty" validate:"dive,required"`
-	Events       []Event  `json:"events,omitempty" validate:"dive,required"`
-	Entities     []Entity `json:"entities,omitempty" validate:"dive,required"`
-	AsEventActor []Event  `json:"asEventActor,omitempty" validate:"dive,required"`
-	Status       []Status `json:"status,omitempty" validate:"dive,required"`
-	WhoisURI     *string  `json:"port43,omitempty" validate:"omitempty"`
-	Links        []Link   `json:"links,omitempty" validate:"dive,required"`
+	ObjectType   string     `json:"objectClassName" validate:"required,eq=entity"`
+	Handle       string     `json:"handle"`
+	VCardArray   any        `json:"vcardArray" validate:"required"`
+	Roles        []string   `json:"roles,omitempty" validate:"dive,required"`
+	PublicIds    []PublicID `json:"publicIds,omitempty" validate:"dive,required"`
+	Events       Events     `json:"events,omitempty" validate:"dive,required"`
+	Entities     []Entity   `json:"entities,omitempty" validate:"dive,required"`
+	AsEventActor Events     `json:"asEventActor,omitempty" validate:"dive,required"`
+	Status       []Status   `json:"status,omitempty" validate:"dive,required"`
+	WhoisURI     *string    `json:"port43,omitempty" validate:"omitempty"`
+	Lang         string     `json:"lang,omitempty"`
+	Remarks      []Notice   `json:"remarks,omitempty" validate:"dive"`
+	Links        []Link     `json:"links,omitempty" validate:"dive,required"`
+
+	// Networks and Autnums hold the ip network and autnum objects of the entity (i.e. all of
+	// the resources of an organization).
+	Networks []IPNetwork `json:"networks,omitempty" validate:"dive"`
+	Autnums  []Autnum    `json:"autnums,omitempty" validate:"dive"`
 }
 
 // Link represents the RDAP specification's link object.
 //
 // See Section 4.2: https://datatracker.ietf.org/doc/rfc9083/
 type Link struct {
-	Rel   string `json:"rel" validate:"required"`
-	Href  string `json:"href" validate:"required,url"`
-	Type  string `json:"type,omitempty" validate:"omitempty"`
-	Value string `json:"value,omitempty" validate:"omitempty"`
+	Rel      string    `json:"rel" validate:"required"`
+	Href     string    `json:"href" validate:"required,url"`
+	Type     string    `json:"type,omitempty" validate:"omitempty"`
+	Value    string    `json:"value,omitempty" validate:"omitempty"`
+	HrefLang Languages `json:"hreflang,omitempty"`
+	Title    string    `json:"title,omitempty"`
+	Media    string    `json:"media,omitempty"`
+}
+
+// Languages is the languages of the target of a link, which servers may return as either a
+// single language tag, or an array of language tags.
+type Languages []string
+
+// UnmarshalJSON decodes either a single language tag, or an array of language tags.
+func (languages *Languages) UnmarshalJSON(data []byte) error {
+	var language string
+
+	if err := json.Unmarshal(data, &language); err == nil {
+		*languages = Languages{language}
+		return nil
+	}
+
+	return json.Unmarshal(data, (*[]string)(languages))
+}
+
+// PublicID represents the RDAP specification's public identifier of an object (i.e. the IANA
+// Registrar ID of a registrar).
+//
+// See Section 4.8: https://datatracker.ietf.org/doc/rfc9083/
+type PublicID struct {
+	Type       string `json:"type" validate:"required"`
+	Identifier string `json:"identifier" validate:"required"`
+}
+
+// Notice represents the RDAP specification's notice and remark objects, which share the same
+// structure.
+//
+// See Section 4.3: https://datatracker.ietf.org/doc/rfc9083/
+type Notice struct {
+	Title       string   `json:"title,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Description []string `json:"description" validate:"required"`
+	Links       []Link   `json:"links,omitempty" validate:"dive,required"`
 }
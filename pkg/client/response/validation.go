@@ -0,0 +1,19 @@
+package response
+
+// ValidationWarning describes a member of an RDAP response which failed validation, when
+// the response was decoded leniently.
+type ValidationWarning struct {
+	// Path is the location of the member in the response, using the JSON member names (i.e.
+	// entities[0].vcardArray).
+	Path string
+
+	// Rule is the validation rule the member failed (i.e. required or url).
+	Rule string
+
+	// Value is the value of the member which failed validation.
+	Value any
+}
+
+func (warning ValidationWarning) String() string {
+	return warning.Path + " failed the " + warning.Rule + " rule"
+}
@@ -0,0 +1,215 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// ErrAttemptBudgetExhausted is returned when a lookup used every request attempt permitted
+// by the Client's retry policy without receiving a successful response.
+var ErrAttemptBudgetExhausted = errors.New("RDAP request attempt budget exhausted")
+
+// StatusError is returned when an RDAP server responds with a non-200 status code.
+type StatusError struct {
+	// Server is the RDAP server base URL which returned the status code.
+	Server string
+
+	// StatusCode is the HTTP status code returned by the server.
+	StatusCode int
+
+	// RetryAfter is the delay requested by the server's Retry-After header, or zero if the
+	// header was absent or invalid.
+	RetryAfter time.Duration
+}
+
+func (err *StatusError) Error() string {
+	return fmt.Sprintf("%s returned non-200 status code: %d", err.Server, err.StatusCode)
+}
+
+// RetryPolicy controls how the Client retries requests to RDAP servers which fail with
+// transient errors.
+type RetryPolicy struct {
+	// MaxAttemptsPerServer is the maximum number of requests made to a single RDAP server,
+	// including the first request, before moving on to the next server.
+	MaxAttemptsPerServer int
+
+	// MaxAttempts is the total number of requests which may be made across all RDAP servers
+	// for a single lookup. A zero value applies no overall limit.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry of a request.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor the backoff delay grows by after each retry.
+	Multiplier float64
+
+	// Jitter is the fraction (between 0 and 1) of each backoff delay which is randomised,
+	// to avoid many clients retrying in lockstep.
+	Jitter float64
+
+	// MaxRetryAfter is the longest Retry-After delay requested by a server which the Client
+	// will honour. Servers requesting longer delays are not retried, and the next server is
+	// used instead. A zero value honours any Retry-After delay.
+	MaxRetryAfter time.Duration
+}
+
+// RecommendedRetryPolicy returns a retry policy which retries transient failures a small
+// number of times with exponential backoff.
+//
+// A Client attempts each RDAP server exactly once (see NoRetryPolicy) unless configured
+// otherwise with WithRetryPolicy, so that it doesn't add load to servers which are
+// struggling without being asked to.
+func RecommendedRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttemptsPerServer: 2,
+		MaxAttempts:          6,
+		InitialBackoff:       250 * time.Millisecond,
+		MaxBackoff:           5 * time.Second,
+		Multiplier:           2,
+		Jitter:               0.2,
+		MaxRetryAfter:        30 * time.Second,
+	}
+}
+
+// NoRetryPolicy returns a retry policy which attempts each RDAP server exactly once. It's the
+// retry policy used by a Client unless configured otherwise.
+func NoRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttemptsPerServer: 1}
+}
+
+// backoff returns the delay before making the given retry (where 1 is the first retry)
+// following the error, and whether the request should be retried at all.
+func (policy RetryPolicy) backoff(retry int, err error) (time.Duration, bool) {
+	delay := float64(policy.InitialBackoff) * math.Pow(max(policy.Multiplier, 1), float64(retry-1))
+
+	if policy.MaxBackoff > 0 {
+		delay = min(delay, float64(policy.MaxBackoff))
+	}
+
+	if policy.Jitter > 0 {
+		delay += delay * policy.Jitter * (2*rand.Float64() - 1)
+	}
+
+	wait := time.Duration(delay)
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+		if policy.MaxRetryAfter > 0 && statusErr.RetryAfter > policy.MaxRetryAfter {
+			return 0, false
+		}
+
+		wait = max(wait, statusErr.RetryAfter)
+	}
+
+	return wait, true
+}
+
+// IsRetryable reports whether an error returned while performing an RDAP request is
+// transient, and the request may succeed if retried.
+//
+// Rate limiting (429), request timeouts (408) and server errors (500, 502, 503 and 504) are
+// retryable, as are network timeouts and dropped connections. Errors caused by the
+// transport policy, unparsable responses or a cancelled context are not.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, errParse) {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.StatusCode {
+		case http.StatusRequestTimeout,
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		default:
+			return false
+		}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which may either be a number of
+// seconds or an HTTP date.
+//
+// See Section 10.2.3: https://datatracker.ietf.org/doc/rfc9110/
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.ParseUint(header, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(header); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
+// maxDrain is the most of a response body which is read before closing it, so that the
+// connection can be reused for the next request.
+const maxDrain = 64 << 10
+
+// drain reads the remainder of a response body (up to maxDrain) and closes it, so that the
+// connection can be reused when the request is retried.
+func drain(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, maxDrain))
+	_ = body.Close()
+}
+
+// attemptBudget tracks the number of requests made across all servers for a single lookup.
+type attemptBudget struct {
+	limit int64
+	used  atomic.Int64
+}
+
+// take reserves an attempt from the budget, returning false if it is exhausted.
+func (budget *attemptBudget) take() bool {
+	if budget.limit <= 0 {
+		budget.used.Add(1)
+		return true
+	}
+
+	return budget.used.Add(1) <= budget.limit
+}
+
+// sleep blocks for the given duration, or until the context is done.
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
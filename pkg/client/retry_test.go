@@ -0,0 +1,242 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFailingHandler returns a handler which responds with the given status code (and
+// optional Retry-After header) for the first failures requests, before serving the fixture.
+func newFailingHandler(t *testing.T, failures int32, statusCode int, retryAfter string, requests *atomic.Int32) http.HandlerFunc {
+	fixture := newFixtureHandler(t, "ipv4.json", nil)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+
+			w.WriteHeader(statusCode)
+			return
+		}
+
+		fixture(w, r)
+	}
+}
+
+// fastRetryPolicy returns a retry policy with short backoffs suitable for tests.
+func fastRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttemptsPerServer: 3,
+		InitialBackoff:       time.Millisecond,
+		MaxBackoff:           5 * time.Millisecond,
+		Multiplier:           2,
+		MaxRetryAfter:        2 * time.Second,
+	}
+}
+
+func TestTransientFailuresAreRetried(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(newFailingHandler(t, 2, http.StatusServiceUnavailable, "", &requests))
+	defer server.Close()
+
+	client := New()
+	client.WithRetryPolicy(fastRetryPolicy())
+
+	response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestNonRetryableFailuresMoveToNextServer(t *testing.T) {
+	var notFoundRequests, requests atomic.Int32
+
+	notFoundServer := httptest.NewServer(newFailingHandler(t, 100, http.StatusNotFound, "", &notFoundRequests))
+	defer notFoundServer.Close()
+
+	server := httptest.NewServer(newFixtureHandler(t, "ipv4.json", &requests))
+	defer server.Close()
+
+	client := New()
+	client.WithRetryPolicy(fastRetryPolicy())
+
+	response, err := client.request(
+		context.Background(),
+		[]string{notFoundServer.URL + "/", server.URL + "/"},
+		query.IPv4Query,
+		"8.8.8.8",
+	)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, int32(1), notFoundRequests.Load())
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestRetryAfterIsRespected(t *testing.T) {
+	t.Run("Within maximum", func(t *testing.T) {
+		var requests atomic.Int32
+		server := httptest.NewServer(newFailingHandler(t, 1, http.StatusTooManyRequests, "1", &requests))
+		defer server.Close()
+
+		client := New()
+		client.WithRetryPolicy(fastRetryPolicy())
+
+		start := time.Now()
+		response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("Exceeding maximum", func(t *testing.T) {
+		var requests atomic.Int32
+		server := httptest.NewServer(newFailingHandler(t, 1, http.StatusTooManyRequests, "120", &requests))
+		defer server.Close()
+
+		client := New()
+		client.WithRetryPolicy(fastRetryPolicy())
+
+		response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+		var statusErr *StatusError
+
+		assert.Nil(t, response)
+		assert.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, 120*time.Second, statusErr.RetryAfter)
+		assert.Equal(t, int32(1), requests.Load())
+	})
+}
+
+func TestAttemptBudgetIsSharedAcrossServers(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(newFailingHandler(t, 100, http.StatusBadGateway, "", &requests))
+	defer server.Close()
+
+	policy := fastRetryPolicy()
+	policy.MaxAttempts = 4
+
+	client := New()
+	client.WithRetryPolicy(policy)
+
+	response, err := client.request(
+		context.Background(),
+		[]string{server.URL + "/a/", server.URL + "/b/", server.URL + "/c/"},
+		query.IPv4Query,
+		"8.8.8.8",
+	)
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, ErrAttemptBudgetExhausted)
+	assert.Equal(t, int32(4), requests.Load())
+}
+
+func TestCancellingContextStopsRetries(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(newFailingHandler(t, 100, http.StatusServiceUnavailable, "1", &requests))
+	defer server.Close()
+
+	client := New()
+	client.WithRetryPolicy(fastRetryPolicy())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	response, err := client.request(ctx, []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestClassifyingRetryableErrors(t *testing.T) {
+	assert.True(t, IsRetryable(&StatusError{StatusCode: http.StatusTooManyRequests}))
+	assert.True(t, IsRetryable(&StatusError{StatusCode: http.StatusServiceUnavailable}))
+	assert.True(t, IsRetryable(&StatusError{StatusCode: http.StatusGatewayTimeout}))
+
+	assert.False(t, IsRetryable(nil))
+	assert.False(t, IsRetryable(&StatusError{StatusCode: http.StatusNotFound}))
+	assert.False(t, IsRetryable(&StatusError{StatusCode: http.StatusBadRequest}))
+	assert.False(t, IsRetryable(ErrPlaintextNotAllowed))
+	assert.False(t, IsRetryable(context.Canceled))
+	assert.False(t, IsRetryable(errors.New("some other error")))
+}
+
+func TestParsingRetryAfterHeader(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 30*time.Second, parseRetryAfter("30", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter("Wed, 01 Jan 2025 12:01:30 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("Wed, 01 Jan 2025 11:00:00 GMT", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+}
+
+func TestClientDoesNotRetryByDefault(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(newFailingHandler(t, 1, http.StatusServiceUnavailable, "", &requests))
+	defer server.Close()
+
+	response, err := New().request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+	assert.Nil(t, response)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestZeroMaxRetryAfterHonoursAnyDelay(t *testing.T) {
+	policy := fastRetryPolicy()
+	policy.MaxRetryAfter = 0
+
+	wait, ok := policy.backoff(1, &StatusError{StatusCode: http.StatusTooManyRequests, RetryAfter: 10 * time.Minute})
+
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Minute, wait)
+}
+
+func TestFailedResponsesAreDrainedForConnectionReuse(t *testing.T) {
+	var requests, connections atomic.Int32
+
+	fixture := newFixtureHandler(t, "ipv4.json", nil)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"errorCode": 503, "title": "Service Unavailable", "description": ["` + strings.Repeat("-", 32<<10) + `"]}`))
+			return
+		}
+
+		fixture(w, r)
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	client := New()
+	client.WithRetryPolicy(fastRetryPolicy())
+
+	response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, int32(2), requests.Load())
+	assert.Equal(t, int32(1), connections.Load())
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/internal/registry"
+	"github.com/ryanmab/rdap-go/pkg/client/response/dns"
+)
+
+// ReverseDomainName returns the reverse DNS domain name (i.e. 2.0.192.in-addr.arpa or
+// 8.b.d.0.1.0.0.2.ip6.arpa) of the zone containing the prefix.
+//
+// Reverse zones are delegated on octet boundaries for IPv4, and nibble boundaries for IPv6,
+// so prefixes which do not end on a boundary are widened to the nearest enclosing boundary
+// (i.e. 192.0.2.0/23 produces 0.192.in-addr.arpa).
+//
+// See Section 2.5: https://datatracker.ietf.org/doc/rfc9082/
+func ReverseDomainName(prefix netip.Prefix) (string, error) {
+	if !prefix.IsValid() {
+		return "", &InvalidIdentifierError{Kind: "IP prefix", Identifier: prefix.String(), Reason: "not a valid prefix"}
+	}
+
+	addr := prefix.Addr()
+	bytes := addr.AsSlice()
+
+	var labels []string
+
+	if addr.Is4() {
+		for i := prefix.Bits()/8 - 1; i >= 0; i-- {
+			labels = append(labels, strconv.Itoa(int(bytes[i])))
+		}
+
+		labels = append(labels, "in-addr", "arpa")
+	} else {
+		for i := prefix.Bits()/4 - 1; i >= 0; i-- {
+			nibble := bytes[i/2] >> 4
+			if i%2 == 1 {
+				nibble = bytes[i/2] & 0x0f
+			}
+
+			labels = append(labels, strconv.FormatUint(uint64(nibble), 16))
+		}
+
+		labels = append(labels, "ip6", "arpa")
+	}
+
+	if len(labels) == 2 {
+		return "", &InvalidIdentifierError{Kind: "IP prefix", Identifier: prefix.String(), Reason: "too short to be delegated as a reverse zone"}
+	}
+
+	return strings.Join(labels, "."), nil
+}
+
+// isReverseDomainName reports whether the lowercase domain name is in the reverse DNS tree
+// (i.e. under in-addr.arpa or ip6.arpa).
+func isReverseDomainName(name string) bool {
+	return strings.HasSuffix("."+name, ".in-addr.arpa") || strings.HasSuffix("."+name, ".ip6.arpa")
+}
+
+// reverseDomainPrefix returns the prefix of the zone named by a lowercase reverse DNS domain
+// name (i.e. 192.0.2.0/24 for 2.0.192.in-addr.arpa), reversing ReverseDomainName.
+func reverseDomainPrefix(name string) (netip.Prefix, bool) {
+	if labels, ok := strings.CutSuffix(name, ".in-addr.arpa"); ok {
+		var bytes [4]byte
+
+		octets := strings.Split(labels, ".")
+		if len(octets) > len(bytes) {
+			return netip.Prefix{}, false
+		}
+
+		for i, octet := range octets {
+			value, err := strconv.ParseUint(octet, 10, 8)
+
+			if err != nil || (len(octet) > 1 && octet[0] == '0') {
+				return netip.Prefix{}, false
+			}
+
+			bytes[len(octets)-1-i] = byte(value)
+		}
+
+		return netip.PrefixFrom(netip.AddrFrom4(bytes), len(octets)*8), true
+	}
+
+	if labels, ok := strings.CutSuffix(name, ".ip6.arpa"); ok {
+		var bytes [16]byte
+
+		nibbles := strings.Split(labels, ".")
+		if len(nibbles) > 2*len(bytes) {
+			return netip.Prefix{}, false
+		}
+
+		for i, nibble := range nibbles {
+			value, err := strconv.ParseUint(nibble, 16, 4)
+
+			if err != nil || len(nibble) != 1 {
+				return netip.Prefix{}, false
+			}
+
+			position := len(nibbles) - 1 - i
+			if position%2 == 0 {
+				value <<= 4
+			}
+
+			bytes[position/2] |= byte(value)
+		}
+
+		return netip.PrefixFrom(netip.AddrFrom16(bytes), len(nibbles)*4), true
+	}
+
+	return netip.Prefix{}, false
+}
+
+// LookupReverseDomain looks up the reverse DNS domain (i.e. 2.0.192.in-addr.arpa) of the zone
+// containing the prefix, using RDAP and retrieves its Domain registration data.
+//
+// Reverse domains are registered with the Regional Internet Registry responsible for the
+// address space, so the request is routed using the IP bootstrap registries.
+func (client *Client) LookupReverseDomain(prefix netip.Prefix) (*dns.Response, error) {
+	return client.LookupReverseDomainContext(context.Background(), prefix)
+}
+
+// LookupReverseDomainContext is like LookupReverseDomain, but uses the provided context for
+// the lifetime of the lookup.
+func (client *Client) LookupReverseDomainContext(ctx context.Context, prefix netip.Prefix) (*dns.Response, error) {
+	if prefix.IsValid() && prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+	}
+
+	name, err := ReverseDomainName(prefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	queryType := query.IPv6Query
+	if prefix.Addr().Is4() {
+		queryType = query.IPv4Query
+	}
+
+	servers, err := registry.GetServers(queryType, prefix.Masked().String())
+
+	if err != nil {
+		slog.Error("failed to get RDAP servers for reverse domain", "prefix", prefix, "domain", name, "error", err)
+		return nil, err
+	}
+
+	return typed[dns.Response](client.request(ctx, servers, query.DomainQuery, name))
+}
+
+// LookupReverseDomainAddr looks up the most specific reverse DNS domain registered for the
+// zone containing an IP address, using RDAP and retrieves its Domain registration data.
+//
+// Reverse domains are rarely registered for individual addresses, so the zones containing
+// the address are tried from most to least specific (from /24 up to /8 for IPv4, and from
+// /64 up to /32 for IPv6) until one is found.
+func (client *Client) LookupReverseDomainAddr(addr netip.Addr) (*dns.Response, error) {
+	return client.LookupReverseDomainAddrContext(context.Background(), addr)
+}
+
+// LookupReverseDomainAddrContext is like LookupReverseDomainAddr, but uses the provided
+// context for the lifetime of the lookup.
+func (client *Client) LookupReverseDomainAddrContext(ctx context.Context, addr netip.Addr) (*dns.Response, error) {
+	addr = addr.Unmap()
+
+	bits := []int{64, 56, 48, 40, 32}
+	if addr.Is4() {
+		bits = []int{24, 16, 8}
+	}
+
+	var err error
+
+	for _, length := range bits {
+		var response *dns.Response
+
+		response, err = client.LookupReverseDomainContext(ctx, netip.PrefixFrom(addr, length))
+
+		var statusErr *StatusError
+		if err == nil || !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+			return response, err
+		}
+	}
+
+	return nil, err
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/dns"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildingReverseDomainNames(t *testing.T) {
+	tests := map[string]string{
+		"192.0.2.0/24":    "2.0.192.in-addr.arpa",
+		"192.0.2.1/32":    "1.2.0.192.in-addr.arpa",
+		"10.0.0.0/8":      "10.in-addr.arpa",
+		"192.0.2.0/23":    "0.192.in-addr.arpa",
+		"2001:db8::/32":   "8.b.d.0.1.0.0.2.ip6.arpa",
+		"2001:db8:1::/48": "1.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa",
+		"2001:db8::/30":   "b.d.0.1.0.0.2.ip6.arpa",
+	}
+
+	for prefix, expected := range tests {
+		name, err := ReverseDomainName(netip.MustParsePrefix(prefix))
+
+		assert.NoError(t, err, "Prefix %s", prefix)
+		assert.Equal(t, expected, name, "Prefix %s", prefix)
+	}
+}
+
+func TestBuildingReverseDomainNamesForShortPrefixesReturnsAnError(t *testing.T) {
+	for _, prefix := range []netip.Prefix{netip.MustParsePrefix("10.0.0.0/7"), netip.MustParsePrefix("2000::/3"), {}} {
+		_, err := ReverseDomainName(prefix)
+
+		var invalidErr *InvalidIdentifierError
+
+		assert.ErrorAs(t, err, &invalidErr, "Prefix %s", prefix)
+	}
+}
+
+func TestParsingReverseDomainNames(t *testing.T) {
+	tests := map[string]string{
+		"2.0.192.in-addr.arpa":             "192.0.2.0/24",
+		"1.2.0.192.in-addr.arpa":           "192.0.2.1/32",
+		"10.in-addr.arpa":                  "10.0.0.0/8",
+		"8.b.d.0.1.0.0.2.ip6.arpa":         "2001:db8::/32",
+		"1.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa": "2001:db8:1::/48",
+		"b.d.0.1.0.0.2.ip6.arpa":           "2001:db0::/28",
+	}
+
+	for name, expected := range tests {
+		prefix, ok := reverseDomainPrefix(name)
+
+		assert.True(t, ok, "Name %s", name)
+		assert.Equal(t, netip.MustParsePrefix(expected), prefix, "Name %s", name)
+	}
+
+	for _, name := range []string{"example.com", "in-addr.arpa", "256.in-addr.arpa", "01.in-addr.arpa", "5.4.3.2.1.in-addr.arpa", "bd.0.1.0.0.2.ip6.arpa", "g.ip6.arpa"} {
+		_, ok := reverseDomainPrefix(name)
+
+		assert.False(t, ok, "Name %s", name)
+	}
+}
+
+func TestLookingUpReverseDomain(t *testing.T) {
+	client := New()
+
+	// Seed the cache, so that the lookups can be performed without making network requests.
+	client.cache.Set(query.DomainQuery, "8.8.8.in-addr.arpa", dns.Response{LdhName: "8.8.8.in-addr.arpa"})
+	client.cache.Set(query.DomainQuery, "0.6.8.4.1.0.0.2.ip6.arpa", dns.Response{LdhName: "0.6.8.4.1.0.0.2.ip6.arpa"})
+
+	t.Run("IPv4 prefix", func(t *testing.T) {
+		response, err := client.LookupReverseDomain(netip.MustParsePrefix("8.8.8.0/24"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "8.8.8.in-addr.arpa", response.LdhName)
+	})
+
+	t.Run("IPv4-mapped prefix", func(t *testing.T) {
+		response, err := client.LookupReverseDomain(netip.MustParsePrefix("::ffff:8.8.8.0/120"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "8.8.8.in-addr.arpa", response.LdhName)
+	})
+
+	t.Run("IPv4 address", func(t *testing.T) {
+		response, err := client.LookupReverseDomainAddr(netip.MustParseAddr("8.8.8.8"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "8.8.8.in-addr.arpa", response.LdhName)
+	})
+
+	t.Run("IPv6 prefix", func(t *testing.T) {
+		response, err := client.LookupReverseDomain(netip.MustParsePrefix("2001:4860::/32"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "0.6.8.4.1.0.0.2.ip6.arpa", response.LdhName)
+	})
+}
+
+func TestDecodingReverseDomainNetwork(t *testing.T) {
+	server := httptest.NewServer(newFixtureHandler(t, "reverse_domain.json", nil))
+	defer server.Close()
+
+	client := New()
+
+	response, err := typed[dns.Response](client.request(context.Background(), []string{server.URL + "/"}, query.DomainQuery, "2.0.192.in-addr.arpa"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2.0.192.in-addr.arpa", response.LdhName)
+	assert.NotNil(t, response.Network)
+	assert.NotNil(t, response.Network.IPv4)
+	assert.Equal(t, "NET-RTR-1", response.Network.IPv4.Name)
+	assert.Equal(t, netip.MustParseAddr("192.0.2.0"), response.Network.StartAddr())
+	assert.Equal(t, netip.MustParseAddr("192.0.2.255"), response.Network.EndAddr())
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/asn"
+	"github.com/ryanmab/rdap-go/pkg/client/response/dns"
+	"github.com/ryanmab/rdap-go/pkg/client/response/entity"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv6"
+	"github.com/stretchr/testify/assert"
+)
+
+// withoutEmptyMembers removes the members of a decoded JSON value which are null or empty, as
+// the responses omit (or encode as null) the optional members a server didn't return.
+func withoutEmptyMembers(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for name, member := range value {
+			value[name] = withoutEmptyMembers(member)
+
+			if isEmpty(value[name]) {
+				delete(value, name)
+			}
+		}
+	case []any:
+		for i, element := range value {
+			value[i] = withoutEmptyMembers(element)
+		}
+	}
+
+	return value
+}
+
+func isEmpty(value any) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case []any:
+		return len(value) == 0
+	case map[string]any:
+		return len(value) == 0
+	default:
+		return false
+	}
+}
+
+// TestDecodingRFC9083Examples decodes the example objects from RFC 9083 (with the common data
+// structures from Section 4 added to the entity), and checks that every member of the example
+// is modelled, by encoding the response again and comparing it to the example.
+func TestDecodingRFC9083Examples(t *testing.T) {
+	tests := []struct {
+		fixture   string
+		queryType query.RdapQuery
+		check     func(t *testing.T, response any)
+	}{
+		{"domain.json", query.DomainQuery, func(t *testing.T, response any) {
+			domain := response.(dns.Response)
+
+			assert.Equal(t, "whois.example.net", *domain.WhoisURI)
+			assert.Equal(t, "ENS_Auth ID", domain.PublicIds[0].Type)
+			assert.Len(t, domain.Remarks, 1)
+			assert.Equal(t, "https://example.net/nameserver/ns1.example.com", domain.Nameservers[0].Links[0].Href)
+			assert.Len(t, domain.Nameservers[0].Remarks, 1)
+		}},
+		{"ip_network.json", query.IPv6Query, func(t *testing.T, response any) {
+			network := response.(ipv6.Response)
+
+			assert.Equal(t, "AU", network.Country)
+			assert.Equal(t, "DIRECT ALLOCATION", network.Type)
+			assert.Len(t, network.Remarks, 1)
+		}},
+		{"autnum.json", query.AsnQuery, func(t *testing.T, response any) {
+			autnum := response.(asn.Response)
+
+			assert.Equal(t, "AU", autnum.Country)
+			assert.Equal(t, "DIRECT ALLOCATION", autnum.Type)
+			assert.Len(t, autnum.Remarks, 1)
+		}},
+		{"entity.json", query.EntityQuery, func(t *testing.T, response any) {
+			entity := response.(entity.Response)
+
+			assert.Equal(t, "en", entity.Lang)
+			assert.Equal(t, "IANA Registrar ID", entity.PublicIds[0].Type)
+			assert.Equal(t, "Terms of Use", entity.Notices[0].Title)
+			assert.Equal(t, []string{"en", "ch"}, []string(entity.Links[1].HrefLang))
+			assert.Equal(t, "screen", entity.Links[1].Media)
+			assert.Equal(t, "https://example.net/entity/SOMEID-LUNARNIC", entity.Events[1].Links[0].Href)
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			data, err := os.ReadFile("testdata/rfc9083/" + test.fixture)
+			assert.NoError(t, err)
+
+			response, err := New().parseResponse(test.queryType, &http.Response{
+				ContentLength: int64(len(data)),
+				Body:          io.NopCloser(bytes.NewReader(data)),
+			})
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Empty(t, reflect.ValueOf(response).FieldByName("Extensions").Interface())
+
+			test.check(t, response)
+
+			encoded, err := json.Marshal(response)
+			assert.NoError(t, err)
+
+			var expected, actual any
+			assert.NoError(t, json.Unmarshal(data, &expected))
+			assert.NoError(t, json.Unmarshal(encoded, &actual))
+
+			assert.Equal(t, withoutEmptyMembers(expected), withoutEmptyMembers(actual))
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/ryanmab/rdap-go/internal/publicsuffix"
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/internal/registry"
+	"github.com/ryanmab/rdap-go/pkg/client/response/dns"
+)
+
+// SuffixPolicy controls how the hostnames passed to LookupDomain are reduced to the
+// registrable domain which is looked up, using the Public Suffix List.
+//
+// The list is compiled into the package (generated by internal/cmd/psl), so it can't be
+// replaced at runtime and is only updated with new releases. Names in the reverse DNS tree
+// (under in-addr.arpa or ip6.arpa) are never reduced.
+//
+// See: https://publicsuffix.org/
+type SuffixPolicy int
+
+const (
+	// ICANNSuffixes reduces hostnames to their registrable domain using the ICANN section of
+	// the Public Suffix List - i.e. www.mail.example.co.uk is looked up as example.co.uk.
+	// This is the default.
+	ICANNSuffixes SuffixPolicy = iota
+
+	// PrivateSuffixes also uses the private section of the Public Suffix List - i.e.
+	// www.example.github.io is looked up as example.github.io, rather than github.io.
+	PrivateSuffixes
+
+	// NoSuffixes looks up hostnames exactly as provided.
+	NoSuffixes
+)
+
+// DomainLookup is the result of looking up the registrable domain of a hostname.
+type DomainLookup struct {
+	// Input is the domain as provided.
+	Input string
+
+	// Hostname is the hostname of the input, in lowercase A-label form.
+	Hostname string
+
+	// PublicSuffix is the public suffix of the hostname (i.e. co.uk), or empty when looked up
+	// using NoSuffixes, or when the hostname is a reverse DNS domain name.
+	PublicSuffix string
+
+	// Queried is the domain which was looked up. This is the registrable domain of the
+	// hostname (i.e. example.co.uk), unless looked up using NoSuffixes, or the hostname is a
+	// reverse DNS domain name (i.e. 2.0.192.in-addr.arpa).
+	Queried string
+
+	// Domain is the registration data of the queried domain.
+	Domain *dns.Response
+}
+
+// WithSuffixPolicy sets how the hostnames passed to LookupDomain are reduced to the
+// registrable domain which is looked up.
+func (client *Client) WithSuffixPolicy(policy SuffixPolicy) {
+	client.suffixPolicy = policy
+}
+
+// LookupRegistrableDomain looks up the registrable domain of a hostname (i.e. example.co.uk
+// for www.mail.example.co.uk), using RDAP and retrieves its Domain registration data, along
+// with the names which were resolved along the way.
+func (client *Client) LookupRegistrableDomain(domain string) (*DomainLookup, error) {
+	return client.LookupRegistrableDomainContext(context.Background(), domain)
+}
+
+// LookupRegistrableDomainContext is like LookupRegistrableDomain, but uses the provided
+// context for the lifetime of the lookup.
+func (client *Client) LookupRegistrableDomainContext(ctx context.Context, domain string) (*DomainLookup, error) {
+	lookup := &DomainLookup{Input: domain}
+
+	domain = strings.TrimSpace(domain)
+
+	if lower := strings.ToLower(domain); !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+		domain = "https://" + domain
+	}
+	url, err := url.Parse(domain)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lookup.Hostname, err = toASCIIDomain(url.Hostname())
+
+	if err != nil {
+		return nil, err
+	}
+
+	lookup.Queried = lookup.Hostname
+
+	if client.suffixPolicy != NoSuffixes && !isReverseDomainName(lookup.Hostname) {
+		includePrivate := client.suffixPolicy == PrivateSuffixes
+
+		lookup.PublicSuffix, _ = publicsuffix.PublicSuffix(lookup.Hostname, includePrivate)
+		lookup.Queried, err = publicsuffix.RegistrableDomain(lookup.Hostname, includePrivate)
+
+		if errors.Is(err, publicsuffix.ErrPublicSuffix) {
+			return nil, &InvalidIdentifierError{Kind: "domain", Identifier: lookup.Hostname, Reason: "is a public suffix, not a registrable domain"}
+		}
+	}
+
+	slog.Info("Resolved domain for lookup", "domain", lookup.Input, "hostname", lookup.Hostname, "queried", lookup.Queried)
+
+	var servers []string
+
+	// Reverse domains are registered with the Regional Internet Registry responsible for the
+	// address space, so are routed using the IP bootstrap registries (as LookupReverseDomain).
+	if prefix, ok := reverseDomainPrefix(lookup.Queried); ok && prefix.Addr().Is4() {
+		servers, err = registry.GetServers(query.IPv4Query, prefix.String())
+	} else if ok {
+		servers, err = registry.GetServers(query.IPv6Query, prefix.String())
+	} else {
+		servers, err = registry.GetServers(query.DomainQuery, lookup.Queried)
+	}
+
+	if err != nil {
+		slog.Error("failed to get RDAP servers for domain", "domain", lookup.Queried, "error", err)
+		return nil, err
+	}
+
+	lookup.Domain, err = typed[dns.Response](client.request(ctx, servers, query.DomainQuery, lookup.Queried))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return lookup, nil
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response/dns"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookingUpRegistrableDomain(t *testing.T) {
+	client := New()
+
+	// Seed the cache, so that the lookups can be performed without making network requests.
+	client.cache.Set(query.DomainQuery, "example.com", dns.Response{LdhName: "EXAMPLE.COM"})
+	client.cache.Set(query.DomainQuery, "appspot.com", dns.Response{LdhName: "APPSPOT.COM"})
+	client.cache.Set(query.DomainQuery, "example.appspot.com", dns.Response{LdhName: "EXAMPLE.APPSPOT.COM"})
+	client.cache.Set(query.DomainQuery, "www.mail.example.com", dns.Response{LdhName: "WWW.MAIL.EXAMPLE.COM"})
+	client.cache.Set(query.DomainQuery, "2.0.192.in-addr.arpa", dns.Response{LdhName: "2.0.192.IN-ADDR.ARPA"})
+	client.cache.Set(query.DomainQuery, "8.b.d.0.1.0.0.2.ip6.arpa", dns.Response{LdhName: "8.B.D.0.1.0.0.2.IP6.ARPA"})
+
+	t.Run("ICANN suffixes", func(t *testing.T) {
+		client.WithSuffixPolicy(ICANNSuffixes)
+
+		lookup, err := client.LookupRegistrableDomain(" https://WWW.Mail.Example.com/path ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, " https://WWW.Mail.Example.com/path ", lookup.Input)
+		assert.Equal(t, "www.mail.example.com", lookup.Hostname)
+		assert.Equal(t, "com", lookup.PublicSuffix)
+		assert.Equal(t, "example.com", lookup.Queried)
+		assert.Equal(t, "EXAMPLE.COM", lookup.Domain.LdhName)
+
+		lookup, err = client.LookupRegistrableDomain("www.example.appspot.com")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "appspot.com", lookup.Queried)
+	})
+
+	t.Run("Private suffixes", func(t *testing.T) {
+		client.WithSuffixPolicy(PrivateSuffixes)
+
+		lookup, err := client.LookupRegistrableDomain("www.example.appspot.com")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "appspot.com", lookup.PublicSuffix)
+		assert.Equal(t, "example.appspot.com", lookup.Queried)
+		assert.Equal(t, "EXAMPLE.APPSPOT.COM", lookup.Domain.LdhName)
+	})
+
+	t.Run("No suffixes", func(t *testing.T) {
+		client.WithSuffixPolicy(NoSuffixes)
+
+		lookup, err := client.LookupRegistrableDomain("www.mail.example.com")
+
+		assert.NoError(t, err)
+		assert.Empty(t, lookup.PublicSuffix)
+		assert.Equal(t, "www.mail.example.com", lookup.Queried)
+		assert.Equal(t, "WWW.MAIL.EXAMPLE.COM", lookup.Domain.LdhName)
+	})
+
+	t.Run("Reverse domain names", func(t *testing.T) {
+		client.WithSuffixPolicy(ICANNSuffixes)
+
+		lookup, err := client.LookupRegistrableDomain("2.0.192.in-addr.arpa")
+
+		assert.NoError(t, err)
+		assert.Empty(t, lookup.PublicSuffix)
+		assert.Equal(t, "2.0.192.in-addr.arpa", lookup.Queried)
+		assert.Equal(t, "2.0.192.IN-ADDR.ARPA", lookup.Domain.LdhName)
+
+		response, err := client.LookupDomain("8.B.D.0.1.0.0.2.ip6.arpa")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "8.B.D.0.1.0.0.2.IP6.ARPA", response.LdhName)
+	})
+
+	t.Run("Domain lookup", func(t *testing.T) {
+		client.WithSuffixPolicy(ICANNSuffixes)
+
+		response, err := client.LookupDomain("www.mail.example.com")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "EXAMPLE.COM", response.LdhName)
+	})
+}
+
+func TestLookingUpPublicSuffixReturnsAnError(t *testing.T) {
+	client := New()
+
+	_, err := client.LookupDomain("co.uk")
+
+	var invalidErr *InvalidIdentifierError
+
+	assert.ErrorAs(t, err, &invalidErr)
+}
@@ -0,0 +1,269 @@
+package client
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	// ErrPlaintextNotAllowed is returned when an RDAP server would have been contacted
+	// over plaintext HTTP, but the Client's transport policy only permits HTTPS.
+	ErrPlaintextNotAllowed = errors.New("plaintext HTTP is not permitted by the transport policy")
+
+	// ErrTLSVersionTooLow is returned when an RDAP server negotiated a TLS version lower
+	// than the minimum permitted by the Client's transport policy.
+	ErrTLSVersionTooLow = errors.New("negotiated TLS version is lower than the transport policy minimum")
+
+	// ErrCertificatePinMismatch is returned when none of the certificates presented by an
+	// RDAP server match the pins configured for its host.
+	ErrCertificatePinMismatch = errors.New("server certificate does not match any pinned public key")
+
+	// ErrTransportPolicyUnenforceable is returned when the transport policy has a minimum TLS
+	// version or pins, but the HTTP client of the Client doesn't use an *http.Transport, so
+	// the TLS configuration used to contact RDAP servers can't be controlled.
+	ErrTransportPolicyUnenforceable = errors.New("transport policy cannot be enforced on the HTTP client's transport")
+)
+
+// SchemePolicy controls which URL schemes the Client is permitted to use when contacting
+// RDAP servers listed in the bootstrap registries.
+type SchemePolicy int
+
+const (
+	// PreferHTTPS attempts all HTTPS servers before falling back to any plaintext HTTP
+	// servers listed for the same registry.
+	PreferHTTPS SchemePolicy = iota
+
+	// HTTPSOnly never contacts RDAP servers over plaintext HTTP. Any plaintext servers
+	// listed in the bootstrap registries are reported as failed attempts with
+	// ErrPlaintextNotAllowed.
+	HTTPSOnly
+)
+
+// TransportPolicy describes the transport security requirements the Client enforces
+// when contacting RDAP servers.
+type TransportPolicy struct {
+	// Scheme controls whether plaintext HTTP servers may be used.
+	Scheme SchemePolicy
+
+	// MinTLSVersion is the minimum TLS version (i.e. tls.VersionTLS12) which must be
+	// negotiated with an RDAP server. A zero value applies no additional minimum.
+	MinTLSVersion uint16
+
+	// Pins maps an RDAP server host (i.e. rdap.arin.net) to the base64 encoded SHA-256
+	// hashes of the Subject Public Key Info of certificates trusted for that host. When
+	// a host has pins configured, at least one certificate in the chain presented by the
+	// server must match.
+	Pins map[string][]string
+}
+
+// DefaultTransportPolicy returns the transport policy used by a Client unless configured
+// otherwise.
+func DefaultTransportPolicy() TransportPolicy {
+	return TransportPolicy{
+		Scheme: PreferHTTPS,
+	}
+}
+
+// order the servers according to the scheme policy, returning the servers which may be
+// attempted, and an error for each server which may not.
+func (policy TransportPolicy) order(servers []string) ([]string, []error) {
+	secure := make([]string, 0, len(servers))
+	plaintext := make([]string, 0)
+
+	for _, server := range servers {
+		if strings.HasPrefix(server, "https://") {
+			secure = append(secure, server)
+		} else {
+			plaintext = append(plaintext, server)
+		}
+	}
+
+	if policy.Scheme == HTTPSOnly {
+		errs := make([]error, 0, len(plaintext))
+
+		for _, server := range plaintext {
+			errs = append(errs, fmt.Errorf("%s: %w", server, ErrPlaintextNotAllowed))
+		}
+
+		return secure, errs
+	}
+
+	return append(secure, plaintext...), nil
+}
+
+// maxRedirects is the number of redirects followed before a request fails, matching the
+// default of http.Client.
+const maxRedirects = 10
+
+// client returns a copy of the HTTP client which enforces the policy on every connection it
+// makes, before the request is sent - the minimum TLS version and pins are enforced during
+// the TLS handshake, and redirects to plaintext servers are refused before they're followed.
+//
+// The TLS requirements can only be enforced on an *http.Transport (or the default transport),
+// so ErrTransportPolicyUnenforceable is returned if the client uses another http.RoundTripper.
+func (policy TransportPolicy) client(base *http.Client) (*http.Client, error) {
+	enforced := *base
+
+	enforced.CheckRedirect = func(request *http.Request, via []*http.Request) error {
+		if policy.Scheme == HTTPSOnly && request.URL.Scheme != "https" {
+			return fmt.Errorf("%s: %w", request.URL.Redacted(), ErrPlaintextNotAllowed)
+		}
+
+		if base.CheckRedirect != nil {
+			return base.CheckRedirect(request, via)
+		}
+
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+
+		return nil
+	}
+
+	if policy.MinTLSVersion == 0 && len(policy.Pins) == 0 {
+		return &enforced, nil
+	}
+
+	var transport *http.Transport
+
+	switch base := base.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = base
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrTransportPolicyUnenforceable, base)
+	}
+
+	enforced.Transport = &policyTransport{
+		policy:     policy,
+		base:       transport,
+		transports: make(map[string]*http.Transport),
+	}
+
+	return &enforced, nil
+}
+
+// policyTransport enforces the TLS requirements of a transport policy during the handshake of
+// every connection, using a copy of the base transport for each host, so that the pins of the
+// host are known (the server name of a connection is empty for IP address hosts).
+type policyTransport struct {
+	policy TransportPolicy
+	base   *http.Transport
+
+	mu         sync.Mutex
+	transports map[string]*http.Transport
+}
+
+func (transport *policyTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return transport.forHost(request.URL.Hostname()).RoundTrip(request)
+}
+
+// CloseIdleConnections closes the idle connections of the transports of every host.
+func (transport *policyTransport) CloseIdleConnections() {
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	for _, hostTransport := range transport.transports {
+		hostTransport.CloseIdleConnections()
+	}
+}
+
+// forHost returns the transport used for connections to the host, which enforces the TLS
+// requirements of the policy for the host.
+func (transport *policyTransport) forHost(host string) *http.Transport {
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	if hostTransport, ok := transport.transports[host]; ok {
+		return hostTransport
+	}
+
+	hostTransport := transport.base.Clone()
+
+	if hostTransport.TLSClientConfig == nil {
+		hostTransport.TLSClientConfig = &tls.Config{}
+	}
+
+	config := hostTransport.TLSClientConfig
+
+	if transport.policy.MinTLSVersion > config.MinVersion {
+		config.MinVersion = transport.policy.MinTLSVersion
+	}
+
+	verifyConnection := config.VerifyConnection
+
+	config.VerifyConnection = func(state tls.ConnectionState) error {
+		if err := transport.policy.verifyConnection(host, state); err != nil {
+			return err
+		}
+
+		if verifyConnection != nil {
+			return verifyConnection(state)
+		}
+
+		return nil
+	}
+
+	transport.transports[host] = hostTransport
+
+	return hostTransport
+}
+
+// verifyConnection checks a TLS connection to the host satisfies the minimum TLS version and
+// the pins of the host, during the handshake.
+func (policy TransportPolicy) verifyConnection(host string, state tls.ConnectionState) error {
+	if policy.MinTLSVersion != 0 && state.Version < policy.MinTLSVersion {
+		return fmt.Errorf("%s negotiated %s: %w", host, tls.VersionName(state.Version), ErrTLSVersionTooLow)
+	}
+
+	pins, ok := policy.Pins[host]
+
+	if !ok || len(pins) == 0 {
+		return nil
+	}
+
+	for _, certificate := range state.PeerCertificates {
+		hash := sha256.Sum256(certificate.RawSubjectPublicKeyInfo)
+
+		if slices.Contains(pins, base64.StdEncoding.EncodeToString(hash[:])) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s: %w", host, ErrCertificatePinMismatch)
+}
+
+// handshakeError identifies a TLS handshake which failed because the server doesn't support
+// the minimum TLS version of the policy. This is reported by the server as a protocol_version
+// alert, which crypto/tls returns as a "remote error" without an exported type.
+//
+// See Section 6.2: https://datatracker.ietf.org/doc/rfc8446/
+func (policy TransportPolicy) handshakeError(host string, err error) error {
+	var opErr *net.OpError
+
+	if policy.MinTLSVersion != 0 &&
+		errors.As(err, &opErr) &&
+		opErr.Op == "remote error" &&
+		opErr.Err.Error() == "tls: protocol version not supported" {
+		return fmt.Errorf("%s does not support %s: %w: %w", host, tls.VersionName(policy.MinTLSVersion), ErrTLSVersionTooLow, err)
+	}
+
+	return err
+}
+
+// isPlaintext reports whether the server URL uses plaintext HTTP.
+func isPlaintext(server string) bool {
+	serverURL, err := url.Parse(server)
+
+	return err == nil && serverURL.Scheme == "http"
+}
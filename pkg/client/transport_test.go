@@ -0,0 +1,228 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFixtureHandler returns a handler which serves the given testdata file as an RDAP
+// response, counting the number of requests it receives.
+func newFixtureHandler(t *testing.T, fixture string, requests *atomic.Int32) http.HandlerFunc {
+	data, err := os.ReadFile("testdata/" + fixture)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requests != nil {
+			requests.Add(1)
+		}
+
+		w.Header().Set("Content-Type", "application/rdap+json")
+		_, _ = w.Write(data)
+	}
+}
+
+func TestHTTPSOnlyPolicyRejectsPlaintextServers(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(newFixtureHandler(t, "ipv4.json", &requests))
+	defer server.Close()
+
+	client := New()
+	client.WithTransportPolicy(TransportPolicy{Scheme: HTTPSOnly})
+
+	response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, ErrPlaintextNotAllowed)
+	assert.Equal(t, int32(0), requests.Load())
+}
+
+func TestHTTPSOnlyPolicyRejectsRedirectsToPlaintext(t *testing.T) {
+	var plaintextRequests atomic.Int32
+
+	plaintextServer := httptest.NewServer(newFixtureHandler(t, "ipv4.json", &plaintextRequests))
+	defer plaintextServer.Close()
+
+	secureServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, plaintextServer.URL+r.URL.Path, http.StatusFound)
+	}))
+	defer secureServer.Close()
+
+	client := New()
+	client.WithHTTPClient(secureServer.Client())
+	client.WithTransportPolicy(TransportPolicy{Scheme: HTTPSOnly})
+
+	response, err := client.request(context.Background(), []string{secureServer.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, ErrPlaintextNotAllowed)
+
+	// The redirect is refused before it's followed.
+	assert.Equal(t, int32(0), plaintextRequests.Load())
+}
+
+func TestPreferHTTPSPolicyAttemptsSecureServersFirst(t *testing.T) {
+	var plaintextRequests, secureRequests atomic.Int32
+
+	plaintextServer := httptest.NewServer(newFixtureHandler(t, "ipv4.json", &plaintextRequests))
+	defer plaintextServer.Close()
+
+	secureServer := httptest.NewTLSServer(newFixtureHandler(t, "ipv4.json", &secureRequests))
+	defer secureServer.Close()
+
+	client := New()
+	client.WithHTTPClient(secureServer.Client())
+
+	response, err := client.request(
+		context.Background(),
+		[]string{plaintextServer.URL + "/", secureServer.URL + "/"},
+		query.IPv4Query,
+		"8.8.8.8",
+	)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Equal(t, int32(0), plaintextRequests.Load())
+	assert.Equal(t, int32(1), secureRequests.Load())
+}
+
+func TestPreferHTTPSPolicyFallsBackToPlaintext(t *testing.T) {
+	plaintextServer := httptest.NewServer(newFixtureHandler(t, "ipv4.json", nil))
+	defer plaintextServer.Close()
+
+	secureServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer secureServer.Close()
+
+	client := New()
+	client.WithHTTPClient(secureServer.Client())
+
+	response, err := client.request(
+		context.Background(),
+		[]string{secureServer.URL + "/", plaintextServer.URL + "/"},
+		query.IPv4Query,
+		"8.8.8.8",
+	)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+}
+
+func TestMinimumTLSVersionIsEnforced(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewUnstartedServer(newFixtureHandler(t, "ipv4.json", &requests))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	client := New()
+	client.WithHTTPClient(server.Client())
+
+	t.Run("Below minimum", func(t *testing.T) {
+		client.ClearCache()
+		client.WithTransportPolicy(TransportPolicy{MinTLSVersion: tls.VersionTLS13})
+
+		response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, ErrTLSVersionTooLow)
+
+		// The handshake fails, so the query is never sent.
+		assert.Equal(t, int32(0), requests.Load())
+	})
+
+	t.Run("At minimum", func(t *testing.T) {
+		client.ClearCache()
+		client.WithTransportPolicy(TransportPolicy{MinTLSVersion: tls.VersionTLS12})
+
+		response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+}
+
+func TestCertificatePinsAreEnforced(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewTLSServer(newFixtureHandler(t, "ipv4.json", &requests))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	hash := sha256.Sum256(server.Certificate().RawSubjectPublicKeyInfo)
+
+	client := New()
+	client.WithHTTPClient(server.Client())
+
+	t.Run("Matching pin", func(t *testing.T) {
+		client.ClearCache()
+		client.WithTransportPolicy(TransportPolicy{
+			Pins: map[string][]string{
+				serverURL.Hostname(): {base64.StdEncoding.EncodeToString(hash[:])},
+			},
+		})
+
+		response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("Mismatched pin", func(t *testing.T) {
+		client.ClearCache()
+		requests.Store(0)
+		client.WithTransportPolicy(TransportPolicy{
+			Pins: map[string][]string{
+				serverURL.Hostname(): {base64.StdEncoding.EncodeToString(make([]byte, sha256.Size))},
+			},
+		})
+
+		response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, ErrCertificatePinMismatch)
+
+		// The pins are checked during the handshake, so the query is never sent.
+		assert.Equal(t, int32(0), requests.Load())
+	})
+}
+
+// roundTripperFunc is an http.RoundTripper which isn't an *http.Transport.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (fn roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return fn(request)
+}
+
+func TestTransportPolicyIsNotEnforceableOnCustomRoundTrippers(t *testing.T) {
+	var requests atomic.Int32
+
+	client := New()
+	client.WithHTTPClient(&http.Client{Transport: roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		requests.Add(1)
+
+		return nil, http.ErrNotSupported
+	})})
+	client.WithTransportPolicy(TransportPolicy{MinTLSVersion: tls.VersionTLS13})
+
+	response, err := client.request(context.Background(), []string{"https://rdap.example.com/"}, query.IPv4Query, "8.8.8.8")
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, ErrTransportPolicyUnenforceable)
+	assert.Equal(t, int32(0), requests.Load())
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ryanmab/rdap-go/pkg/client/response"
+)
+
+// ValidationPolicy controls how the Client handles RDAP responses which fail validation
+// against the RDAP specification.
+type ValidationPolicy int
+
+const (
+	// StrictValidation rejects responses which fail validation, and tries the next RDAP
+	// server instead. This is the default.
+	StrictValidation ValidationPolicy = iota
+
+	// LenientValidation returns responses which fail validation, recording each failure as
+	// a warning on the response (see the Warnings field of each response type).
+	LenientValidation
+
+	// NoValidation returns responses without validating them.
+	NoValidation
+)
+
+// ValidationError is returned by an RDAP server request when the response fails validation
+// under StrictValidation.
+type ValidationError struct {
+	// Warnings lists the members of the response which failed validation.
+	Warnings []response.ValidationWarning
+}
+
+func (err *ValidationError) Error() string {
+	problems := make([]string, 0, len(err.Warnings))
+
+	for _, warning := range err.Warnings {
+		problems = append(problems, warning.String())
+	}
+
+	return fmt.Sprintf("RDAP response failed validation: %s", strings.Join(problems, "; "))
+}
+
+// WithValidationPolicy sets how the Client handles RDAP responses which fail validation.
+func (client *Client) WithValidationPolicy(policy ValidationPolicy) {
+	client.validationPolicy = policy
+}
+
+// newValidator creates a validator which reports failures using the JSON member names of
+// the response.
+func newValidator() *validator.Validate {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		if name == "-" {
+			return ""
+		}
+
+		if name == "" {
+			return field.Name
+		}
+
+		return name
+	})
+
+	validate.RegisterStructValidation(validateEvent, response.Event{})
+	validate.RegisterStructValidation(validateIPNetwork, response.IPNetwork{})
+
+	return validate
+}
+
+// validateEvent reports an event whose eventDate could not be parsed as a date.
+func validateEvent(level validator.StructLevel) {
+	if date, ok := level.Current().Interface().(response.Event).MalformedDate(); ok {
+		level.ReportError(date, "eventDate", "Date", "datetime", "")
+	}
+}
+
+// validateIPNetwork reports an ip network object whose address family could be determined
+// from neither its ipVersion nor its startAddress.
+func validateIPNetwork(level validator.StructLevel) {
+	if network := level.Current().Interface().(response.IPNetwork); network.IPv4 == nil && network.IPv6 == nil {
+		level.ReportError(nil, "ipVersion", "IPVersion", "oneof", "v4 v6")
+	}
+}
+
+// validateResponse validates the decoded response according to the policy, returning the
+// validation failures as warnings under LenientValidation, or as a ValidationError under
+// StrictValidation.
+func validateResponse(validate *validator.Validate, policy ValidationPolicy, output any) ([]response.ValidationWarning, error) {
+	if policy == NoValidation {
+		return nil, nil
+	}
+
+	err := validate.Struct(output)
+
+	if err == nil {
+		return nil, nil
+	}
+
+	var fieldErrs validator.ValidationErrors
+
+	if !errors.As(err, &fieldErrs) {
+		return nil, err
+	}
+
+	warnings := make([]response.ValidationWarning, 0, len(fieldErrs))
+
+	for _, fieldErr := range fieldErrs {
+		// The namespace is prefixed with the name of the response type, which is omitted.
+		_, path, _ := strings.Cut(fieldErr.Namespace(), ".")
+
+		warnings = append(warnings, response.ValidationWarning{
+			Path:  path,
+			Rule:  fieldErr.Tag(),
+			Value: fieldErr.Value(),
+		})
+	}
+
+	if policy == StrictValidation {
+		return nil, &ValidationError{Warnings: warnings}
+	}
+
+	return warnings, nil
+}
@@ -0,0 +1,249 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ryanmab/rdap-go/internal/query"
+	"github.com/ryanmab/rdap-go/pkg/client/response"
+	"github.com/ryanmab/rdap-go/pkg/client/response/entity"
+	"github.com/ryanmab/rdap-go/pkg/client/response/ipv4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrictValidationFallsThroughToNextServer(t *testing.T) {
+	var invalidRequests, requests atomic.Int32
+
+	invalidServer := httptest.NewServer(newFixtureHandler(t, "ipv4_invalid.json", &invalidRequests))
+	defer invalidServer.Close()
+
+	server := httptest.NewServer(newFixtureHandler(t, "ipv4.json", &requests))
+	defer server.Close()
+
+	client := New()
+	client.WithRetryPolicy(fastRetryPolicy())
+
+	response, err := typed[ipv4.Response](client.request(
+		context.Background(),
+		[]string{invalidServer.URL + "/", server.URL + "/"},
+		query.IPv4Query,
+		"8.8.8.8",
+	))
+
+	assert.NoError(t, err)
+	assert.Empty(t, response.Warnings)
+	assert.Equal(t, int32(1), invalidRequests.Load())
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestStrictValidationRejectsInvalidResponses(t *testing.T) {
+	server := httptest.NewServer(newFixtureHandler(t, "ipv4_invalid.json", nil))
+	defer server.Close()
+
+	client := New()
+
+	response, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+	var validationErr *ValidationError
+
+	assert.Nil(t, response)
+	assert.ErrorAs(t, err, &validationErr)
+	assert.ElementsMatch(t, []string{"entities[0].vcardArray", "links[1].href"}, warningPaths(validationErr.Warnings))
+	assert.False(t, IsRetryable(err))
+}
+
+func TestLenientValidationReturnsWarnings(t *testing.T) {
+	server := httptest.NewServer(newFixtureHandler(t, "ipv4_invalid.json", nil))
+	defer server.Close()
+
+	client := New()
+	client.WithValidationPolicy(LenientValidation)
+
+	response, err := typed[ipv4.Response](client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "GOGL", response.Name)
+	assert.ElementsMatch(t, []string{"entities[0].vcardArray", "links[1].href"}, warningPaths(response.Warnings))
+
+	for _, warning := range response.Warnings {
+		if warning.Path == "links[1].href" {
+			assert.Equal(t, "url", warning.Rule)
+			assert.Equal(t, "/registry/entity/GOGL", warning.Value)
+		}
+	}
+}
+
+func TestCachedResponsesAreKeyedByValidationPolicy(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(newFixtureHandler(t, "ipv4_invalid.json", &requests))
+	defer server.Close()
+
+	client := New()
+	client.WithValidationPolicy(LenientValidation)
+
+	response, err := typed[ipv4.Response](client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8"))
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response.Warnings)
+
+	// The response cached under lenient validation is not returned under strict validation.
+	client.WithValidationPolicy(StrictValidation)
+
+	_, err = client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+	var validationErr *ValidationError
+
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, int32(2), requests.Load())
+
+	// But it is still returned under lenient validation.
+	client.WithValidationPolicy(LenientValidation)
+
+	response, err = typed[ipv4.Response](client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8"))
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response.Warnings)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestMalformedEventDatesFailValidation(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/ipv4.json")
+	assert.NoError(t, err)
+
+	fixture = bytes.Replace(fixture, []byte(`"2023-12-28T17:24:33-05:00"`), []byte(`"28th December 2023"`), 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rdap+json")
+		_, _ = w.Write(fixture)
+	}))
+	defer server.Close()
+
+	t.Run("Strict validation", func(t *testing.T) {
+		client := New()
+
+		_, err := client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8")
+
+		var validationErr *ValidationError
+
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"events[0].eventDate"}, warningPaths(validationErr.Warnings))
+	})
+
+	t.Run("Lenient validation", func(t *testing.T) {
+		client := New()
+		client.WithValidationPolicy(LenientValidation)
+
+		output, err := typed[ipv4.Response](client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []response.ValidationWarning{{Path: "events[0].eventDate", Rule: "datetime", Value: "28th December 2023"}}, output.Warnings)
+
+		// The registration date is unknown, rather than zero.
+		_, ok := output.Events.Registration()
+		assert.False(t, ok)
+
+		lastChanged, ok := output.Events.LastChanged()
+		assert.True(t, ok)
+		assert.False(t, lastChanged.IsZero())
+	})
+}
+
+func TestInvalidIPVersionsOfNetworksFailValidation(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/entity.json")
+	assert.NoError(t, err)
+
+	fixture = bytes.Replace(fixture, []byte(`"ipVersion": "v4"`), []byte(`"ipVersion": "4"`), 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rdap+json")
+		_, _ = w.Write(fixture)
+	}))
+	defer server.Close()
+
+	t.Run("Strict validation", func(t *testing.T) {
+		client := New()
+
+		_, err := client.request(context.Background(), []string{server.URL + "/"}, query.EntityQuery, "XXXX")
+
+		var validationErr *ValidationError
+
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"Entity.networks[0].IPv4.ipVersion"}, warningPaths(validationErr.Warnings))
+	})
+
+	t.Run("Lenient validation", func(t *testing.T) {
+		client := New()
+		client.WithValidationPolicy(LenientValidation)
+
+		output, err := typed[entity.Response](client.request(context.Background(), []string{server.URL + "/"}, query.EntityQuery, "XXXX"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []response.ValidationWarning{{Path: "Entity.networks[0].IPv4.ipVersion", Rule: "eq", Value: "4"}}, output.Warnings)
+
+		// The address family is inferred from the start address.
+		assert.NotNil(t, output.Networks[0].IPv4)
+		assert.Equal(t, netip.MustParseAddr("192.0.2.0"), output.Networks[0].StartAddr())
+	})
+}
+
+func TestNoValidationSkipsValidation(t *testing.T) {
+	server := httptest.NewServer(newFixtureHandler(t, "ipv4_invalid.json", nil))
+	defer server.Close()
+
+	client := New()
+	client.WithValidationPolicy(NoValidation)
+
+	response, err := typed[ipv4.Response](client.request(context.Background(), []string{server.URL + "/"}, query.IPv4Query, "8.8.8.8"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "GOGL", response.Name)
+	assert.Empty(t, response.Warnings)
+}
+
+func TestMalformedResponsesFallThroughToNextServer(t *testing.T) {
+	var malformedRequests atomic.Int32
+
+	malformedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		malformedRequests.Add(1)
+
+		w.Header().Set("Content-Type", "application/rdap+json")
+		_, _ = w.Write([]byte(`{"objectClassName": `))
+	}))
+	defer malformedServer.Close()
+
+	server := httptest.NewServer(newFixtureHandler(t, "ipv4.json", nil))
+	defer server.Close()
+
+	client := New()
+
+	response, err := client.request(
+		context.Background(),
+		[]string{malformedServer.URL + "/", server.URL + "/"},
+		query.IPv4Query,
+		"8.8.8.8",
+	)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+
+	// Responses which cannot be parsed are not retried against the same server.
+	assert.Equal(t, int32(1), malformedRequests.Load())
+}
+
+// warningPaths returns the path of each validation warning.
+func warningPaths(warnings []response.ValidationWarning) []string {
+	paths := make([]string, 0, len(warnings))
+
+	for _, warning := range warnings {
+		paths = append(paths, warning.Path)
+	}
+
+	return paths
+}
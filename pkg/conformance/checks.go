@@ -0,0 +1,488 @@
+package conformance
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// checkHeaders checks the HTTP headers of an RDAP response.
+func checkHeaders(report *Report, header http.Header) {
+	contentType := header.Get("Content-Type")
+
+	if contentType == "" {
+		report.add(Error, "header:Content-Type", "RFC 7480 Section 4.2", "the response has no media type, and must use %s", MediaType)
+	} else if mediaType, _, err := mime.ParseMediaType(contentType); err != nil {
+		report.add(Error, "header:Content-Type", "RFC 7480 Section 4.2", "the media type %q is malformed: %s", contentType, err)
+	} else if mediaType != MediaType {
+		report.add(Error, "header:Content-Type", "RFC 7480 Section 4.2", "the media type is %q, but must be %s", mediaType, MediaType)
+	}
+
+	if header.Get("Access-Control-Allow-Origin") == "" {
+		report.add(Warning, "header:Access-Control-Allow-Origin", "RFC 7480 Section 5.6", "the response should allow cross-origin requests")
+	}
+}
+
+// check holds the state of a single check of a response body.
+type check struct {
+	*Checker
+
+	report *Report
+
+	// declared is the extension identifiers declared in the rdapConformance member.
+	declared []string
+}
+
+// checkBody checks the body of an RDAP response. The expected object class is empty if it is
+// not known, and the status code is 0 if the body was not received over HTTP.
+func (checker *Checker) checkBody(report *Report, data []byte, expected string, statusCode int) {
+	var body any
+
+	if err := json.Unmarshal(data, &body); err != nil {
+		report.add(Error, "$", "RFC 9083 Section 1", "the response is not valid JSON: %s", err)
+		return
+	}
+
+	object, ok := body.(map[string]any)
+
+	if !ok {
+		report.add(Error, "$", "RFC 9083 Section 1", "the response must be a JSON object")
+		return
+	}
+
+	c := &check{Checker: checker, report: report}
+
+	c.checkConformance(object)
+
+	_, isError := object["errorCode"]
+
+	switch {
+	case isError || statusCode >= 400:
+		c.checkError(object, statusCode)
+	case object["domainSearchResults"] != nil || object["nameserverSearchResults"] != nil || object["entitySearchResults"] != nil:
+		c.checkSearchResults(object)
+	default:
+		c.checkObject("$", object, expected, true)
+	}
+}
+
+// checkConformance checks the rdapConformance member of the topmost object, recording the
+// extension identifiers it declares.
+func (c *check) checkConformance(object map[string]any) {
+	const reference = "RFC 9083 Section 4.1"
+
+	value, ok := object["rdapConformance"]
+
+	if !ok {
+		c.report.add(Error, "$.rdapConformance", reference, "the topmost object must have an rdapConformance member")
+		return
+	}
+
+	identifiers, ok := value.([]any)
+
+	if !ok {
+		c.report.add(Error, "$.rdapConformance", reference, "rdapConformance must be an array of strings")
+		return
+	}
+
+	for i, value := range identifiers {
+		path := "$.rdapConformance[" + strconv.Itoa(i) + "]"
+		identifier, ok := value.(string)
+
+		if !ok {
+			c.report.add(Error, path, reference, "rdapConformance must only contain strings")
+			continue
+		}
+
+		c.declared = append(c.declared, identifier)
+
+		if _, ok := c.extensions[identifier]; !ok {
+			c.report.add(Warning, path, "RFC 7480 Section 6", "%q is not a registered extension identifier", identifier)
+		}
+	}
+
+	if !slices.Contains(c.declared, "rdap_level_0") {
+		c.report.add(Error, "$.rdapConformance", reference, "rdapConformance must contain rdap_level_0")
+	}
+}
+
+// checkError checks an error response.
+func (c *check) checkError(object map[string]any, statusCode int) {
+	const reference = "RFC 9083 Section 6"
+
+	value, ok := object["errorCode"]
+
+	if !ok {
+		c.report.add(Warning, "$.errorCode", reference, "the response to a failed request should be an error object with an errorCode")
+	} else if code, ok := value.(float64); !ok || code != float64(int(code)) {
+		c.report.add(Error, "$.errorCode", reference, "errorCode must be an integer")
+	} else if statusCode != 0 && int(code) != statusCode {
+		c.report.add(Warning, "$.errorCode", reference, "errorCode is %d, but the HTTP status code is %d", int(code), statusCode)
+	}
+
+	if value, ok := object["title"]; ok {
+		if _, ok := value.(string); !ok {
+			c.report.add(Error, "$.title", reference, "title must be a string")
+		}
+	}
+
+	c.checkDescription("$", object)
+	c.checkNotices("$.notices", object["notices"], true)
+	c.checkMembers("$", object, errorMembers)
+}
+
+// checkSearchResults checks a search response, which holds arrays of objects of a single
+// object class.
+func (c *check) checkSearchResults(object map[string]any) {
+	for _, search := range searchResults {
+		member, class := search.member, search.class
+		value, ok := object[member]
+
+		if !ok {
+			continue
+		}
+
+		results, ok := value.([]any)
+
+		if !ok {
+			c.report.add(Error, "$."+member, "RFC 9083 Section 8", "%s must be an array", member)
+			continue
+		}
+
+		for i, result := range results {
+			c.checkNested("$."+member+"["+strconv.Itoa(i)+"]", result, class)
+		}
+	}
+
+	c.checkNotices("$.notices", object["notices"], true)
+	c.checkMembers("$", object, searchMembers)
+}
+
+// checkNested checks an object embedded in another object.
+func (c *check) checkNested(path string, value any, expected string) {
+	object, ok := value.(map[string]any)
+
+	if !ok {
+		c.report.add(Error, path, "RFC 9083 Section 5", "must be an object")
+		return
+	}
+
+	if _, ok := object["rdapConformance"]; ok {
+		c.report.add(Warning, path+".rdapConformance", "RFC 9083 Section 4.1", "rdapConformance should only appear in the topmost object")
+	}
+
+	c.checkObject(path, object, expected, false)
+}
+
+// checkObject checks an object class (i.e. a domain or entity) and the objects embedded in
+// it.
+func (c *check) checkObject(path string, object map[string]any, expected string, topmost bool) {
+	class := c.checkClassName(path, object, expected)
+
+	c.checkEvents(path+".events", object["events"])
+	c.checkLinks(path+".links", object["links"])
+	c.checkStatus(path+".status", object["status"])
+	c.checkNotices(path+".remarks", object["remarks"], true)
+	c.checkNotices(path+".notices", object["notices"], topmost)
+
+	if class == "entity" {
+		c.checkEvents(path+".asEventActor", object["asEventActor"])
+		c.checkRoles(path+".roles", object["roles"])
+	}
+
+	if class == "ip network" {
+		c.checkNetwork(path, object)
+	}
+
+	for _, nested := range embedded {
+		member, class := nested.member, nested.class
+
+		switch value := object[member].(type) {
+		case nil:
+		case []any:
+			for i, nested := range value {
+				c.checkNested(path+"."+member+"["+strconv.Itoa(i)+"]", nested, class)
+			}
+		default:
+			if member == "network" {
+				c.checkNested(path+"."+member, value, class)
+			} else {
+				c.report.add(Error, path+"."+member, "RFC 9083 Section 5", "%s must be an array", member)
+			}
+		}
+	}
+
+	defined, ok := objectMembers[class]
+
+	if !ok {
+		defined = anyObjectMembers
+	}
+
+	c.checkMembers(path, object, defined)
+}
+
+// checkClassName checks the objectClassName of an object, returning the object class.
+func (c *check) checkClassName(path string, object map[string]any, expected string) string {
+	const reference = "RFC 9083 Section 4.7"
+
+	value, ok := object["objectClassName"]
+
+	if !ok {
+		c.report.add(Error, path+".objectClassName", reference, "the object must have an objectClassName")
+		return ""
+	}
+
+	class, ok := value.(string)
+
+	if !ok {
+		c.report.add(Error, path+".objectClassName", reference, "objectClassName must be a string")
+		return ""
+	}
+
+	if !slices.Contains(objectClasses, class) {
+		c.report.add(Error, path+".objectClassName", reference, "%q is not an object class defined by RFC 9083", class)
+		return ""
+	}
+
+	if expected != "" && class != expected {
+		c.report.add(Error, path+".objectClassName", reference, "the object class is %q, but must be %q here", class, expected)
+	}
+
+	return class
+}
+
+// checkEvents checks an array of events.
+func (c *check) checkEvents(path string, value any) {
+	const reference = "RFC 9083 Section 4.5"
+
+	for i, event := range c.array(path, value, reference) {
+		path := path + "[" + strconv.Itoa(i) + "]"
+
+		if action, ok := c.stringMember(path, event, "eventAction", reference); ok && !slices.Contains(eventActions, action) {
+			c.report.add(Error, path+".eventAction", reference, "%q is not a registered event action", action)
+		}
+
+		if date, ok := c.stringMember(path, event, "eventDate", reference); ok {
+			if _, err := time.Parse(time.RFC3339, date); err != nil {
+				c.report.add(Error, path+".eventDate", reference, "%q is not an RFC 3339 date and time", date)
+			}
+		}
+
+		c.checkLinks(path+".links", event["links"])
+	}
+}
+
+// checkLinks checks an array of links.
+func (c *check) checkLinks(path string, value any) {
+	const reference = "RFC 9083 Section 4.2"
+
+	for i, link := range c.array(path, value, reference) {
+		path := path + "[" + strconv.Itoa(i) + "]"
+
+		if href, ok := c.stringMember(path, link, "href", reference); ok && !isAbsoluteURI(href) {
+			c.report.add(Error, path+".href", reference, "%q is not an absolute URI", href)
+		}
+
+		c.stringMember(path, link, "rel", reference)
+
+		if value, ok := c.stringMember(path, link, "value", reference); ok && !isAbsoluteURI(value) {
+			c.report.add(Warning, path+".value", reference, "%q should be the absolute URI of the context of the link", value)
+		}
+	}
+}
+
+// checkNotices checks an array of notices or remarks. Notices may only appear in the topmost
+// object, so allowed is false for the notices of embedded objects.
+func (c *check) checkNotices(path string, value any, allowed bool) {
+	const reference = "RFC 9083 Section 4.3"
+
+	notices := c.array(path, value, reference)
+
+	if len(notices) > 0 && !allowed {
+		c.report.add(Warning, path, reference, "notices should only appear in the topmost object")
+	}
+
+	for i, notice := range notices {
+		path := path + "[" + strconv.Itoa(i) + "]"
+
+		c.checkDescription(path, notice)
+		c.checkLinks(path+".links", notice["links"])
+	}
+}
+
+// checkDescription checks the description member of a notice, remark or error response.
+func (c *check) checkDescription(path string, object map[string]any) {
+	value, ok := object["description"]
+
+	if !ok {
+		return
+	}
+
+	lines, ok := value.([]any)
+
+	if !ok {
+		c.report.add(Error, path+".description", "RFC 9083 Section 4.3", "description must be an array of strings")
+		return
+	}
+
+	for i, line := range lines {
+		if _, ok := line.(string); !ok {
+			c.report.add(Error, path+".description["+strconv.Itoa(i)+"]", "RFC 9083 Section 4.3", "description must only contain strings")
+		}
+	}
+}
+
+// checkStatus checks an array of status values.
+func (c *check) checkStatus(path string, value any) {
+	c.checkRegistered(path, value, statuses, "RFC 9083 Section 4.6", "status")
+}
+
+// checkRoles checks the array of roles of an entity.
+func (c *check) checkRoles(path string, value any) {
+	c.checkRegistered(path, value, roles, "RFC 9083 Section 10.2.4", "role")
+}
+
+// checkRegistered checks an array of strings which should hold values from an IANA registry.
+func (c *check) checkRegistered(path string, value any, registered []string, reference string, name string) {
+	if value == nil {
+		return
+	}
+
+	values, ok := value.([]any)
+
+	if !ok {
+		c.report.add(Error, path, reference, "must be an array of strings")
+		return
+	}
+
+	for i, value := range values {
+		path := path + "[" + strconv.Itoa(i) + "]"
+
+		if value, ok := value.(string); !ok {
+			c.report.add(Error, path, reference, "must be a string")
+		} else if !slices.Contains(registered, value) {
+			c.report.add(Warning, path, reference, "%q is not a registered %s", value, name)
+		}
+	}
+}
+
+// checkNetwork checks the members specific to an IP network.
+func (c *check) checkNetwork(path string, object map[string]any) {
+	const reference = "RFC 9083 Section 5.4"
+
+	version, _ := object["ipVersion"].(string)
+
+	if version != "v4" && version != "v6" {
+		c.report.add(Error, path+".ipVersion", reference, "ipVersion must be v4 or v6")
+		return
+	}
+
+	for _, member := range []string{"startAddress", "endAddress"} {
+		value, ok := object[member].(string)
+
+		if !ok {
+			c.report.add(Error, path+"."+member, reference, "the network must have a %s", member)
+			continue
+		}
+
+		address, err := netip.ParseAddr(value)
+
+		if err != nil || address.Is4() != (version == "v4") {
+			c.report.add(Error, path+"."+member, reference, "%q is not an IP%s address", value, version)
+		}
+	}
+}
+
+// checkMembers checks that the members of an object are either defined by RFC 9083, or are
+// prefixed with an extension identifier declared in rdapConformance.
+func (c *check) checkMembers(path string, object map[string]any, defined []string) {
+	names := make([]string, 0, len(object))
+
+	for name := range object {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		if slices.Contains(defined, name) {
+			continue
+		}
+
+		if class, ok := object["objectClassName"].(string); ok && slices.Contains(anyObjectMembers, name) {
+			c.report.add(Warning, path+"."+name, "RFC 9083 Section 5", "%q is not a member of %s objects", name, class)
+			continue
+		}
+
+		extension := slices.ContainsFunc(c.declared, func(identifier string) bool {
+			return strings.HasPrefix(name, identifier+"_")
+		})
+
+		if !extension {
+			c.report.add(Warning, path+"."+name, "RFC 9083 Section 2.1", "%q is not defined by RFC 9083, and is not prefixed with an extension identifier declared in rdapConformance", name)
+		}
+	}
+}
+
+// array returns the objects of an array member, reporting an error if the member is not an
+// array of objects.
+func (c *check) array(path string, value any, reference string) []map[string]any {
+	if value == nil {
+		return nil
+	}
+
+	values, ok := value.([]any)
+
+	if !ok {
+		c.report.add(Error, path, reference, "must be an array")
+		return nil
+	}
+
+	objects := make([]map[string]any, 0, len(values))
+
+	for i, value := range values {
+		object, ok := value.(map[string]any)
+
+		if !ok {
+			c.report.add(Error, path+"["+strconv.Itoa(i)+"]", reference, "must be an object")
+			continue
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects
+}
+
+// stringMember returns a required string member of an object, reporting an error if it is
+// missing or is not a string.
+func (c *check) stringMember(path string, object map[string]any, name string, reference string) (string, bool) {
+	value, ok := object[name]
+
+	if !ok {
+		c.report.add(Error, path+"."+name, reference, "%s is required", name)
+		return "", false
+	}
+
+	str, ok := value.(string)
+
+	if !ok {
+		c.report.add(Error, path+"."+name, reference, "%s must be a string", name)
+		return "", false
+	}
+
+	return str, true
+}
+
+// isAbsoluteURI reports whether the value is an absolute URI (i.e. https://example.com/).
+func isAbsoluteURI(value string) bool {
+	uri, err := url.Parse(value)
+
+	return err == nil && uri.IsAbs()
+}
@@ -0,0 +1,304 @@
+// Package conformance checks RDAP responses against the RDAP specification, reporting the
+// problems found as a structured report.
+//
+// See: https://datatracker.ietf.org/doc/rfc7480/, https://datatracker.ietf.org/doc/rfc9082/
+// and https://datatracker.ietf.org/doc/rfc9083/
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// MediaType is the media type of RDAP responses.
+//
+// See Section 4.2: https://datatracker.ietf.org/doc/rfc7480/
+const MediaType = "application/rdap+json"
+
+// Severity is how serious a Finding is.
+type Severity int
+
+const (
+	// Info findings are observations which are not violations of the specification.
+	Info Severity = iota
+
+	// Warning findings violate a recommendation (SHOULD) of the specification, or use values
+	// which are not in the IANA registries.
+	Warning
+
+	// Error findings violate a requirement (MUST) of the specification.
+	Error
+)
+
+func (severity Severity) String() string {
+	switch severity {
+	case Info:
+		return "info"
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(severity))
+	}
+}
+
+// MarshalText encodes the severity by its name.
+func (severity Severity) MarshalText() ([]byte, error) {
+	return []byte(severity.String()), nil
+}
+
+// Finding is a single problem found in an RDAP response.
+type Finding struct {
+	Severity Severity `json:"severity"`
+
+	// Path is the location of the problem, either a JSON path into the response body (i.e.
+	// $.links[0].href) or an HTTP header (i.e. header:Content-Type).
+	Path string `json:"path"`
+
+	Message string `json:"message"`
+
+	// Reference is the section of the specification which was violated (i.e. RFC 9083
+	// Section 4.2).
+	Reference string `json:"reference"`
+}
+
+func (finding Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", finding.Severity, finding.Path, finding.Message, finding.Reference)
+}
+
+// Report is the outcome of checking an RDAP response.
+type Report struct {
+	// Source is where the response was read from (i.e. a URL or file path), if known.
+	Source string `json:"source,omitempty"`
+
+	Findings []Finding `json:"findings"`
+}
+
+// Passed reports whether the response has no Error findings.
+func (report *Report) Passed() bool {
+	return report.Count(Error) == 0
+}
+
+// Count returns the number of findings of the given severity.
+func (report *Report) Count(severity Severity) int {
+	count := 0
+
+	for _, finding := range report.Findings {
+		if finding.Severity == severity {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (report *Report) add(severity Severity, path string, reference string, format string, args ...any) {
+	report.Findings = append(report.Findings, Finding{
+		Severity:  severity,
+		Path:      path,
+		Message:   fmt.Sprintf(format, args...),
+		Reference: reference,
+	})
+}
+
+// Checker checks RDAP responses for conformance with the RDAP specification.
+type Checker struct {
+	extensions map[string]struct{}
+}
+
+// New creates a Checker which recognises the extension identifiers registered with IANA.
+func New() *Checker {
+	checker := &Checker{extensions: make(map[string]struct{}, len(registeredExtensions))}
+
+	for _, identifier := range registeredExtensions {
+		checker.extensions[identifier] = struct{}{}
+	}
+
+	return checker
+}
+
+// WithExtensions sets additional extension identifiers which are treated as registered, such
+// as those of extensions which are not yet registered with IANA.
+func (checker *Checker) WithExtensions(identifiers ...string) {
+	for _, identifier := range identifiers {
+		checker.extensions[identifier] = struct{}{}
+	}
+}
+
+// CheckJSON checks the body of an RDAP response.
+func (checker *Checker) CheckJSON(data []byte) *Report {
+	report := &Report{}
+
+	checker.checkBody(report, data, "", 0)
+
+	return report
+}
+
+// CheckResponse checks an HTTP response from an RDAP server, including its status code and
+// headers. The body of the response is read, but not closed.
+//
+// When the response carries its request, the object class of the response is also checked
+// against the lookup path which was requested.
+func (checker *Checker) CheckResponse(response *http.Response) (*Report, error) {
+	data, err := io.ReadAll(response.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	expected := ""
+
+	if response.Request != nil && response.Request.URL != nil {
+		report.Source = response.Request.URL.String()
+		expected = checkPath(report, response.Request.URL.Path)
+	}
+
+	checkHeaders(report, response.Header)
+
+	checker.checkBody(report, data, expected, response.StatusCode)
+
+	return report, nil
+}
+
+// CheckURL requests a URL from an RDAP server (i.e. https://rdap.example.com/ip/192.0.2.1
+// or a server running locally) and checks the response. If client is nil,
+// http.DefaultClient is used.
+func (checker *Checker) CheckURL(ctx context.Context, client *http.Client, url string) (*Report, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", MediaType)
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	return checker.CheckResponse(response)
+}
+
+// CheckHandler serves a request for the target (i.e. /ip/192.0.2.1) from an in-process RDAP
+// server handler, and checks the response. This allows RDAP server implementations to be
+// checked from their own tests. An error is returned if the target is not a valid URL.
+func (checker *Checker) CheckHandler(handler http.Handler, target string) (*Report, error) {
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, target, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The request is served as if it had been received by a server (i.e. with the target
+	// as its RequestURI).
+	request.RequestURI = target
+	request.RemoteAddr = "192.0.2.1:1234"
+
+	if request.Host == "" {
+		request.Host = "example.com"
+	}
+
+	request.Header.Set("Accept", MediaType)
+
+	buffer := &responseBuffer{header: make(http.Header)}
+
+	handler.ServeHTTP(buffer, request)
+
+	return checker.CheckResponse(buffer.response(request))
+}
+
+// responseBuffer is an http.ResponseWriter which buffers the response written by a handler,
+// so that it can be checked by CheckHandler.
+type responseBuffer struct {
+	header  http.Header
+	written http.Header
+	status  int
+	body    bytes.Buffer
+}
+
+func (buffer *responseBuffer) Header() http.Header {
+	return buffer.header
+}
+
+// WriteHeader records the status code and the headers at the time it is called, as they
+// would have been sent by a server. Only the first call has any effect.
+func (buffer *responseBuffer) WriteHeader(status int) {
+	if buffer.written != nil {
+		return
+	}
+
+	buffer.status = status
+	buffer.written = buffer.header.Clone()
+}
+
+// Write buffers the body, writing a 200 OK status code first if none has been written. As
+// with a server, the Content-Type is detected from the body if the handler hasn't set one.
+func (buffer *responseBuffer) Write(data []byte) (int, error) {
+	if buffer.written == nil {
+		if _, ok := buffer.header["Content-Type"]; !ok {
+			buffer.header.Set("Content-Type", http.DetectContentType(data))
+		}
+
+		buffer.WriteHeader(http.StatusOK)
+	}
+
+	return buffer.body.Write(data)
+}
+
+// response returns the buffered response to the request.
+func (buffer *responseBuffer) response(request *http.Request) *http.Response {
+	buffer.WriteHeader(http.StatusOK)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", buffer.status, http.StatusText(buffer.status)),
+		StatusCode:    buffer.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        buffer.written,
+		Body:          io.NopCloser(&buffer.body),
+		ContentLength: int64(buffer.body.Len()),
+		Request:       request,
+	}
+}
+
+// lookupPaths maps the path segments of RFC 9082 lookups to the object class they return.
+var lookupPaths = map[string]string{
+	"domain":     "domain",
+	"nameserver": "nameserver",
+	"entity":     "entity",
+	"ip":         "ip network",
+	"autnum":     "autnum",
+}
+
+// checkPath returns the object class expected in the response to the lookup path requested,
+// or an empty string if the path is not a lookup.
+func checkPath(report *Report, path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	// The RDAP base URL may include a path (i.e. /registry/ip/192.0.2.1), so lookups are
+	// found by the last segment naming an object class which is followed by a value.
+	for i := len(segments) - 2; i >= 0; i-- {
+		if class, ok := lookupPaths[segments[i]]; ok {
+			return class
+		}
+	}
+
+	report.add(Info, "path", "RFC 9082 Section 3.1", "%q is not a lookup path, so the object class of the response was not checked against it", path)
+
+	return ""
+}
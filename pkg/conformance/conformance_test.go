@@ -0,0 +1,191 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readFixture(t *testing.T, fixture string) []byte {
+	data, err := os.ReadFile("testdata/" + fixture)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func newFixtureHandler(t *testing.T, fixture string, contentType string) http.HandlerFunc {
+	data := readFixture(t, fixture)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		_, _ = w.Write(data)
+	}
+}
+
+func TestConformantResponseHasNoFindings(t *testing.T) {
+	report := New().CheckJSON(readFixture(t, "domain.json"))
+
+	assert.Empty(t, report.Findings)
+	assert.True(t, report.Passed())
+}
+
+func TestNonConformantResponseIsReported(t *testing.T) {
+	report := New().CheckJSON(readFixture(t, "domain_nonconformant.json"))
+
+	assert.False(t, report.Passed())
+
+	expected := []Finding{
+		{Warning, "$.rdapConformance[0]", `"example_extension" is not a registered extension identifier`, "RFC 7480 Section 6"},
+		{Error, "$.rdapConformance", "rdapConformance must contain rdap_level_0", "RFC 9083 Section 4.1"},
+		{Error, "$.events[0].eventAction", `"created" is not a registered event action`, "RFC 9083 Section 4.5"},
+		{Error, "$.events[0].eventDate", `"31/12/1990" is not an RFC 3339 date and time`, "RFC 9083 Section 4.5"},
+		{Error, "$.links[0].href", `"/domain/example.com" is not an absolute URI`, "RFC 9083 Section 4.2"},
+		{Error, "$.links[0].value", "value is required", "RFC 9083 Section 4.2"},
+		{Warning, "$.status[0]", `"frozen" is not a registered status`, "RFC 9083 Section 4.6"},
+		{Error, "$.entities[0].objectClassName", "the object must have an objectClassName", "RFC 9083 Section 4.7"},
+		{Warning, "$.entities[0].notices", "notices should only appear in the topmost object", "RFC 9083 Section 4.3"},
+		{Error, "$.nameservers[0].objectClassName", `"name server" is not an object class defined by RFC 9083`, "RFC 9083 Section 4.7"},
+		{Warning, "$.undeclared_data", `"undeclared_data" is not defined by RFC 9083, and is not prefixed with an extension identifier declared in rdapConformance`, "RFC 9083 Section 2.1"},
+	}
+
+	assert.Equal(t, expected, report.Findings)
+	assert.Equal(t, 7, report.Count(Error))
+	assert.Equal(t, 4, report.Count(Warning))
+}
+
+func TestCheckerAcceptsAdditionalExtensions(t *testing.T) {
+	data := []byte(`{"rdapConformance": ["rdap_level_0", "example_extension"], "objectClassName": "autnum", "example_extension_data": true}`)
+
+	report := New().CheckJSON(data)
+	assert.Len(t, report.Findings, 1)
+
+	checker := New()
+	checker.WithExtensions("example_extension")
+
+	assert.Empty(t, checker.CheckJSON(data).Findings)
+}
+
+func TestMalformedResponsesAreReported(t *testing.T) {
+	tests := map[string]string{
+		"not JSON":        `{"rdapConformance": [`,
+		"not an object":   `["rdap_level_0"]`,
+		"no conformance":  `{"objectClassName": "autnum"}`,
+		"no object class": `{"rdapConformance": ["rdap_level_0"]}`,
+		"IPv6 in IPv4":    `{"rdapConformance": ["rdap_level_0"], "objectClassName": "ip network", "ipVersion": "v4", "startAddress": "2001:db8::", "endAddress": "192.0.2.255"}`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			report := New().CheckJSON([]byte(data))
+
+			assert.False(t, report.Passed())
+			assert.Equal(t, 1, report.Count(Error), report.Findings)
+		})
+	}
+}
+
+func TestCheckURLReportsWrongMediaType(t *testing.T) {
+	server := httptest.NewServer(newFixtureHandler(t, "domain.json", "application/json"))
+	defer server.Close()
+
+	report, err := New().CheckURL(context.Background(), server.Client(), server.URL+"/domain/example.com")
+
+	assert.NoError(t, err)
+	assert.Equal(t, server.URL+"/domain/example.com", report.Source)
+	assert.Equal(t, []Finding{
+		{Error, "header:Content-Type", `the media type is "application/json", but must be application/rdap+json`, "RFC 7480 Section 4.2"},
+	}, report.Findings)
+}
+
+func TestCheckHandlerChecksObjectClassAgainstPath(t *testing.T) {
+	handler := newFixtureHandler(t, "domain.json", MediaType+"; charset=utf-8")
+
+	report, err := New().CheckHandler(handler, "/rdap/domain/example.com")
+
+	assert.NoError(t, err)
+	assert.Empty(t, report.Findings)
+
+	report, err = New().CheckHandler(handler, "/rdap/ip/192.0.2.0/24")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Finding{
+		{Error, "$.objectClassName", `the object class is "domain", but must be "ip network" here`, "RFC 9083 Section 4.7"},
+	}, report.Findings)
+}
+
+func TestCheckHandlerRejectsMalformedTarget(t *testing.T) {
+	handler := newFixtureHandler(t, "domain.json", MediaType)
+
+	report, err := New().CheckHandler(handler, "/rdap/domain/%zz")
+
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func TestCheckHandlerUsesHeadersWrittenWithBody(t *testing.T) {
+	data := readFixture(t, "domain.json")
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		_, _ = w.Write(data)
+
+		// Headers set once the body has been written are never sent.
+		w.Header().Set("Content-Type", MediaType)
+	})
+
+	report, err := New().CheckHandler(handler, "/domain/example.com")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Finding{
+		{Error, "header:Content-Type", `the media type is "text/plain", but must be application/rdap+json`, "RFC 7480 Section 4.2"},
+	}, report.Findings)
+}
+
+func TestMembersOfOtherObjectClassesAreReported(t *testing.T) {
+	report := New().CheckJSON([]byte(`{
+		"rdapConformance": ["rdap_level_0"],
+		"objectClassName": "domain",
+		"ldhName": "example.com",
+		"startAutnum": 64496,
+		"entities": [{"objectClassName": "entity", "handle": "XXXX", "ldhName": "example.com"}]
+	}`))
+
+	assert.Equal(t, []Finding{
+		{Warning, "$.entities[0].ldhName", `"ldhName" is not a member of entity objects`, "RFC 9083 Section 5"},
+		{Warning, "$.startAutnum", `"startAutnum" is not a member of domain objects`, "RFC 9083 Section 5"},
+	}, report.Findings)
+}
+
+func TestErrorResponsesAreCheckedAgainstStatusCode(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", MediaType)
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"rdapConformance": ["rdap_level_0"], "errorCode": 400, "title": "Not Found", "description": ["The domain was not found."]}`))
+	})
+
+	report, err := New().CheckHandler(handler, "/domain/example.com")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Finding{
+		{Warning, "$.errorCode", "errorCode is 400, but the HTTP status code is 404", "RFC 9083 Section 6"},
+	}, report.Findings)
+}
+
+func TestReportIsEncodedWithSeverityNames(t *testing.T) {
+	report := Report{Findings: []Finding{{Severity: Warning, Path: "$", Message: "message", Reference: "RFC 9083"}}}
+
+	data, err := json.Marshal(report)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"findings": [{"severity": "warning", "path": "$", "message": "message", "reference": "RFC 9083"}]}`, string(data))
+}
@@ -0,0 +1,202 @@
+package conformance
+
+import "slices"
+
+// registeredExtensions is the extension identifiers in the IANA RDAP Extensions registry,
+// along with rdap_level_0 which is defined by RFC 9083.
+//
+// See: https://www.iana.org/assignments/rdap-extensions/rdap-extensions.xhtml
+var registeredExtensions = []string{
+	"rdap_level_0",
+	"arin_originas0",
+	"artRecord",
+	"cidr0",
+	"exts",
+	"farv1",
+	"fred",
+	"geofeed1",
+	"icann_rdap_response_profile_0",
+	"icann_rdap_response_profile_1",
+	"icann_rdap_technical_implementation_guide_0",
+	"icann_rdap_technical_implementation_guide_1",
+	"nro_rdap_profile_0",
+	"nro_rdap_profile_asn_flat_0",
+	"nro_rdap_profile_asn_hierarchical_0",
+	"paging",
+	"platformNS",
+	"rdap_objectTag",
+	"redacted",
+	"redirect_with_content",
+	"regType",
+	"reverse_search",
+	"rir_search1",
+	"roidc1",
+	"sorting",
+	"subsetting",
+	"ttl0",
+	"versioning",
+}
+
+// objectClasses is the object classes defined by RFC 9083.
+//
+// See Section 4.7: https://datatracker.ietf.org/doc/rfc9083/
+var objectClasses = []string{
+	"domain",
+	"ip network",
+	"autnum",
+	"entity",
+	"nameserver",
+}
+
+// eventActions is the values in the IANA RDAP JSON Values registry of type "event action".
+//
+// See: https://www.iana.org/assignments/rdap-json-values/rdap-json-values.xhtml
+var eventActions = []string{
+	"registration",
+	"reregistration",
+	"last changed",
+	"expiration",
+	"deletion",
+	"reinstantiation",
+	"transfer",
+	"locked",
+	"unlocked",
+	"last update of RDAP database",
+	"registrar expiration",
+	"enum validation expiration",
+}
+
+// statuses is the values in the IANA RDAP JSON Values registry of type "status".
+//
+// See: https://www.iana.org/assignments/rdap-json-values/rdap-json-values.xhtml
+var statuses = []string{
+	"validated",
+	"renew prohibited",
+	"update prohibited",
+	"transfer prohibited",
+	"delete prohibited",
+	"proxy",
+	"private",
+	"removed",
+	"obscured",
+	"associated",
+	"active",
+	"inactive",
+	"locked",
+	"pending create",
+	"pending renew",
+	"pending transfer",
+	"pending update",
+	"pending delete",
+	"add period",
+	"auto renew period",
+	"client delete prohibited",
+	"client hold",
+	"client renew prohibited",
+	"client transfer prohibited",
+	"client update prohibited",
+	"pending restore",
+	"redemption period",
+	"renew period",
+	"server delete prohibited",
+	"server renew prohibited",
+	"server transfer prohibited",
+	"server update prohibited",
+	"server hold",
+	"transfer period",
+	"administrative",
+	"reserved",
+}
+
+// roles is the values in the IANA RDAP JSON Values registry of type "role".
+//
+// See: https://www.iana.org/assignments/rdap-json-values/rdap-json-values.xhtml
+var roles = []string{
+	"registrant",
+	"technical",
+	"administrative",
+	"abuse",
+	"billing",
+	"registrar",
+	"reseller",
+	"sponsor",
+	"proxy",
+	"notifications",
+	"noc",
+}
+
+// memberClass pairs a member holding embedded objects with their object class.
+type memberClass struct {
+	member string
+	class  string
+}
+
+// embedded is the members of object classes which hold embedded objects.
+var embedded = []memberClass{
+	{"entities", "entity"},
+	{"nameservers", "nameserver"},
+	{"network", "ip network"},
+	{"networks", "ip network"},
+	{"autnums", "autnum"},
+}
+
+// searchResults is the members of search responses, and the object class of their results.
+//
+// See Section 8: https://datatracker.ietf.org/doc/rfc9083/
+var searchResults = []memberClass{
+	{"domainSearchResults", "domain"},
+	{"nameserverSearchResults", "nameserver"},
+	{"entitySearchResults", "entity"},
+}
+
+// commonMembers is the members which may appear in any topmost object.
+var commonMembers = []string{"rdapConformance", "notices", "lang", "links"}
+
+// objectMembers is the members defined by RFC 9083 for each object class, in addition to the
+// members which may appear in any topmost object.
+//
+// See Section 5: https://datatracker.ietf.org/doc/rfc9083/
+var objectMembers = map[string][]string{
+	"entity": append([]string{
+		"objectClassName", "handle", "vcardArray", "roles", "publicIds", "entities", "remarks",
+		"events", "asEventActor", "status", "port43", "networks", "autnums",
+	}, commonMembers...),
+	"nameserver": append([]string{
+		"objectClassName", "handle", "ldhName", "unicodeName", "ipAddresses", "entities",
+		"status", "remarks", "port43", "events",
+	}, commonMembers...),
+	"domain": append([]string{
+		"objectClassName", "handle", "ldhName", "unicodeName", "variants", "nameservers",
+		"secureDNS", "entities", "status", "publicIds", "remarks", "port43", "events", "network",
+	}, commonMembers...),
+	"ip network": append([]string{
+		"objectClassName", "handle", "startAddress", "endAddress", "ipVersion", "name", "type",
+		"country", "parentHandle", "status", "entities", "remarks", "port43", "events",
+	}, commonMembers...),
+	"autnum": append([]string{
+		"objectClassName", "handle", "startAutnum", "endAutnum", "name", "type", "status",
+		"country", "entities", "remarks", "port43", "events",
+	}, commonMembers...),
+}
+
+// anyObjectMembers is the members defined by RFC 9083 for any of the object classes, which
+// are checked against when the object class of an object is not known.
+var anyObjectMembers = func() []string {
+	var members []string
+
+	for _, class := range objectClasses {
+		for _, member := range objectMembers[class] {
+			if !slices.Contains(members, member) {
+				members = append(members, member)
+			}
+		}
+	}
+
+	return members
+}()
+
+// errorMembers is the members defined by RFC 9083 for error responses.
+var errorMembers = append([]string{"errorCode", "title", "description"}, commonMembers...)
+
+// searchMembers is the members defined by RFC 9083 for search responses.
+var searchMembers = append([]string{"domainSearchResults", "nameserverSearchResults", "entitySearchResults"}, commonMembers...)